@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/engine"
+)
+
+// analysis is the result of searching a position
+type analysis struct {
+	FEN         string   `json:"fen"`
+	BestMove    string   `json:"bestMove"`
+	BestMoveSAN string   `json:"bestMoveSan"`
+	Score       int      `json:"score"`
+	Mate        int      `json:"mate,omitempty"`
+	Depth       int      `json:"depth"`
+	Nodes       int64    `json:"nodes"`
+	Time        float64  `json:"seconds"`
+	PV          []string `json:"pv"`
+	PVSAN       []string `json:"pvSan"`
+}
+
+// analyseCommand searches a position for the best move, the starting position if none
+// is given. Scores are in centipawns from the point of view of the side to move.
+func analyseCommand(args []string, s streams) error {
+	set := flags("analyse", "[FEN]", s)
+	depth := set.Int("depth", 0, "depth in plies")
+	moveTime := set.Duration("movetime", 0, "time to search for, e.g. 5s")
+	nodes := set.Int64("nodes", 0, "number of positions to search")
+	asJSON := set.Bool("json", false, "write the result as JSON")
+	if err := parse(set, args); err != nil {
+		return err
+	}
+	board, err := boardArg(set.Args())
+	if err != nil {
+		return err
+	}
+	limits := engine.Limits{Depth: *depth, MoveTime: *moveTime, Nodes: *nodes}
+	if limits == (engine.Limits{}) {
+		limits.Depth = 5
+	}
+
+	e := engine.New()
+	if !*asJSON {
+		e.OnInfo = func(info engine.Info) {
+			fmt.Fprintf(s.out, "depth %d score %s nodes %d time %dms pv %s\n", info.Depth, score(info),
+				info.Nodes, info.Time/time.Millisecond, strings.Join(line(board, info.PV), " "))
+		}
+	}
+	result, err := e.Search(context.Background(), board, limits)
+	if err != nil {
+		return err
+	}
+
+	a := analysis{
+		FEN:         board.FEN(),
+		BestMove:    result.Move.UCI(),
+		BestMoveSAN: board.SAN(result.Move),
+		Score:       result.Score,
+		Mate:        result.Mate,
+		Depth:       result.Depth,
+		Nodes:       result.Nodes,
+		Time:        result.Time.Seconds(),
+		PVSAN:       line(board, result.PV),
+	}
+	for _, m := range result.PV {
+		a.PV = append(a.PV, m.UCI())
+	}
+	if *asJSON {
+		return writeJSON(s, a)
+	}
+	fmt.Fprintf(s.out, "bestmove %s (%s) score %s\n", a.BestMove, a.BestMoveSAN, score(result.Info))
+	return nil
+}
+
+// score formats a score as centipawns or moves to mate, the way UCI engines do
+func score(info engine.Info) string {
+	if info.Mate != 0 {
+		return fmt.Sprintf("mate %d", info.Mate)
+	}
+	return fmt.Sprintf("cp %d", info.Score)
+}
+
+// line returns moves played from a position in SAN
+func line(board *chess.Board, moves []chess.Move) []string {
+	board = board.Copy()
+	san := make([]string, 0, len(moves))
+	for _, m := range moves {
+		san = append(san, board.SAN(m))
+		board.MakeMove(m)
+	}
+	return san
+}
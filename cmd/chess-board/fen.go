@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// fenCommand runs the FEN tools
+func fenCommand(args []string, s streams) error {
+	if len(args) == 0 || args[0] != "validate" {
+		fmt.Fprintln(s.err, "Usage: chess-board fen validate [flags] [FEN...]")
+		return errUsage
+	}
+	return validate(args[1:], s)
+}
+
+// validation is the result of validating a position
+type validation struct {
+	FEN   string `json:"fen"`
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+}
+
+// validate checks that positions given as arguments, or one per line of the input, can
+// be parsed and could arise in a game
+func validate(args []string, s streams) error {
+	set := flags("fen validate", "[FEN...]", s)
+	asJSON := set.Bool("json", false, "write the results as JSON")
+	if err := parse(set, args); err != nil {
+		return err
+	}
+
+	positions := set.Args()
+	if len(positions) == 0 {
+		scanner := bufio.NewScanner(s.in)
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				positions = append(positions, line)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+	}
+
+	results := make([]validation, 0, len(positions))
+	invalid := 0
+	for _, fen := range positions {
+		result := validation{FEN: fen, Valid: true}
+		board, err := chess.ParseFEN(fen)
+		if err == nil && board.Variant != chess.Crazyhouse {
+			err = board.Validate()
+		}
+		if err != nil {
+			result.Valid, result.Error = false, err.Error()
+			invalid++
+		}
+		results = append(results, result)
+	}
+
+	if *asJSON {
+		if err := writeJSON(s, results); err != nil {
+			return err
+		}
+	} else {
+		for _, r := range results {
+			if r.Valid {
+				fmt.Fprintf(s.out, "valid\t%s\n", r.FEN)
+			} else {
+				fmt.Fprintf(s.out, "invalid\t%s\t%s\n", r.FEN, r.Error)
+			}
+		}
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d positions are invalid", invalid, len(results))
+	}
+	return nil
+}
+
+// writeJSON writes a value as indented JSON
+func writeJSON(s streams, v interface{}) error {
+	encoder := json.NewEncoder(s.out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -1,20 +1,111 @@
+// Command chess-board plays chess at the terminal and bundles tools for working with
+// positions and games: validating FEN, splitting, merging, filtering, summarising and
+// classifying the openings of PGN files, rendering diagrams, counting perft nodes and
+// analysing positions.
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
-
-	"github.com/aaronireland/go-chess/pkg/chess"
+	"io"
+	"os"
+	"strings"
 )
 
+// streams are the standard streams a subcommand reads from and writes to
+type streams struct {
+	in       io.Reader
+	out, err io.Writer
+}
+
+// subcommand is one of the tools chess-board bundles
+type subcommand struct {
+	name, usage string
+	run         func(args []string, s streams) error
+}
+
+var subcommands []subcommand
+
+func init() {
+	subcommands = []subcommand{
+		{"play", "play a game at the terminal (the default)", playCommand},
+		{"fen", "validate positions in FEN", fenCommand},
+		{"pgn", "split, merge, filter, summarise and classify PGN files", pgnCommand},
+		{"render", "draw a position as SVG, PNG or text", renderCommand},
+		{"perft", "count the positions reachable from a position", perftCommand},
+		{"analyse", "search a position for the best move", analyseCommand},
+	}
+}
+
 func main() {
-	board, err := chess.NewBoard()
+	os.Exit(run(os.Args[1:], streams{os.Stdin, os.Stdout, os.Stderr}))
+}
 
-	if err != nil {
-		panic(err)
+// run runs the subcommand named by the first argument, playing a game if there's none,
+// and returns the exit code
+func run(args []string, s streams) int {
+	name := "play"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+	if name == "help" {
+		usage(s.out)
+		return 0
 	}
+	for _, c := range subcommands {
+		if c.name != name {
+			continue
+		}
+		err := c.run(args, s)
+		switch {
+		case err == nil:
+			return 0
+		case errors.Is(err, flag.ErrHelp):
+			return 0
+		case errors.Is(err, errUsage):
+			return 2
+		}
+		fmt.Fprintf(s.err, "chess-board %s: %s\n", name, err)
+		return 1
+	}
+	fmt.Fprintf(s.err, "chess-board: unknown command %q\n", name)
+	usage(s.err)
+	return 2
+}
+
+// usage lists the subcommands
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: chess-board [command] [arguments]")
+	fmt.Fprintln(w, "\nCommands:")
+	for _, c := range subcommands {
+		fmt.Fprintf(w, "  %-8s %s\n", c.name, c.usage)
+	}
+	fmt.Fprintln(w, "\nRun chess-board COMMAND -h for the arguments of a command.")
+}
 
-	fmt.Println(board)
-	fmt.Println("White to move... e4")
-	board.MovePieceAlgebraic(chess.WhitePawn.Index, "e2", "e4")
-	fmt.Println(board)
+// errUsage is returned when a subcommand's arguments are invalid, after its usage has
+// been shown
+var errUsage = errors.New("invalid arguments")
+
+// flags returns a flag set for a subcommand which writes its usage to the error stream
+func flags(name, args string, s streams) *flag.FlagSet {
+	set := flag.NewFlagSet(name, flag.ContinueOnError)
+	set.SetOutput(s.err)
+	set.Usage = func() {
+		fmt.Fprintf(s.err, "Usage: chess-board %s [flags] %s\n", name, args)
+		set.PrintDefaults()
+	}
+	return set
+}
+
+// parse parses a subcommand's flags, returning errUsage if they're invalid
+func parse(set *flag.FlagSet, args []string) error {
+	if err := set.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return err
+		}
+		return errUsage
+	}
+	return nil
 }
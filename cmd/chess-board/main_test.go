@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testPGN = `[Event "Casual"]
+[White "Alice"]
+[Black "Bob"]
+[Result "1-0"]
+[ECO "C50"]
+
+1. e4 e5 2. Bc4 Nc6 3. Qh5 Nf6 4. Qxf7# 1-0
+
+[Event "Casual"]
+[White "Bob"]
+[Black "Carol"]
+[Result "1/2-1/2"]
+[ECO "B20"]
+
+1. e4 c5 1/2-1/2
+
+[Event "Casual"]
+[White "Carol"]
+[Black "Alice"]
+[Result "0-1"]
+[ECO "C50"]
+
+1. f3 e5 2. g4 Qh4# 0-1
+`
+
+// runCommand runs chess-board with arguments and input, returning the exit code and
+// what was written to the output and error streams
+func runCommand(input string, args ...string) (int, string, string) {
+	var out, errOut bytes.Buffer
+	code := run(args, streams{strings.NewReader(input), &out, &errOut})
+	return code, out.String(), errOut.String()
+}
+
+func TestRun(t *testing.T) {
+	if code, out, _ := runCommand("", "help"); code != 0 || !strings.Contains(out, "analyse") {
+		t.Errorf("expected the commands to be listed, actual: %d %s", code, out)
+	}
+	if code, _, errOut := runCommand("", "bogus"); code != 2 || !strings.Contains(errOut, `unknown command "bogus"`) {
+		t.Errorf("expected an unknown command to fail, actual: %d %s", code, errOut)
+	}
+	if code, _, _ := runCommand("", "perft", "-depth", "x"); code != 2 {
+		t.Errorf("expected invalid flags to fail with a usage error, actual: %d", code)
+	}
+	if code, out, _ := runCommand("quit\n", "-ascii", "-colors", "none"); code != 0 || !strings.Contains(out, "R  N  B  Q  K") {
+		t.Errorf("expected to play a game by default, actual: %d %s", code, out)
+	}
+}
+
+func TestFENValidate(t *testing.T) {
+	code, out, errOut := runCommand("4k3/8/8/8/8/8/8/4K3 w - - 0 1\n\n8/8/8/8 w - - 0 1\n", "fen", "validate", "-json")
+	var results []validation
+	if err := json.Unmarshal([]byte(out), &results); err != nil {
+		t.Fatalf("unexpected error reading the results: %s\n%s", err, out)
+	}
+	if code != 1 || len(results) != 2 || !results[0].Valid || results[1].Valid || results[1].Error == "" {
+		t.Errorf("expected the second position to be invalid, actual: %d %+v", code, results)
+	}
+	if !strings.Contains(errOut, "1 of 2 positions are invalid") {
+		t.Errorf("expected a count of invalid positions, actual: %s", errOut)
+	}
+
+	code, out, _ = runCommand("", "fen", "validate", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR", "w", "KQkq", "-")
+	if code != 1 || !strings.HasPrefix(out, "invalid\t") {
+		t.Errorf("expected each argument to be a position, actual: %d %s", code, out)
+	}
+	if code, _, _ := runCommand("", "fen"); code != 2 {
+		t.Errorf("expected fen without a tool to fail, actual: %d", code)
+	}
+}
+
+func TestPGNTools(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chess-board")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "games.pgn")
+	ioutil.WriteFile(path, []byte(testPGN), 0644)
+
+	if code, out, _ := runCommand("", "pgn", "split", "-o", filepath.Join(dir, "split"), path); code != 0 || out != "wrote 3 games to "+filepath.Join(dir, "split")+"\n" {
+		t.Errorf("expected 3 games to be split, actual: %d %s", code, out)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "split", "game-*.pgn"))
+	if len(files) != 3 || filepath.Base(files[0]) != "game-0001.pgn" {
+		t.Errorf("expected a file for each game, actual: %v", files)
+	}
+
+	code, out, _ := runCommand("", append([]string{"pgn", "merge"}, files...)...)
+	if code != 0 || strings.Count(out, "[Event ") != 3 || !strings.Contains(out, "4. Qxf7# 1-0") {
+		t.Errorf("expected the games to be merged, actual: %d %s", code, out)
+	}
+
+	for filter, games := range map[string]int{
+		"-player alice":     2,
+		"-white bob":        1,
+		"-result 0-1":       1,
+		"-eco C":            2,
+		"-min-plies 4":      2,
+		"-max-plies 3":      1,
+		"-variant chess960": 0,
+	} {
+		_, out, _ := runCommand(testPGN, append([]string{"pgn", "filter"}, strings.Fields(filter)...)...)
+		if n := strings.Count(out, "[Event "); n != games {
+			t.Errorf("expected %d games matching %s, actual: %d", games, filter, n)
+		}
+	}
+
+	_, out, _ = runCommand(testPGN, "pgn", "stats", "-json")
+	var sum summary
+	if err := json.Unmarshal([]byte(out), &sum); err != nil {
+		t.Fatalf("unexpected error reading the statistics: %s\n%s", err, out)
+	}
+	if sum.Games != 3 || sum.Players != 3 || sum.MinPlies != 2 || sum.MaxPlies != 7 || sum.Results["1-0"] != 1 ||
+		len(sum.Openings) != 2 || sum.Openings[0] != (count{"C50", 2}) {
+		t.Errorf("unexpected statistics: %+v", sum)
+	}
+	if _, out, _ := runCommand(testPGN, "pgn", "stats"); !strings.Contains(out, "Games:   3") || !strings.Contains(out, "1-0      1 (33.3%)") {
+		t.Errorf("unexpected statistics:\n%s", out)
+	}
+
+	code, out, errOut := runCommand(testPGN+"\n1. d4 Nf6 2. c4 e6 3. Nc3 Bb4 *\n", "pgn", "eco")
+	if code != 0 || strings.Count(out, `[ECO "C50"]`) != 2 || !strings.Contains(out, `[ECO "E20"]`) || !strings.Contains(out, `[Opening "Nimzo-Indian Defense"]`) {
+		t.Errorf("expected the untagged game to be classified, actual: %d %s", code, out)
+	}
+	if !strings.Contains(errOut, "classified 1 games") {
+		t.Errorf("expected a count of classified games, actual: %s", errOut)
+	}
+	if _, out, _ := runCommand(testPGN, "pgn", "eco", "-overwrite"); !strings.Contains(out, `[ECO "C23"]`) || !strings.Contains(out, `[Opening "Barnes Opening"]`) {
+		t.Errorf("expected the games to be reclassified, actual: %s", out)
+	}
+}
+
+func TestRender(t *testing.T) {
+	code, out, _ := runCommand("", "render", "-svg", "-coords", "-arrow", "e2e4", "-arrow", "g1f3")
+	if code != 0 || !strings.HasPrefix(out, "<svg") || strings.Count(out, `class="arrow"`) != 2 {
+		t.Errorf("expected an SVG diagram with two arrows, actual: %d %s", code, out)
+	}
+	if _, out, _ := runCommand("", "render", "-png", "-size", "16"); !strings.HasPrefix(out, "\x89PNG") {
+		t.Errorf("expected a PNG image")
+	}
+	_, out, _ = runCommand("", "render", "-text", "-flip", "4k3/8/8/8/8/8/8/4K3", "w", "-", "-", "0", "1")
+	if !strings.Contains(out, "1  -  -  -  K  -  -  -  -  1") {
+		t.Errorf("expected a text board from black's side, actual:\n%s", out)
+	}
+	if code, _, errOut := runCommand("", "render", "-png", "-text"); code != 1 || !strings.Contains(errOut, "only one of") {
+		t.Errorf("expected an error for several formats, actual: %d %s", code, errOut)
+	}
+}
+
+func TestPerft(t *testing.T) {
+	_, out, _ := runCommand("", "perft", "-depth", "3", "-divide", "-json")
+	var result perftResult
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("unexpected error reading the result: %s\n%s", err, out)
+	}
+	if result.Nodes != 8902 || len(result.Divide) != 20 || result.Divide["e2e4"] != 600 {
+		t.Errorf("unexpected perft result: %+v", result)
+	}
+	if _, out, _ := runCommand("", "perft", "-depth", "2", "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1"); !strings.Contains(out, "depth 2: 568 nodes") {
+		t.Errorf("unexpected perft output: %s", out)
+	}
+}
+
+func TestAnalyse(t *testing.T) {
+	_, out, _ := runCommand("", "analyse", "-depth", "4", "-json", "r5k1/5ppp/8/8/8/8/4RPPP/4R1K1 w - - 0 1")
+	var a analysis
+	if err := json.Unmarshal([]byte(out), &a); err != nil {
+		t.Fatalf("unexpected error reading the analysis: %s\n%s", err, out)
+	}
+	if a.BestMove != "e2e8" || a.BestMoveSAN != "Re8+" || a.Mate != 2 || strings.Join(a.PVSAN, " ") != "Re8+ Rxe8 Rxe8#" {
+		t.Errorf("unexpected analysis: %+v", a)
+	}
+
+	_, out, _ = runCommand("", "analyse", "-depth", "2")
+	if !strings.Contains(out, "depth 1 score cp") || !strings.Contains(out, "bestmove ") {
+		t.Errorf("expected the progress of the search, actual:\n%s", out)
+	}
+	if code, _, errOut := runCommand("", "analyse", "4k3/8/8/8/8/8/8/4K3 w - -"); code != 0 {
+		t.Errorf("unexpected failure: %s", errOut)
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// perftResult is the number of positions reachable from a position
+type perftResult struct {
+	FEN    string           `json:"fen"`
+	Depth  int              `json:"depth"`
+	Nodes  int64            `json:"nodes"`
+	Time   float64          `json:"seconds"`
+	Divide map[string]int64 `json:"divide,omitempty"`
+}
+
+// perftCommand counts the leaf nodes of the move tree of a position, the starting
+// position if none is given
+func perftCommand(args []string, s streams) error {
+	set := flags("perft", "[FEN]", s)
+	depth := set.Int("depth", 4, "depth in plies")
+	divide := set.Bool("divide", false, "count the nodes after each move")
+	asJSON := set.Bool("json", false, "write the result as JSON")
+	if err := parse(set, args); err != nil {
+		return err
+	}
+	board, err := boardArg(set.Args())
+	if err != nil {
+		return err
+	}
+	if *depth < 1 {
+		return fmt.Errorf("depth must be at least 1")
+	}
+
+	result := perftResult{FEN: board.FEN(), Depth: *depth}
+	start := time.Now()
+	if *divide {
+		result.Divide = map[string]int64{}
+		for _, m := range board.LegalMoves() {
+			board.MakeMove(m)
+			nodes := board.Perft(*depth - 1)
+			board.UnmakeMove()
+			result.Divide[m.UCI()] = nodes
+			result.Nodes += nodes
+		}
+	} else {
+		result.Nodes = board.Perft(*depth)
+	}
+	result.Time = time.Since(start).Seconds()
+
+	if *asJSON {
+		return writeJSON(s, result)
+	}
+	moves := make([]string, 0, len(result.Divide))
+	for m := range result.Divide {
+		moves = append(moves, m)
+	}
+	sort.Strings(moves)
+	for _, m := range moves {
+		fmt.Fprintf(s.out, "%s: %d\n", m, result.Divide[m])
+	}
+	fmt.Fprintf(s.out, "depth %d: %d nodes in %.3fs\n", result.Depth, result.Nodes, result.Time)
+	return nil
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/eco"
+)
+
+// pgnCommand runs the PGN tools
+func pgnCommand(args []string, s streams) error {
+	tools := map[string]func([]string, streams) error{
+		"split":  split,
+		"merge":  merge,
+		"filter": filter,
+		"stats":  stats,
+		"eco":    classify,
+	}
+	if len(args) == 0 || tools[args[0]] == nil {
+		fmt.Fprintln(s.err, "Usage: chess-board pgn split|merge|filter|stats|eco [flags] [FILE...]")
+		return errUsage
+	}
+	return tools[args[0]](args[1:], s)
+}
+
+// readGames calls fn with each game in the files, or in the input if no files are
+// given. Games that can't be parsed are reported to the error stream and skipped.
+func readGames(files []string, s streams, fn func(*chess.Game) error) error {
+	read := func(name string, r io.Reader) error {
+		reader := chess.NewPGNReader(r)
+		for {
+			game, err := reader.Next()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				fmt.Fprintf(s.err, "%s: %s\n", name, err)
+				continue
+			}
+			if err := fn(game); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(files) == 0 {
+		return read("stdin", s.in)
+	}
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		err = read(name, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// split writes each game to a file of its own
+func split(args []string, s streams) error {
+	set := flags("pgn split", "[FILE...]", s)
+	dir := set.String("o", ".", "directory to write the games to")
+	prefix := set.String("prefix", "game", "prefix of the names of the files, which are numbered")
+	if err := parse(set, args); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(*dir, 0755); err != nil {
+		return err
+	}
+
+	count := 0
+	err := readGames(set.Args(), s, func(game *chess.Game) error {
+		count++
+		path := filepath.Join(*dir, fmt.Sprintf("%s-%04d.pgn", *prefix, count))
+		return ioutil.WriteFile(path, []byte(game.String()), 0644)
+	})
+	fmt.Fprintf(s.out, "wrote %d games to %s\n", count, *dir)
+	return err
+}
+
+// merge writes the games of several files as one PGN stream
+func merge(args []string, s streams) error {
+	set := flags("pgn merge", "FILE...", s)
+	if err := parse(set, args); err != nil {
+		return err
+	}
+	return readGames(set.Args(), s, func(game *chess.Game) error {
+		_, err := fmt.Fprintln(s.out, game)
+		return err
+	})
+}
+
+// filter writes the games matching all of the given criteria
+func filter(args []string, s streams) error {
+	set := flags("pgn filter", "[FILE...]", s)
+	white := set.String("white", "", "white player's name contains")
+	black := set.String("black", "", "black player's name contains")
+	player := set.String("player", "", "either player's name contains")
+	result := set.String("result", "", "result is 1-0, 0-1, 1/2-1/2 or *")
+	eco := set.String("eco", "", "ECO code starts with, e.g. B or B90")
+	variant := set.String("variant", "", "variant, e.g. Chess960")
+	minPlies := set.Int("min-plies", 0, "at least this many plies")
+	maxPlies := set.Int("max-plies", 0, "at most this many plies")
+	if err := parse(set, args); err != nil {
+		return err
+	}
+
+	contains := func(value, substr string) bool {
+		return strings.Contains(strings.ToLower(value), strings.ToLower(substr))
+	}
+	return readGames(set.Args(), s, func(game *chess.Game) error {
+		tags := game.Tags
+		switch {
+		case !contains(tags["White"], *white), !contains(tags["Black"], *black):
+			return nil
+		case *player != "" && !contains(tags["White"], *player) && !contains(tags["Black"], *player):
+			return nil
+		case *result != "" && game.Result != *result:
+			return nil
+		case !strings.HasPrefix(tags["ECO"], *eco):
+			return nil
+		case *variant != "" && !strings.EqualFold(variantName(game), *variant):
+			return nil
+		case len(game.Moves) < *minPlies, *maxPlies > 0 && len(game.Moves) > *maxPlies:
+			return nil
+		}
+		_, err := fmt.Fprintln(s.out, game)
+		return err
+	})
+}
+
+// classify writes the games with their ECO, Opening and Variation tags filled in from
+// the positions they reach
+func classify(args []string, s streams) error {
+	set := flags("pgn eco", "[FILE...]", s)
+	overwrite := set.Bool("overwrite", false, "replace the tags of games which already have an ECO code")
+	if err := parse(set, args); err != nil {
+		return err
+	}
+
+	tagged := 0
+	err := readGames(set.Args(), s, func(game *chess.Game) error {
+		if game.Tags["ECO"] == "" || *overwrite {
+			if eco.Tag(game) {
+				tagged++
+			}
+		}
+		_, err := fmt.Fprintln(s.out, game)
+		return err
+	})
+	fmt.Fprintf(s.err, "classified %d games\n", tagged)
+	return err
+}
+
+// variantName returns the variant a game is played under
+func variantName(game *chess.Game) string {
+	if v := game.Tags["Variant"]; v != "" {
+		return v
+	}
+	return chess.Standard.Name()
+}
+
+// summary is the statistics of a collection of games
+type summary struct {
+	Games        int            `json:"games"`
+	Results      map[string]int `json:"results"`
+	Variants     map[string]int `json:"variants"`
+	Players      int            `json:"players"`
+	AveragePlies float64        `json:"averagePlies"`
+	MinPlies     int            `json:"minPlies"`
+	MaxPlies     int            `json:"maxPlies"`
+	Openings     []count        `json:"openings,omitempty"`
+}
+
+// count is how many games share a value
+type count struct {
+	Name  string `json:"name"`
+	Games int    `json:"games"`
+}
+
+// stats summarises games: how many there are, their results, variants and lengths,
+// how many players played them and the most common openings by ECO code
+func stats(args []string, s streams) error {
+	set := flags("pgn stats", "[FILE...]", s)
+	asJSON := set.Bool("json", false, "write the statistics as JSON")
+	top := set.Int("top", 10, "number of openings to list")
+	if err := parse(set, args); err != nil {
+		return err
+	}
+
+	sum := summary{Results: map[string]int{}, Variants: map[string]int{}}
+	players, openings := map[string]bool{}, map[string]int{}
+	plies := 0
+	err := readGames(set.Args(), s, func(game *chess.Game) error {
+		n := len(game.Moves)
+		if sum.Games == 0 || n < sum.MinPlies {
+			sum.MinPlies = n
+		}
+		if n > sum.MaxPlies {
+			sum.MaxPlies = n
+		}
+		sum.Games++
+		plies += n
+		sum.Results[game.Result]++
+		sum.Variants[variantName(game)]++
+		for _, tag := range []string{"White", "Black"} {
+			if name := game.Tags[tag]; name != "" && name != "?" {
+				players[name] = true
+			}
+		}
+		if eco := game.Tags["ECO"]; eco != "" {
+			openings[eco]++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sum.Players = len(players)
+	if sum.Games > 0 {
+		sum.AveragePlies = float64(plies) / float64(sum.Games)
+	}
+	for name, games := range openings {
+		sum.Openings = append(sum.Openings, count{name, games})
+	}
+	sort.Slice(sum.Openings, func(i, j int) bool {
+		a, b := sum.Openings[i], sum.Openings[j]
+		return a.Games > b.Games || a.Games == b.Games && a.Name < b.Name
+	})
+	if len(sum.Openings) > *top {
+		sum.Openings = sum.Openings[:*top]
+	}
+
+	if *asJSON {
+		return writeJSON(s, sum)
+	}
+	fmt.Fprintf(s.out, "Games:   %d\n", sum.Games)
+	fmt.Fprintf(s.out, "Players: %d\n", sum.Players)
+	fmt.Fprintf(s.out, "Plies:   %.1f average, %d shortest, %d longest\n", sum.AveragePlies, sum.MinPlies, sum.MaxPlies)
+	fmt.Fprintln(s.out, "Results:")
+	for _, result := range []string{chess.WhiteWins, chess.Draw, chess.BlackWins, chess.InProgress} {
+		if n := sum.Results[result]; n > 0 {
+			fmt.Fprintf(s.out, "  %-8s %d (%.1f%%)\n", result, n, 100*float64(n)/float64(sum.Games))
+		}
+	}
+	if len(sum.Variants) > 1 {
+		fmt.Fprintln(s.out, "Variants:")
+		names := make([]string, 0, len(sum.Variants))
+		for name := range sum.Variants {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(s.out, "  %-16s %d\n", name, sum.Variants[name])
+		}
+	}
+	if len(sum.Openings) > 0 {
+		fmt.Fprintln(s.out, "Openings:")
+		for _, o := range sum.Openings {
+			fmt.Fprintf(s.out, "  %-8s %d\n", o.Name, o.Games)
+		}
+	}
+	return nil
+}
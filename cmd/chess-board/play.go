@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/aaronireland/go-chess/pkg/clock"
+	"github.com/aaronireland/go-chess/pkg/engine"
+	"github.com/aaronireland/go-chess/pkg/render"
+)
+
+// playCommand starts an interactive game
+func playCommand(args []string, s streams) error {
+	set := flags("play", "", s)
+	timeControl := set.String("tc", "", "time control in PGN TimeControl syntax, e.g. 300+3 or 40/5400+30:1800+30")
+	colors := set.String("colors", "256", "terminal colors: none, 256 or truecolor")
+	ascii := set.Bool("ascii", false, "draw pieces as letters")
+	level := set.Int("level", 0, fmt.Sprintf("play against the engine at a level from 1 to %d", engine.MaxLevel))
+	engineColor := set.String("engine", "black", "color the engine plays with -level")
+	if err := parse(set, args); err != nil {
+		return err
+	}
+
+	opts := render.TerminalOptions{ASCII: *ascii}
+	switch *colors {
+	case "none":
+		opts.Colors = render.NoColor
+	case "256":
+		opts.Colors = render.Color256
+	case "truecolor":
+		opts.Colors = render.TrueColor
+	default:
+		return fmt.Errorf("unknown colors %q", *colors)
+	}
+
+	var control *clock.TimeControl
+	if *timeControl != "" {
+		tc, err := clock.ParseTimeControl(*timeControl)
+		if err != nil {
+			return err
+		}
+		control = &tc
+	}
+
+	r, err := newREPL(s.in, s.out, opts, control)
+	if err != nil {
+		return err
+	}
+	if *level > 0 {
+		if err := r.execute([]string{"engine", fmt.Sprint(*level), *engineColor}); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(s.out, "Type help for a list of commands")
+	return r.Run()
+}
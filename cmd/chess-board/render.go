@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/render"
+)
+
+// renderCommand draws a position, the starting position if none is given
+func renderCommand(args []string, s streams) error {
+	set := flags("render", "[FEN]", s)
+	svg := set.Bool("svg", false, "draw an SVG diagram (the default)")
+	png := set.Bool("png", false, "draw a PNG image")
+	text := set.Bool("text", false, "draw the board as text")
+	output := set.String("o", "", "file to write to instead of the output")
+	flip := set.Bool("flip", false, "draw the board from black's side")
+	size := set.Int("size", 0, "size of the squares in pixels")
+	coordinates := set.Bool("coords", false, "label the files and ranks of SVG diagrams")
+	lastMove := set.String("lastmove", "", "highlight a move in UCI, e.g. e2e4")
+	var arrows list
+	set.Var(&arrows, "arrow", "draw an arrow for a move in UCI, e.g. g1f3; may be repeated")
+	if err := parse(set, args); err != nil {
+		return err
+	}
+
+	board, err := boardArg(set.Args())
+	if err != nil {
+		return err
+	}
+	orientation := chess.WHITE
+	if *flip {
+		orientation = chess.BLACK
+	}
+	var last *chess.Move
+	if *lastMove != "" {
+		from, to, err := squares(*lastMove)
+		if err != nil {
+			return err
+		}
+		last = &chess.Move{From: from, To: to}
+	}
+
+	var w io.Writer = s.out
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	switch {
+	case *png && !*svg && !*text:
+		return render.EncodePNG(w, board, render.ImageOptions{
+			SquareSize: *size, Orientation: orientation, LastMove: last, Check: true,
+		})
+	case *text && !*svg && !*png:
+		_, err := fmt.Fprint(w, render.RenderTerminal(board, render.TerminalOptions{
+			Orientation: orientation, LastMove: last, Check: true, ASCII: true,
+		}))
+		return err
+	case *png || *text:
+		return fmt.Errorf("only one of -svg, -png and -text may be given")
+	}
+
+	opts := render.SVGOptions{
+		SquareSize: *size, Orientation: orientation, Coordinates: *coordinates, LastMove: last, Check: true,
+	}
+	for _, arrow := range arrows {
+		from, to, err := squares(arrow)
+		if err != nil {
+			return err
+		}
+		opts.Arrows = append(opts.Arrows, render.Arrow{From: from, To: to})
+	}
+	_, err = fmt.Fprintln(w, render.RenderSVG(board, opts))
+	return err
+}
+
+// boardArg returns the board for a position given as arguments, which may be split
+// into its fields, or the starting position if there are no arguments
+func boardArg(args []string) (*chess.Board, error) {
+	if len(args) == 0 {
+		return chess.NewBoard()
+	}
+	return chess.ParseFEN(strings.Join(args, " "))
+}
+
+// squares parses the squares of a move in UCI, e.g. e2e4
+func squares(move string) (int, int, error) {
+	if len(move) < 4 {
+		return 0, 0, fmt.Errorf("invalid move %q: expected squares in UCI, e.g. e2e4", move)
+	}
+	from, err := chess.ParseSquare(move[:2])
+	if err != nil {
+		return 0, 0, err
+	}
+	to, err := chess.ParseSquare(move[2:4])
+	if err != nil {
+		return 0, 0, err
+	}
+	return from, to, nil
+}
+
+// list is a flag that may be repeated, collecting its values
+type list []string
+
+func (l *list) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *list) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
@@ -0,0 +1,395 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/clock"
+	"github.com/aaronireland/go-chess/pkg/engine"
+	"github.com/aaronireland/go-chess/pkg/render"
+)
+
+// repl is an interactive game played at the terminal, either hotseat between two
+// players or against the engine
+type repl struct {
+	in   *bufio.Scanner
+	out  io.Writer
+	opts render.TerminalOptions
+
+	game  *chess.Game
+	board *chess.Board
+
+	engine      *engine.Engine // nil for hotseat games
+	limits      engine.Limits
+	engineColor chess.Color
+
+	control *clock.TimeControl // Time control of each game, if the games are timed
+	clock   *clock.Clock
+	source  clock.Source // Source of time for the clock, the system's if nil
+}
+
+// command is a command the REPL understands besides moves
+type command struct {
+	name, args, help string
+	run              func(r *repl, args []string) error
+}
+
+// commands are listed by help in order
+var commands []command
+
+func init() {
+	commands = []command{
+		{"help", "", "show this help", (*repl).help},
+		{"new", "", "start a new game", (*repl).newGame},
+		{"undo", "", "take back the last move, or your last move against the engine", (*repl).undo},
+		{"flip", "", "turn the board around", (*repl).flip},
+		{"moves", "", "list the legal moves", (*repl).moves},
+		{"hint", "", "suggest a move", (*repl).hint},
+		{"fen", "[FEN]", "show the position in FEN, or set it up", (*repl).fen},
+		{"pgn", "", "show the game in PGN", (*repl).pgn},
+		{"load", "FILE", "load the first game of a PGN file", (*repl).load},
+		{"save", "FILE", "save the game to a PGN file", (*repl).save},
+		{"engine", "LEVEL [white|black]", fmt.Sprintf("play against the engine at a level from 1 to %d, "+
+			"which plays black unless given a color", engine.MaxLevel), (*repl).playEngine},
+		{"hotseat", "", "play both sides", (*repl).hotseat},
+		{"quit", "", "exit", nil},
+	}
+}
+
+// newREPL returns a REPL reading commands from in and writing to out, starting a new
+// game
+func newREPL(in io.Reader, out io.Writer, opts render.TerminalOptions, control *clock.TimeControl) (*repl, error) {
+	r := &repl{in: bufio.NewScanner(in), out: out, opts: opts, control: control}
+	if err := r.newGame(nil); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Run reads and runs commands until the input ends or the player quits
+func (r *repl) Run() error {
+	for {
+		r.prompt()
+		if !r.in.Scan() {
+			return r.in.Err()
+		}
+		fields := strings.Fields(r.in.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "quit" || fields[0] == "exit" {
+			return nil
+		}
+		if err := r.execute(fields); err != nil {
+			fmt.Fprintln(r.out, err)
+		}
+	}
+}
+
+// execute runs a command or plays a move
+func (r *repl) execute(fields []string) error {
+	for _, c := range commands {
+		if c.name == fields[0] && c.run != nil {
+			return c.run(r, fields[1:])
+		}
+	}
+	if len(fields) > 1 {
+		return fmt.Errorf("unknown command %q, type help for a list of commands", fields[0])
+	}
+	if err := r.play(fields[0]); err != nil {
+		return err
+	}
+	if r.engine != nil && !r.over() && r.board.Turn == r.engineColor {
+		return r.engineMove()
+	}
+	return nil
+}
+
+// prompt asks the player to move
+func (r *repl) prompt() {
+	if r.over() {
+		fmt.Fprint(r.out, "> ")
+		return
+	}
+	fmt.Fprintf(r.out, "%s to move> ", r.board.Turn)
+}
+
+// show draws the board and reports the end of the game
+func (r *repl) show() {
+	opts := r.opts
+	opts.Check = true
+	opts.Captured = true
+	opts.Clock = r.clock
+	if m, ok := r.board.LastMove(); ok {
+		opts.LastMove = &m
+	}
+	fmt.Fprint(r.out, render.RenderTerminal(r.board, opts))
+	if r.over() {
+		fmt.Fprintf(r.out, "Game over: %s\n", r.game.Result)
+	}
+}
+
+// over checks whether the game has ended
+func (r *repl) over() bool {
+	return r.game.Result != chess.InProgress
+}
+
+// play makes a move in SAN or UCI for the side to move
+func (r *repl) play(move string) error {
+	if r.over() {
+		return fmt.Errorf("the game is over (%s), type new to start another", r.game.Result)
+	}
+	m, err := r.board.ParseMove(move)
+	if err != nil {
+		return err
+	}
+	san := r.board.SAN(m)
+	r.board.MakeMove(m)
+	if r.clock != nil {
+		// A move made after the player's time ran out doesn't count
+		if err := r.clock.Press(); err != nil {
+			r.board.UnmakeMove()
+			if _, flagged := r.clock.Flagged(); flagged {
+				r.game.Result = winner(r.board.Turn.Opponent())
+				r.show()
+			}
+			return err
+		}
+	}
+	r.game.Moves = append(r.game.Moves, m)
+
+	r.game.Result = r.board.Result()
+	if r.board.IsThreefoldRepetition() || r.board.IsFiftyMoveDraw() {
+		r.game.Result = chess.Draw
+	}
+	if r.clock != nil && r.over() {
+		r.clock.Pause()
+	}
+	fmt.Fprintf(r.out, "%s plays %s\n", r.board.Turn.Opponent(), san)
+	r.show()
+	return nil
+}
+
+// winner returns the result of a game won by a color
+func winner(c chess.Color) string {
+	if c == chess.WHITE {
+		return chess.WhiteWins
+	}
+	return chess.BlackWins
+}
+
+// engineMove plays the engine's move
+func (r *repl) engineMove() error {
+	result, err := r.engine.Search(context.Background(), r.board, r.engineLimits())
+	if err != nil {
+		return err
+	}
+	return r.play(result.Move.UCI())
+}
+
+// engineLimits returns the limits of the engine's search, thinking for no longer than
+// the clock allows in timed games
+func (r *repl) engineLimits() engine.Limits {
+	limits := r.limits
+	if r.clock != nil {
+		budget := r.clock.Allocate(clock.DefaultAllocator, r.board.Turn)
+		if budget.Optimum <= 0 {
+			budget.Optimum = time.Millisecond
+		}
+		if limits.MoveTime == 0 || budget.Optimum < limits.MoveTime {
+			limits.MoveTime = budget.Optimum
+		}
+	}
+	return limits
+}
+
+func (r *repl) help(args []string) error {
+	fmt.Fprintln(r.out, "Enter moves in SAN (e.g. Nf3) or UCI (e.g. g1f3), or one of the commands:")
+	for _, c := range commands {
+		fmt.Fprintf(r.out, "  %-28s %s\n", strings.TrimSpace(c.name+" "+c.args), c.help)
+	}
+	return nil
+}
+
+func (r *repl) newGame(args []string) error {
+	return r.start(chess.NewGame())
+}
+
+// start starts playing a game, with the engine moving first if it's its turn
+func (r *repl) start(game *chess.Game, err error) error {
+	if err != nil {
+		return err
+	}
+	board, err := game.Board()
+	if err != nil {
+		return err
+	}
+	r.game, r.board = game, board
+	r.game.Result = board.Result()
+	if r.control != nil {
+		if r.clock, err = clock.NewClock(*r.control, r.source); err != nil {
+			return err
+		}
+		if !r.over() {
+			if r.board.Turn == chess.BLACK {
+				if err := r.clock.Switch(); err != nil {
+					return err
+				}
+			}
+			if err := r.clock.Start(); err != nil {
+				return err
+			}
+		}
+	}
+	r.show()
+	if r.engine != nil && !r.over() && r.board.Turn == r.engineColor {
+		return r.engineMove()
+	}
+	return nil
+}
+
+func (r *repl) undo(args []string) error {
+	plies := 1
+	if r.engine != nil && r.board.Turn != r.engineColor {
+		plies = 2
+	}
+	if len(r.game.Moves) < plies {
+		return fmt.Errorf("no moves to take back")
+	}
+	if r.clock != nil {
+		if color, flagged := r.clock.Flagged(); flagged {
+			return fmt.Errorf("%s has run out of time, type new to start another game", color)
+		}
+	}
+	for i := 0; i < plies; i++ {
+		r.board.UnmakeMove()
+	}
+	r.game.Moves = r.game.Moves[:len(r.game.Moves)-plies]
+	r.game.Result = chess.InProgress
+	if r.clock != nil {
+		// The time spent on the moves taken back isn't given back, but the clock has to be
+		// running for the side to move again, without charging or crediting anyone
+		if !r.clock.Running() {
+			if err := r.clock.Start(); err != nil {
+				return err
+			}
+		}
+		if r.clock.Turn() != r.board.Turn {
+			if err := r.clock.Switch(); err != nil {
+				return err
+			}
+		}
+	}
+	r.show()
+	return nil
+}
+
+func (r *repl) flip(args []string) error {
+	r.opts.Orientation = r.opts.Orientation.Opponent()
+	r.show()
+	return nil
+}
+
+func (r *repl) moves(args []string) error {
+	var moves []string
+	for _, m := range r.board.LegalMoves() {
+		moves = append(moves, r.board.SAN(m))
+	}
+	fmt.Fprintln(r.out, strings.Join(moves, " "))
+	return nil
+}
+
+func (r *repl) hint(args []string) error {
+	if r.over() {
+		return fmt.Errorf("the game is over (%s)", r.game.Result)
+	}
+	_, limits := engine.Level(engine.MaxLevel)
+	result, err := engine.New().Search(context.Background(), r.board, limits)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(r.out, "Try %s\n", r.board.SAN(result.Move))
+	return nil
+}
+
+func (r *repl) fen(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprintln(r.out, r.board.FEN())
+		return nil
+	}
+	return r.start(chess.NewGame(chess.WithFEN(strings.Join(args, " "))))
+}
+
+func (r *repl) pgn(args []string) error {
+	fmt.Fprint(r.out, r.game)
+	return nil
+}
+
+func (r *repl) load(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: load FILE")
+	}
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	game, err := chess.NewPGNReader(f).Next()
+	if err != nil {
+		return fmt.Errorf("unable to read a game from %s: %s", args[0], err)
+	}
+	return r.start(game, nil)
+}
+
+func (r *repl) save(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: save FILE")
+	}
+	if err := ioutil.WriteFile(args[0], []byte(r.game.String()), 0644); err != nil {
+		return err
+	}
+	fmt.Fprintf(r.out, "Saved to %s\n", args[0])
+	return nil
+}
+
+func (r *repl) playEngine(args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return fmt.Errorf("usage: engine LEVEL [white|black]")
+	}
+	level, err := strconv.Atoi(args[0])
+	if err != nil || level < 1 || level > engine.MaxLevel {
+		return fmt.Errorf("level must be from 1 to %d", engine.MaxLevel)
+	}
+	color := chess.BLACK
+	if len(args) == 2 {
+		switch args[1] {
+		case "white":
+			color = chess.WHITE
+		case "black":
+		default:
+			return fmt.Errorf("color must be white or black")
+		}
+	}
+	r.engine, r.limits = engine.Level(level)
+	r.engineColor = color
+	r.opts.Orientation = color.Opponent()
+	fmt.Fprintf(r.out, "The engine plays %s at level %d\n", color, level)
+	if !r.over() && r.board.Turn == r.engineColor {
+		return r.engineMove()
+	}
+	return nil
+}
+
+func (r *repl) hotseat(args []string) error {
+	r.engine = nil
+	fmt.Fprintln(r.out, "Playing both sides")
+	return nil
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/clock"
+	"github.com/aaronireland/go-chess/pkg/engine"
+	"github.com/aaronireland/go-chess/pkg/render"
+)
+
+// runREPL plays a script of commands, returning the REPL and its output
+func runREPL(t *testing.T, script ...string) (*repl, string) {
+	var out bytes.Buffer
+	r, err := newREPL(strings.NewReader(strings.Join(script, "\n")), &out, render.TerminalOptions{ASCII: true}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error starting the REPL: %s", err)
+	}
+	if err := r.Run(); err != nil {
+		t.Fatalf("unexpected error running the REPL: %s", err)
+	}
+	return r, out.String()
+}
+
+func TestREPLHotseat(t *testing.T) {
+	r, out := runREPL(t, "e4", "e5", "Qh5", "Nc6", "Bc4", "Nf9", "Nf6", "Qxf7#", "e4")
+	if r.game.Result != chess.WhiteWins || len(r.game.Moves) != 7 {
+		t.Errorf("expected white to win in 7 plies, actual: %s %d", r.game.Result, len(r.game.Moves))
+	}
+	for _, expected := range []string{"white plays Qxf7#", `invalid square: "f9"`, "Game over: 1-0", "the game is over"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected %q in the output:\n%s", expected, out)
+		}
+	}
+
+	r, out = runREPL(t, "e4", "undo", "undo", "d4", "pgn", "fen", "bogus command")
+	if len(r.game.Moves) != 1 || r.board.FEN() != "rnbqkbnr/pppppppp/8/8/3P4/8/PPP1PPPP/RNBQKBNR b KQkq d3 0 1" {
+		t.Errorf("expected d4 to be the only move, actual: %s", r.board.FEN())
+	}
+	for _, expected := range []string{"no moves to take back", "1. d4 *", "3P4", `unknown command "bogus"`} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected %q in the output:\n%s", expected, out)
+		}
+	}
+}
+
+func TestREPLClock(t *testing.T) {
+	control := clock.FischerControl(time.Minute, 10*time.Second)
+	var out bytes.Buffer
+	r, err := newREPL(strings.NewReader("e4\nundo"), &out, render.TerminalOptions{ASCII: true}, &control)
+	if err != nil {
+		t.Fatalf("unexpected error starting the REPL: %s", err)
+	}
+	if err := r.Run(); err != nil {
+		t.Fatalf("unexpected error running the REPL: %s", err)
+	}
+	// Handing the move back to white doesn't count a move for black or add its increment
+	if r.clock.Turn() != chess.WHITE || r.clock.Moves(chess.BLACK) != 0 || r.clock.Remaining(chess.BLACK) > time.Minute {
+		t.Errorf("expected white's clock to run again without crediting black: %s", r.clock)
+	}
+}
+
+func TestREPLFlag(t *testing.T) {
+	control := clock.FischerControl(time.Minute, 0)
+	var out bytes.Buffer
+	r, err := newREPL(strings.NewReader(""), &out, render.TerminalOptions{ASCII: true}, &control)
+	if err != nil {
+		t.Fatalf("unexpected error starting the REPL: %s", err)
+	}
+	source := clock.NewFakeSource(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	r.source = source
+	if err := r.newGame(nil); err != nil {
+		t.Fatalf("unexpected error starting a game: %s", err)
+	}
+	if err := r.execute([]string{"e4"}); err != nil {
+		t.Fatalf("unexpected error playing e4: %s", err)
+	}
+
+	// Black's move after the flag isn't played, and the game is lost on time
+	source.Advance(2 * time.Minute)
+	if err := r.execute([]string{"e5"}); err == nil || !strings.Contains(err.Error(), "black has run out of time") {
+		t.Errorf("expected black to run out of time, actual: %v", err)
+	}
+	if len(r.game.Moves) != 1 || r.board.Turn != chess.BLACK || r.game.Result != chess.WhiteWins {
+		t.Errorf("expected the game to end on time after e4, actual: %v %s", r.game.Moves, r.game.Result)
+	}
+	if err := r.execute([]string{"undo"}); err == nil || len(r.game.Moves) != 1 {
+		t.Errorf("expected no take backs after the flag, actual: %v %v", r.game.Moves, err)
+	}
+}
+
+func TestREPLEngineClock(t *testing.T) {
+	control := clock.FischerControl(10*time.Second, 0)
+	r, err := newREPL(strings.NewReader(""), ioutil.Discard, render.TerminalOptions{ASCII: true}, &control)
+	if err != nil {
+		t.Fatalf("unexpected error starting the REPL: %s", err)
+	}
+	_, r.limits = engine.Level(engine.MaxLevel)
+	budget := r.clock.Allocate(clock.DefaultAllocator, chess.WHITE)
+	if limits := r.engineLimits(); limits.MoveTime <= 0 || limits.MoveTime > budget.Optimum || limits.MoveTime >= r.limits.MoveTime {
+		t.Errorf("expected the engine to think for at most %s, actual: %s", budget.Optimum, limits.MoveTime)
+	}
+
+	r.clock = nil
+	if limits := r.engineLimits(); limits != r.limits {
+		t.Errorf("expected the level's limits without a clock, actual: %+v", limits)
+	}
+}
+
+func TestREPLEngine(t *testing.T) {
+	r, out := runREPL(t, "engine 1", "e4", "undo", "d4", "hint")
+	if len(r.game.Moves) != 2 || r.game.Moves[0].UCI() != "d2d4" || r.board.Turn != chess.WHITE {
+		t.Errorf("expected the engine to answer d4, actual: %v", r.game.Moves)
+	}
+	if !strings.Contains(out, "The engine plays black at level 1") || !strings.Contains(out, "Try ") {
+		t.Errorf("expected the engine to play and give a hint:\n%s", out)
+	}
+
+	r, _ = runREPL(t, "engine 8 white", "flip")
+	if len(r.game.Moves) != 1 || r.opts.Orientation != chess.WHITE {
+		t.Errorf("expected the engine to move first with the board flipped back, actual: %v %s", r.game.Moves, r.opts.Orientation)
+	}
+
+	_, out = runREPL(t, "engine 9", "engine 1 green", "hotseat")
+	if !strings.Contains(out, "level must be from 1 to 8") || !strings.Contains(out, "color must be white or black") {
+		t.Errorf("expected errors for invalid engine settings:\n%s", out)
+	}
+}
+
+func TestREPLFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chess-board")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "game.pgn")
+
+	runREPL(t, "e4", "c5", "Nf3", "save "+path)
+	r, out := runREPL(t, "load "+path, "d6")
+	if len(r.game.Moves) != 4 || r.board.FEN() != "rnbqkbnr/pp2pppp/3p4/2p5/4P3/5N2/PPPP1PPP/RNBQKB1R w KQkq - 0 3" {
+		t.Errorf("expected the saved game to continue, actual: %s\n%s", r.board.FEN(), out)
+	}
+
+	r, _ = runREPL(t, "fen 4k3/8/8/8/8/8/4P3/4K3 b - - 0 1", "Kd7")
+	if r.game.Tags["FEN"] != "4k3/8/8/8/8/8/4P3/4K3 b - - 0 1" || len(r.game.Moves) != 1 {
+		t.Errorf("expected a game from the position, actual: %v %v", r.game.Tags, r.game.Moves)
+	}
+	if _, out := runREPL(t, "load "+filepath.Join(dir, "missing.pgn"), "fen 8/8"); !strings.Contains(out, "no such file") {
+		t.Errorf("expected an error loading a missing file:\n%s", out)
+	}
+}
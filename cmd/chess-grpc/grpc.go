@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The server side of gRPC over HTTP/2, see
+// https://github.com/grpc/grpc/blob/master/doc/PROTOCOL-HTTP2.md. Each message is
+// prefixed with a compression flag and its length; the status of a call is sent in the
+// trailers. Compression isn't supported.
+
+// Status codes, see https://github.com/grpc/grpc/blob/master/doc/statuscodes.md
+const (
+	codeOK                 = 0
+	codeCanceled           = 1
+	codeUnknown            = 2
+	codeInvalidArgument    = 3
+	codeDeadlineExceeded   = 4
+	codeNotFound           = 5
+	codeFailedPrecondition = 9
+	codeUnimplemented      = 12
+	codeInternal           = 13
+)
+
+// maxMessageSize limits the size of the requests the server reads
+const maxMessageSize = 4 << 20
+
+// statusError is an error with a gRPC status code
+type statusError struct {
+	code    int
+	message string
+}
+
+func (e *statusError) Error() string {
+	return e.message
+}
+
+// errorf returns an error with a status code
+func errorf(code int, format string, args ...interface{}) error {
+	return &statusError{code, fmt.Sprintf(format, args...)}
+}
+
+// method is an RPC. It reads a request and sends its replies, one for unary methods.
+type method struct {
+	request func() message
+	call    func(ctx context.Context, req message, send func(message) error) error
+}
+
+// unary returns a method with a single reply
+func unary(request func() message, call func(req message) (message, error)) method {
+	return method{request, func(ctx context.Context, req message, send func(message) error) error {
+		reply, err := call(req)
+		if err != nil {
+			return err
+		}
+		return send(reply)
+	}}
+}
+
+// rpcServer serves the methods of a gRPC service at /{service}/{method}
+type rpcServer struct {
+	service string
+	methods map[string]method
+}
+
+func (s *rpcServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.ProtoMajor != 2 {
+		http.Error(w, "gRPC requires HTTP/2", http.StatusHTTPVersionNotSupported)
+		return
+	}
+	if r.Method != http.MethodPost || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+		http.Error(w, "expected a gRPC request", http.StatusUnsupportedMediaType)
+		return
+	}
+	w.Header().Set("Content-Type", "application/grpc")
+
+	m, ok := s.methods[strings.TrimPrefix(r.URL.Path, "/"+s.service+"/")]
+	if !ok || !strings.HasPrefix(r.URL.Path, "/"+s.service+"/") {
+		writeStatus(w, errorf(codeUnimplemented, "unknown method %s", r.URL.Path))
+		return
+	}
+	ctx := r.Context()
+	if timeout := r.Header.Get("Grpc-Timeout"); timeout != "" {
+		d, err := parseTimeout(timeout)
+		if err != nil {
+			writeStatus(w, errorf(codeInvalidArgument, "%s", err))
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d)
+		defer cancel()
+	}
+
+	req := m.request()
+	data, err := readMessage(r.Body)
+	if err == nil {
+		err = req.unmarshal(data)
+	}
+	if err != nil {
+		writeStatus(w, errorf(codeInvalidArgument, "invalid request: %s", err))
+		return
+	}
+	err = m.call(ctx, req, func(reply message) error {
+		if err := writeMessage(w, reply.marshal()); err != nil {
+			return err
+		}
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = ctx.Err()
+	}
+	writeStatus(w, err)
+}
+
+// readMessage reads a length-prefixed message
+func readMessage(r io.Reader) ([]byte, error) {
+	var prefix [5]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return nil, err
+	}
+	if prefix[0] != 0 {
+		return nil, fmt.Errorf("compressed messages aren't supported")
+	}
+	length := binary.BigEndian.Uint32(prefix[1:])
+	if length > maxMessageSize {
+		return nil, fmt.Errorf("message too large")
+	}
+	data := make([]byte, length)
+	_, err := io.ReadFull(r, data)
+	return data, err
+}
+
+// writeMessage writes a length-prefixed message
+func writeMessage(w io.Writer, data []byte) error {
+	prefix := [5]byte{}
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(data)))
+	if _, err := w.Write(prefix[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// writeStatus sends the status of a call in the trailers
+func writeStatus(w http.ResponseWriter, err error) {
+	code, message := codeOK, ""
+	var status *statusError
+	switch {
+	case err == nil:
+	case errors.As(err, &status):
+		code, message = status.code, status.message
+	case errors.Is(err, context.DeadlineExceeded):
+		code, message = codeDeadlineExceeded, err.Error()
+	case errors.Is(err, context.Canceled):
+		code, message = codeCanceled, err.Error()
+	default:
+		code, message = codeUnknown, err.Error()
+	}
+	w.Header().Set(http.TrailerPrefix+"Grpc-Status", strconv.Itoa(code))
+	if message != "" {
+		w.Header().Set(http.TrailerPrefix+"Grpc-Message", percentEncode(message))
+	}
+}
+
+// percentEncode escapes a status message as gRPC requires: every byte outside printable
+// ASCII, and the percent sign itself
+func percentEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c > 0x7e || c == '%' {
+			fmt.Fprintf(&b, "%%%02X", c)
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// parseTimeout parses the grpc-timeout header, e.g. "100m" for 100 milliseconds
+func parseTimeout(s string) (time.Duration, error) {
+	units := map[byte]time.Duration{
+		'H': time.Hour, 'M': time.Minute, 'S': time.Second,
+		'm': time.Millisecond, 'u': time.Microsecond, 'n': time.Nanosecond,
+	}
+	if len(s) < 2 || len(s) > 9 {
+		return 0, fmt.Errorf("invalid timeout %q", s)
+	}
+	unit, ok := units[s[len(s)-1]]
+	n, err := strconv.ParseInt(s[:len(s)-1], 10, 64)
+	if !ok || err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid timeout %q", s)
+	}
+	return time.Duration(n) * unit, nil
+}
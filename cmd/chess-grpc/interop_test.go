@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// The messages of proto.go and the framing of grpc.go are checked against the protobuf
+// and gRPC libraries, with the messages described by chess.proto itself
+
+// compileProto returns the descriptor of chess.proto
+func compileProto(t *testing.T) protoreflect.FileDescriptor {
+	t.Helper()
+	compiler := protocompile.Compiler{Resolver: &protocompile.SourceResolver{}}
+	files, err := compiler.Compile(context.Background(), "chess.proto")
+	if err != nil {
+		t.Fatalf("unexpected error compiling chess.proto: %s", err)
+	}
+	return files[0]
+}
+
+// dynamic returns an empty message of chess.proto
+func dynamic(t *testing.T, fd protoreflect.FileDescriptor, name string) *dynamicpb.Message {
+	t.Helper()
+	md := fd.Messages().ByName(protoreflect.Name(name))
+	if md == nil {
+		t.Fatalf("no message %s in chess.proto", name)
+	}
+	return dynamicpb.NewMessage(md)
+}
+
+func TestProtoInterop(t *testing.T) {
+	fd := compileProto(t)
+	for _, test := range []struct {
+		name    string
+		message message
+	}{
+		{"Game", &game{ID: "abc", Position: &position{FEN: chess.StartFEN, Variant: "Standard", Turn: chess.BLACK, Check: true, Result: "*"}, Moves: []*move{{"e2e4", "e4"}, {"c7c5", "c5"}}, Tags: map[string]string{"White": "Alice", "Black": "Bob"}}},
+		{"NewGameRequest", &newGameRequest{FEN: chess.StartFEN, Variant: "atomic", Tags: map[string]string{"Event": "Test"}}},
+		{"MakeMoveRequest", &makeMoveRequest{GameID: "abc", Move: "Nf3"}},
+		{"LegalMovesRequest", &legalMovesRequest{GameID: "abc", FEN: chess.StartFEN, Variant: "koth"}},
+		{"LegalMovesResponse", &legalMovesResponse{Position: &position{Turn: chess.BLACK}, Moves: []*move{{"e7e5", "e5"}}}},
+		{"AnalyseRequest", &analyseRequest{GameID: "abc", FEN: chess.StartFEN, Variant: "horde", Depth: 4, Nodes: 1 << 40, MoveTimeMs: 1500}},
+		{"AnalysisInfo", &analysisInfo{Depth: 3, Score: -120, Mate: -2, Nodes: 1234, TimeMs: 56, PV: []*move{{"e2e4", "e4"}}, BestMove: &move{"e2e4", "e4"}}},
+	} {
+		// Decoded by the library, the message encodes back to the same bytes, so no
+		// field was skipped as unknown or written with the wrong type, and decodes to
+		// the same message
+		data := test.message.marshal()
+		m := dynamic(t, fd, test.name)
+		if err := proto.Unmarshal(data, m); err != nil {
+			t.Errorf("unexpected error decoding %s: %s", test.name, err)
+			continue
+		}
+		if len(m.GetUnknown()) != 0 {
+			t.Errorf("%s has fields missing from chess.proto: %x", test.name, m.GetUnknown())
+		}
+		encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+		if err != nil {
+			t.Errorf("unexpected error encoding %s: %s", test.name, err)
+			continue
+		}
+		if !bytes.Equal(encoded, data) {
+			t.Errorf("expected %s to encode as %x, actual: %x", test.name, encoded, data)
+		}
+		decoded := reflect.New(reflect.TypeOf(test.message).Elem()).Interface().(message)
+		if err := decoded.unmarshal(encoded); err != nil {
+			t.Errorf("unexpected error decoding %s from the library: %s", test.name, err)
+		} else if !reflect.DeepEqual(decoded, test.message) {
+			t.Errorf("expected %+v, actual: %+v", test.message, decoded)
+		}
+	}
+
+	// Spot check the values as the library reads them
+	m := dynamic(t, fd, "AnalysisInfo")
+	proto.Unmarshal((&analysisInfo{Score: -120, BestMove: &move{"e2e4", "e4"}}).marshal(), m)
+	fields := m.Descriptor().Fields()
+	if score := m.Get(fields.ByName("score")).Int(); score != -120 {
+		t.Errorf("expected a score of -120, actual: %d", score)
+	}
+	if san := m.Get(fields.ByName("best_move")).Message().Get(fd.Messages().ByName("Move").Fields().ByName("san")).String(); san != "e4" {
+		t.Errorf("expected the best move e4, actual: %s", san)
+	}
+}
+
+func TestGRPCInterop(t *testing.T) {
+	fd := compileProto(t)
+	s := newTestServer(t)
+	defer s.http.Close()
+
+	config := s.client.Transport.(*http.Transport).TLSClientConfig.Clone()
+	conn, err := grpc.NewClient(strings.TrimPrefix(s.http.URL, "https://"), grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	if err != nil {
+		t.Fatalf("unexpected error connecting: %s", err)
+	}
+	defer conn.Close()
+	ctx := context.Background()
+	service := "/" + string(fd.Services().Get(0).FullName()) + "/"
+
+	req := dynamic(t, fd, "NewGameRequest")
+	tags := req.Mutable(req.Descriptor().Fields().ByName("tags")).Map()
+	tags.Set(protoreflect.ValueOfString("White").MapKey(), protoreflect.ValueOfString("Alice"))
+	g := dynamic(t, fd, "Game")
+	if err := conn.Invoke(ctx, service+"NewGame", req, g); err != nil {
+		t.Fatalf("unexpected error creating a game: %s", err)
+	}
+	id := g.Get(g.Descriptor().Fields().ByName("id")).String()
+	if white := g.Get(g.Descriptor().Fields().ByName("tags")).Map().Get(protoreflect.ValueOfString("White").MapKey()).String(); id == "" || white != "Alice" {
+		t.Errorf("unexpected game: %v", g)
+	}
+
+	play := dynamic(t, fd, "MakeMoveRequest")
+	play.Set(play.Descriptor().Fields().ByName("game_id"), protoreflect.ValueOfString(id))
+	play.Set(play.Descriptor().Fields().ByName("move"), protoreflect.ValueOfString("e4"))
+	g = dynamic(t, fd, "Game")
+	if err := conn.Invoke(ctx, service+"MakeMove", play, g); err != nil {
+		t.Fatalf("unexpected error playing e4: %s", err)
+	}
+	moves := g.Get(g.Descriptor().Fields().ByName("moves")).List()
+	pos := g.Get(g.Descriptor().Fields().ByName("position")).Message()
+	turn := pos.Get(pos.Descriptor().Fields().ByName("turn")).Enum()
+	if moves.Len() != 1 || turn != 1 {
+		t.Errorf("expected black to move after e4, actual: %v", g)
+	}
+
+	// Errors arrive as status codes
+	play.Set(play.Descriptor().Fields().ByName("move"), protoreflect.ValueOfString("e4"))
+	err = conn.Invoke(ctx, service+"MakeMove", play, dynamic(t, fd, "Game"))
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected an illegal move to be rejected, actual: %v", err)
+	}
+	req.Set(req.Descriptor().Fields().ByName("variant"), protoreflect.ValueOfString("bogus"))
+	err = conn.Invoke(ctx, service+"NewGame", req, dynamic(t, fd, "Game"))
+	if st, _ := status.FromError(err); st.Code() != codes.InvalidArgument || st.Message() != `unknown variant "bogus"` {
+		t.Errorf("expected an unknown variant to be rejected, actual: %v", err)
+	}
+
+	analyse := dynamic(t, fd, "AnalyseRequest")
+	analyse.Set(analyse.Descriptor().Fields().ByName("fen"), protoreflect.ValueOfString("rnbqkbnr/pppp1ppp/8/4p3/6P1/5P2/PPPPP2P/RNBQKBNR b KQkq - 0 2"))
+	analyse.Set(analyse.Descriptor().Fields().ByName("depth"), protoreflect.ValueOfInt32(2))
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, service+"Analyse")
+	if err != nil {
+		t.Fatalf("unexpected error starting an analysis: %s", err)
+	}
+	if err := stream.SendMsg(analyse); err != nil {
+		t.Fatalf("unexpected error sending the analysis request: %s", err)
+	}
+	stream.CloseSend()
+	var infos []*dynamicpb.Message
+	for {
+		info := dynamic(t, fd, "AnalysisInfo")
+		if err := stream.RecvMsg(info); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("unexpected error reading the analysis: %s", err)
+		}
+		infos = append(infos, info)
+	}
+	if len(infos) != 3 {
+		t.Fatalf("expected an update for each iteration and the best move, actual: %d", len(infos))
+	}
+	final := infos[2]
+	best := final.Get(final.Descriptor().Fields().ByName("best_move")).Message()
+	if san := best.Get(best.Descriptor().Fields().ByName("san")).String(); san != "Qh4#" {
+		t.Errorf("expected Qh4#, actual: %v", final)
+	}
+}
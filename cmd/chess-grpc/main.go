@@ -0,0 +1,27 @@
+// Command chess-grpc serves the gRPC service defined in chess.proto: creating games,
+// playing moves, listing legal moves and streaming analysis. Games are kept in memory.
+//
+// gRPC runs over HTTP/2, which the standard library serves over TLS, so a certificate
+// and key are required:
+//
+//	chess-grpc -addr :9090 -cert server.crt -key server.key
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+)
+
+func main() {
+	addr := flag.String("addr", ":9090", "address to listen on")
+	cert := flag.String("cert", "", "TLS certificate file")
+	key := flag.String("key", "", "TLS key file")
+	flag.Parse()
+	if *cert == "" || *key == "" {
+		log.Fatal("a TLS certificate and key are required, see -h")
+	}
+
+	log.Printf("listening on %s", *addr)
+	log.Fatal(http.ListenAndServeTLS(*addr, *cert, *key, newServer()))
+}
@@ -0,0 +1,458 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// The messages of chess.proto and their protobuf wire encoding, see
+// https://protobuf.dev/programming-guides/encoding/. Fields with default values are left
+// out and unknown fields are skipped, as generated code does.
+
+// message is a protobuf message
+type message interface {
+	marshal() []byte
+	unmarshal(b []byte) error
+}
+
+// Wire types
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// protoWriter appends fields in the wire format
+type protoWriter []byte
+
+func (w *protoWriter) tag(field, wireType int) {
+	*w = appendVarint(*w, uint64(field<<3|wireType))
+}
+
+func (w *protoWriter) uint(field int, v uint64) {
+	if v != 0 {
+		w.tag(field, wireVarint)
+		*w = appendVarint(*w, v)
+	}
+}
+
+// int writes a signed integer, which like int32 and int64 fields takes ten bytes when
+// negative
+func (w *protoWriter) int(field int, v int64) {
+	w.uint(field, uint64(v))
+}
+
+func (w *protoWriter) bool(field int, v bool) {
+	if v {
+		w.uint(field, 1)
+	}
+}
+
+func (w *protoWriter) bytes(field int, b []byte) {
+	w.tag(field, wireBytes)
+	*w = appendVarint(*w, uint64(len(b)))
+	*w = append(*w, b...)
+}
+
+func (w *protoWriter) string(field int, s string) {
+	if s != "" {
+		w.bytes(field, []byte(s))
+	}
+}
+
+// stringMap writes a map as repeated entries with the key in field 1 and value in field
+// 2, sorted by key so the encoding is deterministic
+func (w *protoWriter) stringMap(field int, m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var entry protoWriter
+		entry.string(1, k)
+		entry.string(2, m[k])
+		w.bytes(field, entry)
+	}
+}
+
+func appendVarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+// readFields calls fn with each field of an encoded message: its number, its value if
+// it's a varint and its bytes if it's length-delimited
+func readFields(b []byte, fn func(field int, v uint64, data []byte) error) error {
+	for len(b) > 0 {
+		tag, n := binary.Uvarint(b)
+		if n <= 0 {
+			return fmt.Errorf("proto: invalid field tag")
+		}
+		b = b[n:]
+		field, wireType := int(tag>>3), int(tag&7)
+		var v uint64
+		var data []byte
+		switch wireType {
+		case wireVarint:
+			if v, n = binary.Uvarint(b); n <= 0 {
+				return fmt.Errorf("proto: invalid varint in field %d", field)
+			}
+			b = b[n:]
+		case wireBytes:
+			length, n := binary.Uvarint(b)
+			if n <= 0 || length > uint64(len(b)-n) {
+				return fmt.Errorf("proto: invalid length of field %d", field)
+			}
+			data, b = b[n:n+int(length)], b[n+int(length):]
+		case wireFixed64, wireFixed32:
+			size := 8
+			if wireType == wireFixed32 {
+				size = 4
+			}
+			if len(b) < size {
+				return fmt.Errorf("proto: truncated field %d", field)
+			}
+			b = b[size:]
+			continue
+		default:
+			return fmt.Errorf("proto: unsupported wire type %d in field %d", wireType, field)
+		}
+		if err := fn(field, v, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readMapEntry reads an entry of a map<string, string> into the map
+func readMapEntry(m map[string]string, data []byte) error {
+	var key, value string
+	err := readFields(data, func(field int, v uint64, data []byte) error {
+		switch field {
+		case 1:
+			key = string(data)
+		case 2:
+			value = string(data)
+		}
+		return nil
+	})
+	m[key] = value
+	return err
+}
+
+//-----------------------------------------------------------------------------
+// Messages
+//-----------------------------------------------------------------------------
+
+type position struct {
+	FEN     string
+	Variant string
+	Turn    chess.Color
+	Check   bool
+	Result  string
+}
+
+func (p *position) marshal() []byte {
+	var w protoWriter
+	w.string(1, p.FEN)
+	w.string(2, p.Variant)
+	w.uint(3, uint64(p.Turn))
+	w.bool(4, p.Check)
+	w.string(5, p.Result)
+	return w
+}
+
+func (p *position) unmarshal(b []byte) error {
+	return readFields(b, func(field int, v uint64, data []byte) error {
+		switch field {
+		case 1:
+			p.FEN = string(data)
+		case 2:
+			p.Variant = string(data)
+		case 3:
+			p.Turn = chess.Color(v)
+		case 4:
+			p.Check = v != 0
+		case 5:
+			p.Result = string(data)
+		}
+		return nil
+	})
+}
+
+type move struct {
+	UCI string
+	SAN string
+}
+
+func (m *move) marshal() []byte {
+	var w protoWriter
+	w.string(1, m.UCI)
+	w.string(2, m.SAN)
+	return w
+}
+
+func (m *move) unmarshal(b []byte) error {
+	return readFields(b, func(field int, v uint64, data []byte) error {
+		switch field {
+		case 1:
+			m.UCI = string(data)
+		case 2:
+			m.SAN = string(data)
+		}
+		return nil
+	})
+}
+
+// readMove appends a move read from its encoding to a list of moves
+func readMove(moves *[]*move, data []byte) error {
+	m := &move{}
+	*moves = append(*moves, m)
+	return m.unmarshal(data)
+}
+
+type game struct {
+	ID       string
+	Position *position
+	Moves    []*move
+	Tags     map[string]string
+}
+
+func (g *game) marshal() []byte {
+	var w protoWriter
+	w.string(1, g.ID)
+	if g.Position != nil {
+		w.bytes(2, g.Position.marshal())
+	}
+	for _, m := range g.Moves {
+		w.bytes(3, m.marshal())
+	}
+	w.stringMap(4, g.Tags)
+	return w
+}
+
+func (g *game) unmarshal(b []byte) error {
+	return readFields(b, func(field int, v uint64, data []byte) error {
+		switch field {
+		case 1:
+			g.ID = string(data)
+		case 2:
+			g.Position = &position{}
+			return g.Position.unmarshal(data)
+		case 3:
+			return readMove(&g.Moves, data)
+		case 4:
+			if g.Tags == nil {
+				g.Tags = map[string]string{}
+			}
+			return readMapEntry(g.Tags, data)
+		}
+		return nil
+	})
+}
+
+type newGameRequest struct {
+	FEN     string
+	Variant string
+	Tags    map[string]string
+}
+
+func (r *newGameRequest) marshal() []byte {
+	var w protoWriter
+	w.string(1, r.FEN)
+	w.string(2, r.Variant)
+	w.stringMap(3, r.Tags)
+	return w
+}
+
+func (r *newGameRequest) unmarshal(b []byte) error {
+	return readFields(b, func(field int, v uint64, data []byte) error {
+		switch field {
+		case 1:
+			r.FEN = string(data)
+		case 2:
+			r.Variant = string(data)
+		case 3:
+			if r.Tags == nil {
+				r.Tags = map[string]string{}
+			}
+			return readMapEntry(r.Tags, data)
+		}
+		return nil
+	})
+}
+
+type makeMoveRequest struct {
+	GameID string
+	Move   string
+}
+
+func (r *makeMoveRequest) marshal() []byte {
+	var w protoWriter
+	w.string(1, r.GameID)
+	w.string(2, r.Move)
+	return w
+}
+
+func (r *makeMoveRequest) unmarshal(b []byte) error {
+	return readFields(b, func(field int, v uint64, data []byte) error {
+		switch field {
+		case 1:
+			r.GameID = string(data)
+		case 2:
+			r.Move = string(data)
+		}
+		return nil
+	})
+}
+
+type legalMovesRequest struct {
+	GameID  string
+	FEN     string
+	Variant string
+}
+
+func (r *legalMovesRequest) marshal() []byte {
+	var w protoWriter
+	w.string(1, r.GameID)
+	w.string(2, r.FEN)
+	w.string(3, r.Variant)
+	return w
+}
+
+func (r *legalMovesRequest) unmarshal(b []byte) error {
+	return readFields(b, func(field int, v uint64, data []byte) error {
+		switch field {
+		case 1:
+			r.GameID = string(data)
+		case 2:
+			r.FEN = string(data)
+		case 3:
+			r.Variant = string(data)
+		}
+		return nil
+	})
+}
+
+type legalMovesResponse struct {
+	Position *position
+	Moves    []*move
+}
+
+func (r *legalMovesResponse) marshal() []byte {
+	var w protoWriter
+	if r.Position != nil {
+		w.bytes(1, r.Position.marshal())
+	}
+	for _, m := range r.Moves {
+		w.bytes(2, m.marshal())
+	}
+	return w
+}
+
+func (r *legalMovesResponse) unmarshal(b []byte) error {
+	return readFields(b, func(field int, v uint64, data []byte) error {
+		switch field {
+		case 1:
+			r.Position = &position{}
+			return r.Position.unmarshal(data)
+		case 2:
+			return readMove(&r.Moves, data)
+		}
+		return nil
+	})
+}
+
+type analyseRequest struct {
+	GameID     string
+	FEN        string
+	Variant    string
+	Depth      int32
+	Nodes      int64
+	MoveTimeMs int64
+}
+
+func (r *analyseRequest) marshal() []byte {
+	var w protoWriter
+	w.string(1, r.GameID)
+	w.string(2, r.FEN)
+	w.string(3, r.Variant)
+	w.int(4, int64(r.Depth))
+	w.int(5, r.Nodes)
+	w.int(6, r.MoveTimeMs)
+	return w
+}
+
+func (r *analyseRequest) unmarshal(b []byte) error {
+	return readFields(b, func(field int, v uint64, data []byte) error {
+		switch field {
+		case 1:
+			r.GameID = string(data)
+		case 2:
+			r.FEN = string(data)
+		case 3:
+			r.Variant = string(data)
+		case 4:
+			r.Depth = int32(v)
+		case 5:
+			r.Nodes = int64(v)
+		case 6:
+			r.MoveTimeMs = int64(v)
+		}
+		return nil
+	})
+}
+
+type analysisInfo struct {
+	Depth    int32
+	Score    int32
+	Mate     int32
+	Nodes    int64
+	TimeMs   int64
+	PV       []*move
+	BestMove *move
+}
+
+func (a *analysisInfo) marshal() []byte {
+	var w protoWriter
+	w.int(1, int64(a.Depth))
+	w.int(2, int64(a.Score))
+	w.int(3, int64(a.Mate))
+	w.int(4, a.Nodes)
+	w.int(5, a.TimeMs)
+	for _, m := range a.PV {
+		w.bytes(6, m.marshal())
+	}
+	if a.BestMove != nil {
+		w.bytes(7, a.BestMove.marshal())
+	}
+	return w
+}
+
+func (a *analysisInfo) unmarshal(b []byte) error {
+	return readFields(b, func(field int, v uint64, data []byte) error {
+		switch field {
+		case 1:
+			a.Depth = int32(v)
+		case 2:
+			a.Score = int32(v)
+		case 3:
+			a.Mate = int32(v)
+		case 4:
+			a.Nodes = int64(v)
+		case 5:
+			a.TimeMs = int64(v)
+		case 6:
+			return readMove(&a.PV, data)
+		case 7:
+			a.BestMove = &move{}
+			return a.BestMove.unmarshal(data)
+		}
+		return nil
+	})
+}
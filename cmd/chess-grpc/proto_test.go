@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+func TestMarshal(t *testing.T) {
+	for _, test := range []struct {
+		message  message
+		expected []byte
+	}{
+		{&move{UCI: "e2e4"}, []byte("\x0a\x04e2e4")},
+		{&position{Turn: chess.BLACK, Check: true}, []byte{0x18, 0x01, 0x20, 0x01}},
+		{&analysisInfo{Score: -5}, []byte{0x10, 0xfb, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+		{&newGameRequest{Tags: map[string]string{"White": "A"}}, []byte("\x1a\x0a\x0a\x05White\x12\x01A")},
+		{&game{}, nil},
+	} {
+		if actual := test.message.marshal(); !bytes.Equal(actual, test.expected) {
+			t.Errorf("unexpected encoding of %+v: %x", test.message, actual)
+		}
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	for _, m := range []message{
+		&game{ID: "abc", Position: &position{FEN: chess.StartFEN, Variant: "Standard", Result: "*"}, Moves: []*move{{"e2e4", "e4"}, {"c7c5", "c5"}}, Tags: map[string]string{"White": "Alice", "Black": "Bob"}},
+		&newGameRequest{FEN: chess.StartFEN, Variant: "atomic", Tags: map[string]string{"Event": "Test"}},
+		&makeMoveRequest{GameID: "abc", Move: "Nf3"},
+		&legalMovesRequest{FEN: chess.StartFEN, Variant: "koth"},
+		&legalMovesResponse{Position: &position{Turn: chess.BLACK}, Moves: []*move{{"e7e5", "e5"}}},
+		&analyseRequest{GameID: "abc", Depth: 4, Nodes: 1 << 40, MoveTimeMs: 1500},
+		&analysisInfo{Depth: 3, Score: -120, Mate: -2, Nodes: 1234, TimeMs: 56, PV: []*move{{"e2e4", "e4"}}, BestMove: &move{"e2e4", "e4"}},
+	} {
+		decoded := reflect.New(reflect.TypeOf(m).Elem()).Interface().(message)
+		if err := decoded.unmarshal(m.marshal()); err != nil {
+			t.Errorf("unexpected error decoding %+v: %s", m, err)
+		} else if !reflect.DeepEqual(decoded, m) {
+			t.Errorf("expected %+v, actual: %+v", m, decoded)
+		}
+	}
+
+	// Unknown fields of every wire type are skipped
+	var m move
+	data := []byte("\x0a\x04e2e4\x18\x96\x01\x21\x01\x02\x03\x04\x05\x06\x07\x08\x2d\x01\x02\x03\x04\x32\x01x\x12\x02e4")
+	if err := m.unmarshal(data); err != nil || m != (move{"e2e4", "e4"}) {
+		t.Errorf("expected unknown fields to be skipped, actual: %+v %v", m, err)
+	}
+	for _, data := range []string{"\x0a\x05e2e4", "\x0a", "\x80", "\x0b", "\x21\x01"} {
+		if err := m.unmarshal([]byte(data)); err == nil {
+			t.Errorf("expected an error decoding %x", data)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/engine"
+)
+
+// service implements chess.v1.ChessService, keeping games in memory
+type service struct {
+	mu    sync.Mutex
+	games map[string]*entry
+}
+
+// entry is a game being played along with a board of its current position
+type entry struct {
+	game  *chess.Game
+	board *chess.Board
+}
+
+// newServer returns the gRPC server of the service
+func newServer() *rpcServer {
+	s := &service{games: map[string]*entry{}}
+	return &rpcServer{
+		service: "chess.v1.ChessService",
+		methods: map[string]method{
+			"NewGame": unary(func() message { return &newGameRequest{} }, func(req message) (message, error) {
+				return s.newGame(req.(*newGameRequest))
+			}),
+			"MakeMove": unary(func() message { return &makeMoveRequest{} }, func(req message) (message, error) {
+				return s.makeMove(req.(*makeMoveRequest))
+			}),
+			"LegalMoves": unary(func() message { return &legalMovesRequest{} }, func(req message) (message, error) {
+				return s.legalMoves(req.(*legalMovesRequest))
+			}),
+			"Analyse": {func() message { return &analyseRequest{} }, func(ctx context.Context, req message, send func(message) error) error {
+				return s.analyse(ctx, req.(*analyseRequest), send)
+			}},
+		},
+	}
+}
+
+// reservedTags describe the position and result of a game, so they're set from the
+// game rather than by the client
+var reservedTags = map[string]bool{"FEN": true, "SetUp": true, "Variant": true, "Result": true}
+
+// newGame starts a game
+func (s *service) newGame(req *newGameRequest) (*game, error) {
+	for name := range req.Tags {
+		if reservedTags[name] {
+			return nil, errorf(codeInvalidArgument, "the %s tag can't be set", name)
+		}
+	}
+	v, err := chess.VariantByName(req.Variant)
+	if err != nil {
+		return nil, errorf(codeInvalidArgument, "%s", err)
+	}
+	e := &entry{}
+	e.game, err = chess.NewGame(chess.WithVariant(v), chess.WithFEN(req.FEN))
+	if err == nil {
+		e.board, err = e.game.StartingPosition()
+	}
+	if err != nil {
+		return nil, errorf(codeInvalidArgument, "%s", err)
+	}
+	for name, value := range req.Tags {
+		e.game.Tags[name] = value
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := newID()
+	s.games[id] = e
+	return e.message(id), nil
+}
+
+// makeMove plays a move in a game
+func (s *service) makeMove(req *makeMoveRequest) (*game, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.games[req.GameID]
+	if !ok {
+		return nil, errorf(codeNotFound, "no game %q", req.GameID)
+	}
+	if e.game.Result != chess.InProgress {
+		return nil, errorf(codeFailedPrecondition, "the game is over: %s", e.game.Result)
+	}
+	m, err := e.board.ParseMove(req.Move)
+	if err != nil {
+		return nil, errorf(codeInvalidArgument, "%s", err)
+	}
+	e.board.MakeMove(m)
+	e.game.Moves = append(e.game.Moves, m)
+	e.game.Result = e.board.Result()
+	return e.message(req.GameID), nil
+}
+
+// legalMoves lists the moves in a game's position or the position requested
+func (s *service) legalMoves(req *legalMovesRequest) (*legalMovesResponse, error) {
+	board, err := s.board(req.GameID, req.FEN, req.Variant)
+	if err != nil {
+		return nil, err
+	}
+	res := &legalMovesResponse{Position: describe(board)}
+	if res.Position.Result == chess.InProgress {
+		for _, m := range board.LegalMoves() {
+			res.Moves = append(res.Moves, &move{m.UCI(), board.SAN(m)})
+		}
+	}
+	return res, nil
+}
+
+// analyse searches a position, sending the progress of each iteration and then the
+// best move
+func (s *service) analyse(ctx context.Context, req *analyseRequest, send func(message) error) error {
+	board, err := s.board(req.GameID, req.FEN, req.Variant)
+	if err != nil {
+		return err
+	}
+	limits := engine.Limits{
+		Depth:    int(req.Depth),
+		Nodes:    req.Nodes,
+		MoveTime: time.Duration(req.MoveTimeMs) * time.Millisecond,
+	}
+	if limits == (engine.Limits{}) {
+		limits.Depth = 5
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var sendErr error
+	e := engine.New()
+	e.OnInfo = func(info engine.Info) {
+		if sendErr == nil {
+			if sendErr = send(analysis(board, info)); sendErr != nil {
+				cancel()
+			}
+		}
+	}
+	result, err := e.Search(ctx, board, limits)
+	switch {
+	case err == engine.ErrNoMoves:
+		return errorf(codeFailedPrecondition, "the game is over")
+	case err != nil:
+		return errorf(codeInternal, "%s", err)
+	case sendErr != nil:
+		return sendErr
+	}
+	final := analysis(board, result.Info)
+	final.BestMove = &move{result.Move.UCI(), board.SAN(result.Move)}
+	return send(final)
+}
+
+// board returns a copy of a game's board, or the board for a position
+func (s *service) board(id, fen, variant string) (*chess.Board, error) {
+	if id != "" {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		e, ok := s.games[id]
+		if !ok {
+			return nil, errorf(codeNotFound, "no game %q", id)
+		}
+		return e.board.Copy(), nil
+	}
+	v, err := chess.VariantByName(variant)
+	if err != nil {
+		return nil, errorf(codeInvalidArgument, "%s", err)
+	}
+	board, err := chess.NewBoardWith(chess.WithVariant(v), chess.WithFEN(fen))
+	if err != nil {
+		return nil, errorf(codeInvalidArgument, "%s", err)
+	}
+	return board, nil
+}
+
+// newID returns a random identifier for a game
+func newID() string {
+	id := make([]byte, 6)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// message returns the game as a protobuf message
+func (e *entry) message(id string) *game {
+	g := &game{ID: id, Position: describe(e.board), Tags: e.game.Tags}
+	board, _ := e.game.StartingPosition()
+	for _, m := range e.game.Moves {
+		g.Moves = append(g.Moves, &move{m.UCI(), board.SAN(m)})
+		board.MakeMove(m)
+	}
+	return g
+}
+
+// describe returns the position on a board
+func describe(board *chess.Board) *position {
+	return &position{
+		FEN:     board.FEN(),
+		Variant: board.VariantName(),
+		Turn:    board.Turn,
+		Check:   board.InCheck(),
+		Result:  board.Result(),
+	}
+}
+
+// analysis returns the progress of a search as a protobuf message
+func analysis(board *chess.Board, info engine.Info) *analysisInfo {
+	a := &analysisInfo{
+		Depth:  int32(info.Depth),
+		Score:  int32(info.Score),
+		Mate:   int32(info.Mate),
+		Nodes:  info.Nodes,
+		TimeMs: int64(info.Time / time.Millisecond),
+	}
+	board = board.Copy()
+	for _, m := range info.PV {
+		a.PV = append(a.PV, &move{m.UCI(), board.SAN(m)})
+		board.MakeMove(m)
+	}
+	return a
+}
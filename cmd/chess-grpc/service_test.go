@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// testServer serves the service over HTTP/2 on a local listener
+type testServer struct {
+	t      *testing.T
+	http   *httptest.Server
+	client *http.Client
+}
+
+func newTestServer(t *testing.T) *testServer {
+	ts := httptest.NewUnstartedServer(newServer())
+	ts.TLS = &tls.Config{NextProtos: []string{"h2"}}
+	ts.StartTLS()
+	client := ts.Client()
+	client.Transport.(*http.Transport).ForceAttemptHTTP2 = true
+	return &testServer{t, ts, client}
+}
+
+// invoke calls a method, decoding its replies with newReply and returning the status
+func (s *testServer) invoke(name string, req message, timeout string, newReply func() message) ([]message, int, string) {
+	s.t.Helper()
+	var body bytes.Buffer
+	writeMessage(&body, req.marshal())
+	r, _ := http.NewRequest(http.MethodPost, s.http.URL+"/chess.v1.ChessService/"+name, &body)
+	r.Header.Set("Content-Type", "application/grpc+proto")
+	r.Header.Set("TE", "trailers")
+	if timeout != "" {
+		r.Header.Set("Grpc-Timeout", timeout)
+	}
+	res, err := s.client.Do(r)
+	if err != nil {
+		s.t.Fatalf("unexpected error calling %s: %s", name, err)
+	}
+	defer res.Body.Close()
+	if res.ProtoMajor != 2 || res.Header.Get("Content-Type") != "application/grpc" {
+		s.t.Fatalf("expected a gRPC response over HTTP/2, actual: %s %s", res.Proto, res.Header.Get("Content-Type"))
+	}
+
+	var replies []message
+	for {
+		data, err := readMessage(res.Body)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			s.t.Fatalf("unexpected error reading a reply to %s: %s", name, err)
+		}
+		reply := newReply()
+		if err := reply.unmarshal(data); err != nil {
+			s.t.Fatalf("unexpected error decoding a reply to %s: %s", name, err)
+		}
+		replies = append(replies, reply)
+	}
+	code, err := strconv.Atoi(res.Trailer.Get("Grpc-Status"))
+	if err != nil {
+		s.t.Fatalf("expected a status, actual trailers: %v", res.Trailer)
+	}
+	message, _ := url.PathUnescape(res.Trailer.Get("Grpc-Message"))
+	return replies, code, message
+}
+
+func (s *testServer) play(id, san string) (*game, int, string) {
+	s.t.Helper()
+	replies, code, message := s.invoke("MakeMove", &makeMoveRequest{id, san}, "", func() message { return &game{} })
+	if len(replies) == 0 {
+		return nil, code, message
+	}
+	return replies[0].(*game), code, message
+}
+
+func TestService(t *testing.T) {
+	s := newTestServer(t)
+	defer s.http.Close()
+
+	replies, code, _ := s.invoke("NewGame", &newGameRequest{Tags: map[string]string{"White": "Alice"}}, "", func() message { return &game{} })
+	if code != codeOK || len(replies) != 1 {
+		t.Fatalf("unexpected status creating a game: %d", code)
+	}
+	g := replies[0].(*game)
+	if g.ID == "" || g.Position.Turn != 0 || g.Position.Result != "*" || g.Tags["White"] != "Alice" || len(g.Moves) != 0 {
+		t.Errorf("unexpected game: %+v %+v", g, g.Position)
+	}
+
+	for _, san := range []string{"f3", "e7e5", "g4"} {
+		if _, code, message := s.play(g.ID, san); code != codeOK {
+			t.Fatalf("unexpected error playing %s: %d %s", san, code, message)
+		}
+	}
+	if _, code, message := s.play(g.ID, "Qh5#"); code != codeInvalidArgument || message == "" {
+		t.Errorf("expected an illegal move to be rejected, actual: %d %s", code, message)
+	}
+
+	replies, _, _ = s.invoke("LegalMoves", &legalMovesRequest{GameID: g.ID}, "", func() message { return &legalMovesResponse{} })
+	legal := replies[0].(*legalMovesResponse)
+	if legal.Position.Turn != 1 || len(legal.Moves) != 30 {
+		t.Errorf("unexpected legal moves: %+v %d", legal.Position, len(legal.Moves))
+	}
+
+	replies, code, _ = s.invoke("Analyse", &analyseRequest{GameID: g.ID, Depth: 2}, "", func() message { return &analysisInfo{} })
+	if code != codeOK || len(replies) != 3 {
+		t.Fatalf("expected an update for each iteration and the best move, actual: %d %d", code, len(replies))
+	}
+	final := replies[2].(*analysisInfo)
+	if replies[0].(*analysisInfo).BestMove != nil || replies[1].(*analysisInfo).Depth != 2 || final.BestMove == nil || *final.BestMove != (move{"d8h4", "Qh4#"}) || final.Mate != 1 {
+		t.Errorf("expected mate in 1, actual: %+v", final)
+	}
+
+	updated, code, _ := s.play(g.ID, "Qh4#")
+	if code != codeOK || updated.Position.Result != "0-1" || !updated.Position.Check || len(updated.Moves) != 4 || updated.Moves[3].SAN != "Qh4#" {
+		t.Errorf("expected black to win, actual: %d %+v", code, updated)
+	}
+	if _, code, message := s.play(g.ID, "Kf2"); code != codeFailedPrecondition {
+		t.Errorf("expected no moves after the game is over, actual: %d %s", code, message)
+	}
+	if _, code, _ := s.invoke("Analyse", &analyseRequest{GameID: g.ID}, "", func() message { return &analysisInfo{} }); code != codeFailedPrecondition {
+		t.Errorf("expected no analysis after the game is over, actual: %d", code)
+	}
+}
+
+func TestServiceErrors(t *testing.T) {
+	s := newTestServer(t)
+	defer s.http.Close()
+	newGame := func() message { return &game{} }
+
+	if _, code, message := s.invoke("NewGame", &newGameRequest{Variant: "bogus"}, "", newGame); code != codeInvalidArgument || message != `unknown variant "bogus"` {
+		t.Errorf("expected an unknown variant to be rejected, actual: %d %s", code, message)
+	}
+	if _, code, _ := s.invoke("NewGame", &newGameRequest{FEN: "8/8 w"}, "", newGame); code != codeInvalidArgument {
+		t.Errorf("expected an invalid FEN to be rejected, actual: %d", code)
+	}
+	if _, code, message := s.invoke("NewGame", &newGameRequest{Tags: map[string]string{"White": "Alice", "FEN": chess.StartFEN}}, "", newGame); code != codeInvalidArgument || message != "the FEN tag can't be set" {
+		t.Errorf("expected a reserved tag to be rejected, actual: %d %s", code, message)
+	}
+	if _, code, _ := s.play("missing", "e4"); code != codeNotFound {
+		t.Errorf("expected a missing game, actual: %d", code)
+	}
+	if _, code, _ := s.invoke("Resign", &makeMoveRequest{}, "", newGame); code != codeUnimplemented {
+		t.Errorf("expected an unknown method, actual: %d", code)
+	}
+
+	replies, _, _ := s.invoke("NewGame", &newGameRequest{Variant: "horde"}, "", newGame)
+	if g := replies[0].(*game); g.Position.Variant != "Horde" {
+		t.Errorf("expected a game of Horde, actual: %+v", g.Position)
+	}
+	replies, _, _ = s.invoke("LegalMoves", &legalMovesRequest{FEN: "7k/5Q2/6K1/8/8/8/8/8 b - - 0 1"}, "", func() message { return &legalMovesResponse{} })
+	if legal := replies[0].(*legalMovesResponse); legal.Position.Result != "1/2-1/2" || len(legal.Moves) != 0 {
+		t.Errorf("expected stalemate, actual: %+v", legal.Position)
+	}
+
+	// A deep search is stopped by the deadline, after reporting the iterations completed
+	replies, code, _ := s.invoke("Analyse", &analyseRequest{Depth: 30}, "200m", func() message { return &analysisInfo{} })
+	if code != codeDeadlineExceeded || len(replies) == 0 {
+		t.Errorf("expected the search to stop at the deadline, actual: %d %d", code, len(replies))
+	}
+	if _, code, _ := s.invoke("Analyse", &analyseRequest{}, "soon", func() message { return &analysisInfo{} }); code != codeInvalidArgument {
+		t.Errorf("expected an invalid timeout to be rejected, actual: %d", code)
+	}
+
+	res, err := s.client.Post(s.http.URL+"/chess.v1.ChessService/NewGame", "application/json", nil)
+	if err != nil || res.StatusCode != http.StatusUnsupportedMediaType {
+		t.Errorf("expected a request that isn't gRPC to be rejected, actual: %v %v", res, err)
+	}
+}
+
+func TestPercentEncode(t *testing.T) {
+	if s := percentEncode("100% déjà\n"); s != "100%25 d%C3%A9j%C3%A0%0A" {
+		t.Errorf("unexpected encoding: %s", s)
+	}
+	if d, err := parseTimeout("1500m"); err != nil || d.Seconds() != 1.5 {
+		t.Errorf("unexpected timeout: %s %v", d, err)
+	}
+}
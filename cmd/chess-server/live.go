@@ -0,0 +1,548 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/clock"
+)
+
+// Live games are played over a WebSocket at /live/{id}. Every message is a JSON object
+// with a type. A connection starts by joining the game:
+//
+//	{"type": "join", "role": "white"}
+//	{"type": "join", "role": "black", "token": "…", "from": 12}
+//	{"type": "join", "role": "spectator"}
+//
+// Taking a seat returns a token which lets the player reconnect later. The server replies
+// with a joined event holding the starting position and the number of plies played, then
+// replays each move after the ply given by from, so clients that lose their connection
+// only miss what they haven't seen. A client which is ahead of the game, e.g. after a
+// takeback, gets every move again. Players then send:
+//
+//	{"type": "move", "move": "e4"}       a move in SAN or UCI
+//	{"type": "draw"}                     offer a draw, or accept the opponent's offer
+//	{"type": "takeback"}                 ask to take back a move, or accept the opponent's request
+//	{"type": "decline"}                  decline the opponent's offer
+//	{"type": "resign"}
+//
+// Everyone in the game receives move, clock, offer, declined, takeback and result events.
+// Clients that fall too far behind reading them are disconnected. Once a game is over
+// it's kept for a while, then removed and anyone still connected is disconnected.
+
+// newLiveGame is the request to create a live game
+type newLiveGame struct {
+	newGame
+	TimeControl string `json:"timeControl"` // In seconds as in PGN, e.g. "300+3", untimed if empty
+}
+
+// liveGame is a game played over WebSockets by two players and watched by spectators
+type liveGame struct {
+	*game
+	id     string
+	start  string       // FEN of the starting position
+	clock  *clock.Clock // nil for untimed games
+	reason string       // How the game ended
+	done   chan struct{}
+
+	mu         sync.Mutex
+	seats      [2]seat
+	offers     [2]offer
+	spectators map[*wsConn]bool
+}
+
+// seat is a player's place in a game, reserved by the token given when they joined
+type seat struct {
+	token string
+	conn  *wsConn // nil while the player is disconnected
+}
+
+// offer is a pending draw offer or takeback request
+type offer struct {
+	kind string // "draw" or "takeback", empty if there's no offer
+	ply  int    // The ply a takeback returns to
+}
+
+// command is a message sent by a client
+type command struct {
+	Type  string `json:"type"`
+	Role  string `json:"role,omitempty"`
+	Token string `json:"token,omitempty"`
+	From  int    `json:"from,omitempty"`
+	Move  string `json:"move,omitempty"`
+}
+
+// event is a message sent to the players and spectators of a game. Ply is the number of
+// plies played once the event has happened.
+type event struct {
+	Type    string      `json:"type"`
+	Ply     int         `json:"ply"`
+	Role    string      `json:"role,omitempty"`
+	Token   string      `json:"token,omitempty"`
+	FEN     string      `json:"fen,omitempty"`
+	UCI     string      `json:"uci,omitempty"`
+	SAN     string      `json:"san,omitempty"`
+	Clock   *clockState `json:"clock,omitempty"`
+	Offer   string      `json:"offer,omitempty"`
+	By      string      `json:"by,omitempty"`
+	Result  string      `json:"result,omitempty"`
+	Reason  string      `json:"reason,omitempty"`
+	Message string      `json:"message,omitempty"`
+}
+
+// clockState is the time left for each player in milliseconds
+type clockState struct {
+	White   int64  `json:"white"`
+	Black   int64  `json:"black"`
+	Running string `json:"running,omitempty"` // Color whose clock is running
+}
+
+// liveState is a live game's state served over plain HTTP
+type liveState struct {
+	gameState
+	Clock  *clockState `json:"clock,omitempty"`
+	Reason string      `json:"reason,omitempty"`
+}
+
+// handleLiveGames creates a live game
+func (s *server) handleLiveGames(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodPost) {
+		return
+	}
+	var req newLiveGame
+	if r.ContentLength != 0 && !decode(w, r, &req) {
+		return
+	}
+	g, err := createGame(req.newGame)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	live := &liveGame{
+		game:       g,
+		id:         newID(),
+		start:      g.board.FEN(),
+		done:       make(chan struct{}),
+		spectators: map[*wsConn]bool{},
+	}
+	if req.TimeControl != "" {
+		tc, err := clock.ParseTimeControl(req.TimeControl)
+		if err == nil {
+			live.clock, err = clock.NewClock(tc, s.source)
+		}
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		if s.tick > 0 {
+			go live.run(s.tick)
+		}
+	}
+
+	s.mu.Lock()
+	s.live[live.id] = live
+	retain := s.retain
+	s.mu.Unlock()
+	go s.expire(live, retain)
+
+	w.Header().Set("Location", "/live/"+live.id)
+	writeJSON(w, http.StatusCreated, live.state())
+}
+
+// expire forgets a live game once it has been over for the given time, disconnecting
+// anyone still in it
+func (s *server) expire(g *liveGame, retain time.Duration) {
+	<-g.done
+	time.Sleep(retain)
+	s.mu.Lock()
+	delete(s.live, g.id)
+	s.mu.Unlock()
+	g.disconnect()
+}
+
+// handleLiveGame connects to a live game over a WebSocket, or serves its state over HTTP
+func (s *server) handleLiveGame(w http.ResponseWriter, r *http.Request) {
+	id := strings.Trim(strings.TrimPrefix(r.URL.Path, "/live/"), "/")
+	s.mu.Lock()
+	g, ok := s.live[id]
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no game %q", id))
+		return
+	}
+
+	if !isWebSocket(r) {
+		if allow(w, r, http.MethodGet) {
+			writeJSON(w, http.StatusOK, g.state())
+		}
+		return
+	}
+	conn, err := upgrade(w, r)
+	if err != nil {
+		return
+	}
+	g.serve(conn)
+}
+
+// state returns the game's state
+func (g *liveGame) state() liveState {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return liveState{g.game.state(g.id), g.clockState(), g.reason}
+}
+
+// serve reads a client's commands until it disconnects
+func (g *liveGame) serve(conn *wsConn) {
+	defer conn.Close()
+	var join command
+	if err := conn.ReadJSON(&join); err != nil || join.Type != "join" {
+		conn.Send(event{Type: "error", Message: "expected to join the game"})
+		return
+	}
+	role, err := g.join(conn, join)
+	if err != nil {
+		conn.Send(event{Type: "error", Message: err.Error()})
+		return
+	}
+	defer g.leave(conn)
+
+	for {
+		message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var cmd command
+		if err := json.Unmarshal(message, &cmd); err != nil {
+			conn.Send(event{Type: "error", Message: fmt.Sprintf("invalid command: %s", err)})
+			continue
+		}
+		g.handle(conn, role, cmd)
+	}
+}
+
+// join seats a player, or adds a spectator, and catches the connection up with the game
+func (g *liveGame) join(conn *wsConn, cmd command) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	joined := event{Type: "joined", Ply: len(g.Moves), Role: cmd.Role, FEN: g.start}
+	switch cmd.Role {
+	case "white", "black":
+		seat := &g.seats[colorOf(cmd.Role)]
+		if seat.token == "" {
+			seat.token = newID() + newID()
+		} else if cmd.Token != seat.token {
+			return "", fmt.Errorf("%s is already taken", cmd.Role)
+		}
+		if seat.conn != nil && seat.conn != conn {
+			go seat.conn.Close()
+		}
+		seat.conn = conn
+		joined.Token = seat.token
+	case "spectator", "":
+		joined.Role = "spectator"
+		g.spectators[conn] = true
+	default:
+		return "", fmt.Errorf("unknown role %q", cmd.Role)
+	}
+	conn.Send(joined)
+
+	from := cmd.From
+	if from < 0 || from > len(g.Moves) {
+		from = 0
+	}
+	board, _ := g.StartingPosition()
+	for i, m := range g.Moves {
+		if i >= from {
+			e := event{Type: "move", Ply: i + 1, UCI: m.UCI(), SAN: board.SAN(m)}
+			board.MakeMove(m)
+			e.FEN = board.FEN()
+			conn.Send(e)
+		} else {
+			board.MakeMove(m)
+		}
+	}
+	if g.clock != nil {
+		conn.Send(event{Type: "clock", Ply: len(g.Moves), Clock: g.clockState()})
+	}
+	for c, o := range g.offers {
+		if o.kind != "" {
+			conn.Send(event{Type: "offer", Ply: len(g.Moves), Offer: o.kind, By: chess.Color(c).String()})
+		}
+	}
+	if g.Result != chess.InProgress {
+		conn.Send(event{Type: "result", Ply: len(g.Moves), Result: g.Result, Reason: g.reason})
+	}
+	return joined.Role, nil
+}
+
+// leave forgets a connection once it's closed, keeping a player's seat for them
+func (g *liveGame) leave(conn *wsConn) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for i := range g.seats {
+		if g.seats[i].conn == conn {
+			g.seats[i].conn = nil
+		}
+	}
+	delete(g.spectators, conn)
+}
+
+// handle carries out a player's command, replying with an error if it can't be done
+func (g *liveGame) handle(conn *wsConn, role string, cmd command) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var err error
+	color := colorOf(role)
+	switch {
+	case role == "spectator":
+		err = fmt.Errorf("spectators can't %s", cmd.Type)
+	case g.Result != chess.InProgress || g.flagged():
+		err = fmt.Errorf("the game is over")
+	case cmd.Type == "move":
+		err = g.move(color, cmd.Move)
+	case cmd.Type == "draw", cmd.Type == "takeback":
+		err = g.offer(color, cmd.Type)
+	case cmd.Type == "decline":
+		err = g.decline(color)
+	case cmd.Type == "resign":
+		g.end(winner(color.Opponent()), "resignation")
+	default:
+		err = fmt.Errorf("unknown command %q", cmd.Type)
+	}
+	if err != nil {
+		conn.Send(event{Type: "error", Ply: len(g.Moves), Message: err.Error()})
+	}
+}
+
+// move plays a player's move and presses their clock, ending the game if it's over
+func (g *liveGame) move(color chess.Color, move string) error {
+	if g.seats[color.Opponent()].token == "" {
+		return fmt.Errorf("waiting for an opponent")
+	}
+	if g.board.Turn != color {
+		return fmt.Errorf("it's not your turn")
+	}
+	m, err := g.board.ParseMove(move)
+	if err != nil {
+		return err
+	}
+
+	if g.clock != nil {
+		if err := g.press(color); err != nil {
+			g.flagged()
+			return err
+		}
+	}
+	e := event{Type: "move", Ply: len(g.Moves) + 1, UCI: m.UCI(), SAN: g.board.SAN(m)}
+	g.board.MakeMove(m)
+	g.Moves = append(g.Moves, m)
+	g.offers = [2]offer{}
+	e.FEN, e.Clock = g.board.FEN(), g.clockState()
+	g.broadcast(e)
+
+	if result := g.board.Result(); result != chess.InProgress {
+		g.end(result, g.outcome())
+	}
+	return nil
+}
+
+// press presses the player's clock, starting it for the first move, which may be black's
+func (g *liveGame) press(color chess.Color) error {
+	if !g.clock.Running() {
+		if g.clock.Turn() != color {
+			if err := g.clock.Switch(); err != nil {
+				return err
+			}
+		}
+		if err := g.clock.Start(); err != nil {
+			return err
+		}
+	}
+	return g.clock.Press()
+}
+
+// offer offers a draw or asks to take back the player's last move, accepting the
+// opponent's offer if they've made the same one
+func (g *liveGame) offer(color chess.Color, kind string) error {
+	theirs := g.offers[color.Opponent()]
+	if theirs.kind == kind {
+		if kind == "draw" {
+			g.end(chess.Draw, "agreement")
+		} else {
+			g.takeback(theirs.ply)
+		}
+		return nil
+	}
+	if g.offers[color].kind == kind {
+		return fmt.Errorf("you've already offered a %s", kind)
+	}
+
+	o := offer{kind: kind}
+	if kind == "takeback" {
+		// Take back the opponent's reply along with the player's move if they've made one
+		o.ply = len(g.Moves) - 1
+		if g.board.Turn == color {
+			o.ply--
+		}
+		if o.ply < 0 {
+			return fmt.Errorf("no moves to take back")
+		}
+	}
+	g.offers[color] = o
+	g.broadcast(event{Type: "offer", Ply: len(g.Moves), Offer: kind, By: color.String()})
+	return nil
+}
+
+// decline declines the opponent's offer
+func (g *liveGame) decline(color chess.Color) error {
+	theirs := g.offers[color.Opponent()]
+	if theirs.kind == "" {
+		return fmt.Errorf("no offer to decline")
+	}
+	g.offers[color.Opponent()] = offer{}
+	g.broadcast(event{Type: "declined", Ply: len(g.Moves), Offer: theirs.kind, By: color.String()})
+	return nil
+}
+
+// takeback takes back moves until the game is back at the given ply, handing the clock
+// back to the player to move
+func (g *liveGame) takeback(ply int) {
+	for len(g.Moves) > ply {
+		g.board.UnmakeMove()
+		g.Moves = g.Moves[:len(g.Moves)-1]
+	}
+	if g.clock != nil && g.clock.Running() {
+		if g.clock.Turn() != g.board.Turn {
+			g.clock.Switch()
+		}
+		if ply == 0 {
+			g.clock.Pause()
+		}
+	}
+	g.offers = [2]offer{}
+	g.broadcast(event{Type: "takeback", Ply: ply, FEN: g.board.FEN(), Clock: g.clockState()})
+}
+
+// end ends the game, stopping the clock
+func (g *liveGame) end(result, reason string) {
+	g.Result, g.reason = result, reason
+	g.offers = [2]offer{}
+	if g.clock != nil {
+		g.clock.Pause()
+	}
+	close(g.done)
+	g.broadcast(event{Type: "result", Ply: len(g.Moves), Result: result, Reason: reason, Clock: g.clockState()})
+}
+
+// outcome describes how the game on the board ended
+func (g *liveGame) outcome() string {
+	switch {
+	case g.board.IsCheckmate():
+		return "checkmate"
+	case g.board.IsStalemate():
+		return "stalemate"
+	case g.board.Variant == nil && g.board.IsInsufficientMaterial():
+		return "insufficient material"
+	}
+	return strings.ToLower(g.board.VariantName())
+}
+
+// flagged checks whether the player to move has run out of time, ending the game if so
+func (g *liveGame) flagged() bool {
+	if g.clock == nil || g.Result != chess.InProgress {
+		return false
+	}
+	color, flagged := g.clock.Flagged()
+	if flagged {
+		g.end(winner(color.Opponent()), "timeout")
+	}
+	return flagged
+}
+
+// tick sends the clocks to everyone in the game, ending it if a player has run out of time
+func (g *liveGame) tick() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.clock == nil || !g.clock.Running() || g.flagged() {
+		return
+	}
+	g.broadcast(event{Type: "clock", Ply: len(g.Moves), Clock: g.clockState()})
+}
+
+// run ticks the game's clock at an interval until the game ends
+func (g *liveGame) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-g.done:
+			return
+		case <-ticker.C:
+			g.tick()
+		}
+	}
+}
+
+// clockState returns the time left on the clock, or nil for untimed games
+func (g *liveGame) clockState() *clockState {
+	if g.clock == nil {
+		return nil
+	}
+	state := &clockState{
+		White: int64(g.clock.Remaining(chess.WHITE) / time.Millisecond),
+		Black: int64(g.clock.Remaining(chess.BLACK) / time.Millisecond),
+	}
+	if g.clock.Running() {
+		state.Running = g.clock.Turn().String()
+	}
+	return state
+}
+
+// broadcast queues an event for the players and spectators
+func (g *liveGame) broadcast(e event) {
+	for _, seat := range g.seats {
+		if seat.conn != nil {
+			seat.conn.Send(e)
+		}
+	}
+	for conn := range g.spectators {
+		conn.Send(e)
+	}
+}
+
+// disconnect closes the connections of the players and spectators
+func (g *liveGame) disconnect() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, seat := range g.seats {
+		if seat.conn != nil {
+			go seat.conn.Close()
+		}
+	}
+	for conn := range g.spectators {
+		go conn.Close()
+	}
+}
+
+// colorOf returns the color of a player's role
+func colorOf(role string) chess.Color {
+	if role == "black" {
+		return chess.BLACK
+	}
+	return chess.WHITE
+}
+
+// winner returns the result of a game won by the given color
+func winner(c chess.Color) string {
+	if c == chess.WHITE {
+		return chess.WhiteWins
+	}
+	return chess.BlackWins
+}
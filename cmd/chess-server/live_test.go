@@ -0,0 +1,534 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/clock"
+)
+
+// harness runs a server in-process, with clocks that only move when the test advances
+// them, and connects clients to its live games
+type harness struct {
+	t      *testing.T
+	server *server
+	http   *httptest.Server
+	time   *clock.FakeSource
+}
+
+func newHarness(t *testing.T) *harness {
+	h := &harness{t: t, server: newServer(), time: clock.NewFakeSource(time.Unix(0, 0))}
+	h.server.source, h.server.tick = h.time, 0
+	h.http = httptest.NewServer(h.server)
+	return h
+}
+
+func (h *harness) Close() {
+	h.http.Close()
+}
+
+// create creates a live game, returning its id
+func (h *harness) create(body string) string {
+	h.t.Helper()
+	var state liveState
+	if res := request(h.t, h.http, "POST", "/live", body, &state); res.StatusCode != http.StatusCreated {
+		h.t.Fatalf("unexpected error creating a game: %d", res.StatusCode)
+	}
+	return state.ID
+}
+
+// join connects a client to a game, returning the client and the joined event
+func (h *harness) join(id string, join command) (*client, event) {
+	h.t.Helper()
+	conn, err := dialWebSocket("ws" + strings.TrimPrefix(h.http.URL, "http") + "/live/" + id)
+	if err != nil {
+		h.t.Fatalf("unexpected error connecting: %s", err)
+	}
+	c := &client{h.t, conn}
+	join.Type = "join"
+	c.send(join)
+	return c, c.expect("joined")
+}
+
+// tick advances time and ticks the game's clock
+func (h *harness) tick(id string, d time.Duration) {
+	h.time.Advance(d)
+	h.server.mu.Lock()
+	g := h.server.live[id]
+	h.server.mu.Unlock()
+	g.tick()
+}
+
+// client is a player or spectator connected to a live game
+type client struct {
+	t    *testing.T
+	conn *wsConn
+}
+
+func (c *client) send(cmd command) {
+	c.t.Helper()
+	if err := c.conn.WriteJSON(cmd); err != nil {
+		c.t.Fatalf("unexpected error sending %+v: %s", cmd, err)
+	}
+}
+
+// expect reads the next event, failing unless it has the given type
+func (c *client) expect(kind string) event {
+	c.t.Helper()
+	c.conn.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var e event
+	if err := c.conn.ReadJSON(&e); err != nil {
+		c.t.Fatalf("expected a %s event, actual error: %s", kind, err)
+	}
+	if e.Type != kind {
+		c.t.Fatalf("expected a %s event, actual: %+v", kind, e)
+	}
+	return e
+}
+
+// play sends a move and checks every client sees it
+func play(t *testing.T, mover *client, move string, clients ...*client) event {
+	t.Helper()
+	mover.send(command{Type: "move", Move: move})
+	var e event
+	for _, c := range clients {
+		e = c.expect("move")
+	}
+	return e
+}
+
+func TestLiveGame(t *testing.T) {
+	h := newHarness(t)
+	defer h.Close()
+	id := h.create(`{"timeControl": "60+2", "white": "Alice", "black": "Bob"}`)
+
+	white, joined := h.join(id, command{Role: "white"})
+	if joined.Role != "white" || joined.Token == "" || joined.Ply != 0 || !strings.HasPrefix(joined.FEN, "rnbqkbnr/") {
+		t.Errorf("unexpected joined event: %+v", joined)
+	}
+	white.expect("clock")
+	white.send(command{Type: "move", Move: "e4"})
+	if e := white.expect("error"); e.Message != "waiting for an opponent" {
+		t.Errorf("expected to wait for an opponent, actual: %s", e.Message)
+	}
+	black, _ := h.join(id, command{Role: "black"})
+	black.expect("clock")
+	spectator, joined := h.join(id, command{})
+	spectator.expect("clock")
+	if joined.Role != "spectator" || joined.Token != "" {
+		t.Errorf("unexpected joined event: %+v", joined)
+	}
+
+	black.send(command{Type: "move", Move: "e5"})
+	if e := black.expect("error"); e.Message != "it's not your turn" {
+		t.Errorf("expected black to wait for their turn, actual: %s", e.Message)
+	}
+	e := play(t, white, "e4", white, black, spectator)
+	if e.Ply != 1 || e.SAN != "e4" || e.UCI != "e2e4" || e.Clock == nil || e.Clock.White != 62000 || e.Clock.Running != "black" {
+		t.Errorf("unexpected move event: %+v %+v", e, e.Clock)
+	}
+
+	h.tick(id, 10*time.Second)
+	for _, c := range []*client{white, black, spectator} {
+		if e := c.expect("clock"); e.Clock.Black != 50000 || e.Clock.White != 62000 {
+			t.Errorf("unexpected clock: %+v", e.Clock)
+		}
+	}
+	e = play(t, black, "e7e5", white, black, spectator)
+	if e.Clock.Black != 52000 || e.Clock.Running != "white" || e.FEN != "rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq e6 0 2" {
+		t.Errorf("unexpected move event: %+v %+v", e, e.Clock)
+	}
+
+	spectator.send(command{Type: "move", Move: "Nf3"})
+	if e := spectator.expect("error"); e.Message != "spectators can't move" {
+		t.Errorf("expected spectators not to be able to move, actual: %s", e.Message)
+	}
+	white.send(command{Type: "move", Move: "Ke3"})
+	if e := white.expect("error"); !strings.Contains(e.Message, "Ke3") {
+		t.Errorf("expected an illegal move to be rejected, actual: %s", e.Message)
+	}
+
+	for _, move := range []string{"Bc4", "Nc6", "Qh5", "Nf6"} {
+		mover := white
+		if strings.HasPrefix(move, "N") {
+			mover = black
+		}
+		play(t, mover, move, white, black, spectator)
+	}
+	play(t, white, "Qxf7#", white, black, spectator)
+	for _, c := range []*client{white, black, spectator} {
+		if e := c.expect("result"); e.Result != "1-0" || e.Reason != "checkmate" || e.Ply != 7 {
+			t.Errorf("unexpected result: %+v", e)
+		}
+	}
+	black.send(command{Type: "resign"})
+	if e := black.expect("error"); e.Message != "the game is over" {
+		t.Errorf("expected no commands after the game is over, actual: %s", e.Message)
+	}
+
+	var state liveState
+	request(t, h.http, "GET", "/live/"+id, "", &state)
+	if state.Result != "1-0" || state.Reason != "checkmate" || len(state.Moves) != 7 || state.Clock.Running != "" {
+		t.Errorf("unexpected game state: %+v", state)
+	}
+}
+
+func TestLiveReconnect(t *testing.T) {
+	h := newHarness(t)
+	defer h.Close()
+	id := h.create("")
+
+	white, joined := h.join(id, command{Role: "white"})
+	token := joined.Token
+	black, _ := h.join(id, command{Role: "black"})
+	for _, move := range []string{"d4", "d5", "c4"} {
+		mover := white
+		if move == "d5" {
+			mover = black
+		}
+		play(t, mover, move, white, black)
+	}
+	black.send(command{Type: "draw"})
+	white.expect("offer")
+	black.expect("offer")
+
+	intruder, _ := h.join(id, command{})
+	intruder.expect("move")
+	intruder.conn.Close()
+	if conn, err := dialWebSocket("ws" + strings.TrimPrefix(h.http.URL, "http") + "/live/" + id); err == nil {
+		c := &client{t, conn}
+		c.send(command{Type: "join", Role: "white", Token: "guess"})
+		if e := c.expect("error"); e.Message != "white is already taken" {
+			t.Errorf("expected the seat to be taken, actual: %s", e.Message)
+		}
+	}
+
+	// Resuming from the first ply replays the rest of the game and the pending offer
+	again, joined := h.join(id, command{Role: "white", Token: token, From: 1})
+	if joined.Token != token || joined.Ply != 3 {
+		t.Errorf("unexpected joined event: %+v", joined)
+	}
+	var replayed []string
+	for i := 0; i < 2; i++ {
+		replayed = append(replayed, again.expect("move").SAN)
+	}
+	if strings.Join(replayed, " ") != "d5 c4" {
+		t.Errorf("expected the moves after the first ply, actual: %v", replayed)
+	}
+	if e := again.expect("offer"); e.Offer != "draw" || e.By != "black" {
+		t.Errorf("expected black's draw offer, actual: %+v", e)
+	}
+	if _, err := white.conn.ReadMessage(); err == nil {
+		t.Errorf("expected the old connection to be closed")
+	}
+
+	// A client that is ahead of the game is sent every move
+	resumed, _ := h.join(id, command{From: 10})
+	if e := resumed.expect("move"); e.Ply != 1 {
+		t.Errorf("expected the game from the start, actual: %+v", e)
+	}
+	play(t, black, "e6", again, black)
+}
+
+func TestLiveOffers(t *testing.T) {
+	h := newHarness(t)
+	defer h.Close()
+	id := h.create(`{"timeControl": "300"}`)
+	white, _ := h.join(id, command{Role: "white"})
+	black, _ := h.join(id, command{Role: "black"})
+	white.expect("clock")
+	black.expect("clock")
+
+	white.send(command{Type: "takeback"})
+	if e := white.expect("error"); e.Message != "no moves to take back" {
+		t.Errorf("expected nothing to take back, actual: %s", e.Message)
+	}
+	play(t, white, "e4", white, black)
+	h.time.Advance(5 * time.Second)
+	play(t, black, "c5", white, black)
+	h.time.Advance(7 * time.Second)
+
+	// Black asks to take back their move, white declines
+	black.send(command{Type: "takeback"})
+	if e := white.expect("offer"); e.Offer != "takeback" || e.By != "black" {
+		t.Errorf("unexpected offer: %+v", e)
+	}
+	black.expect("offer")
+	white.send(command{Type: "decline"})
+	white.expect("declined")
+	if e := black.expect("declined"); e.Offer != "takeback" || e.By != "white" {
+		t.Errorf("unexpected decline: %+v", e)
+	}
+	white.send(command{Type: "decline"})
+	if e := white.expect("error"); e.Message != "no offer to decline" {
+		t.Errorf("expected no offer to decline, actual: %s", e.Message)
+	}
+
+	// White asks to take back e4 along with black's reply, black accepts
+	white.send(command{Type: "takeback"})
+	white.expect("offer")
+	black.expect("offer")
+	black.send(command{Type: "takeback"})
+	for _, c := range []*client{white, black} {
+		e := c.expect("takeback")
+		if e.Ply != 0 || !strings.HasPrefix(e.FEN, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w") || e.Clock.Running != "" {
+			t.Errorf("unexpected takeback: %+v %+v", e, e.Clock)
+		}
+		if e.Clock.White != 293000 || e.Clock.Black != 295000 {
+			t.Errorf("expected the time spent to be kept, actual: %+v", e.Clock)
+		}
+	}
+
+	play(t, white, "d4", white, black)
+	play(t, black, "d5", white, black)
+	black.send(command{Type: "takeback"})
+	white.expect("offer")
+	black.expect("offer")
+	white.send(command{Type: "takeback"})
+	if e := white.expect("takeback"); e.Ply != 1 || e.Clock.Running != "black" {
+		t.Errorf("expected black's move to be taken back, actual: %+v %+v", e, e.Clock)
+	}
+	black.expect("takeback")
+
+	// Offers lapse once a move is made
+	white.send(command{Type: "draw"})
+	white.expect("offer")
+	black.expect("offer")
+	white.send(command{Type: "draw"})
+	if e := white.expect("error"); e.Message != "you've already offered a draw" {
+		t.Errorf("expected a repeated offer to be rejected, actual: %s", e.Message)
+	}
+	play(t, black, "Nf6", white, black)
+	white.send(command{Type: "draw"})
+	white.expect("offer")
+	black.expect("offer")
+	black.send(command{Type: "draw"})
+	if e := white.expect("result"); e.Result != "1/2-1/2" || e.Reason != "agreement" {
+		t.Errorf("expected a draw by agreement, actual: %+v", e)
+	}
+}
+
+func TestLiveTimeout(t *testing.T) {
+	h := newHarness(t)
+	defer h.Close()
+	id := h.create(`{"timeControl": "60"}`)
+	white, _ := h.join(id, command{Role: "white"})
+	black, _ := h.join(id, command{Role: "black"})
+	white.expect("clock")
+	black.expect("clock")
+
+	play(t, white, "e4", white, black)
+	h.tick(id, 30*time.Second)
+	white.expect("clock")
+	black.expect("clock")
+	h.tick(id, 31*time.Second)
+	if e := white.expect("result"); e.Result != "1-0" || e.Reason != "timeout" || e.Clock.Black != 0 {
+		t.Errorf("expected black to lose on time, actual: %+v %+v", e, e.Clock)
+	}
+	black.expect("result")
+
+	id = h.create(`{"timeControl": "60"}`)
+	white, _ = h.join(id, command{Role: "white"})
+	black, _ = h.join(id, command{Role: "black"})
+	white.expect("clock")
+	black.expect("clock")
+	play(t, white, "e4", white, black)
+	black.send(command{Type: "resign"})
+	if e := white.expect("result"); e.Result != "1-0" || e.Reason != "resignation" {
+		t.Errorf("expected black to resign, actual: %+v", e)
+	}
+}
+
+func TestLiveBlackToMove(t *testing.T) {
+	h := newHarness(t)
+	defer h.Close()
+	id := h.create(`{"timeControl": "60+2", "fen": "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq - 0 1"}`)
+	white, _ := h.join(id, command{Role: "white"})
+	black, _ := h.join(id, command{Role: "black"})
+	white.expect("clock")
+	black.expect("clock")
+
+	if e := play(t, black, "e5", white, black); e.Clock.White != 60000 || e.Clock.Black != 62000 || e.Clock.Running != "white" {
+		t.Errorf("expected black's clock to run first, actual: %+v", e.Clock)
+	}
+
+	// Taking the move back doesn't charge white or give black another increment
+	h.time.Advance(3 * time.Second)
+	black.send(command{Type: "takeback"})
+	white.expect("offer")
+	black.expect("offer")
+	white.send(command{Type: "takeback"})
+	for _, c := range []*client{white, black} {
+		if e := c.expect("takeback"); e.Clock.White != 60000 || e.Clock.Black != 62000 || e.Clock.Running != "" {
+			t.Errorf("unexpected clock after the takeback: %+v", e.Clock)
+		}
+	}
+}
+
+func TestLiveErrors(t *testing.T) {
+	h := newHarness(t)
+	defer h.Close()
+
+	var e map[string]string
+	if res := request(t, h.http, "POST", "/live", `{"timeControl": "fast"}`, &e); res.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected an invalid time control to be rejected, actual: %d", res.StatusCode)
+	}
+	if res := request(t, h.http, "GET", "/live/missing", "", &e); res.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a missing game, actual: %d", res.StatusCode)
+	}
+
+	id := h.create("")
+	c, _ := h.join(id, command{Role: "spectator"})
+	c.conn.WriteMessage([]byte("{"))
+	if e := c.expect("error"); !strings.HasPrefix(e.Message, "invalid command") {
+		t.Errorf("expected invalid JSON to be rejected, actual: %s", e.Message)
+	}
+	conn, err := dialWebSocket("ws" + strings.TrimPrefix(h.http.URL, "http") + "/live/" + id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.WriteJSON(command{Type: "join", Role: "referee"})
+	var ev event
+	if err := conn.ReadJSON(&ev); err != nil || ev.Message != `unknown role "referee"` {
+		t.Errorf("expected an unknown role to be rejected, actual: %+v %v", ev, err)
+	}
+
+	// Messages may be split across frames and pings are answered
+	c.conn.mu.Lock()
+	c.conn.conn.Write(maskedFrame(opText, false, []byte(`{"type":`)))
+	c.conn.conn.Write(maskedFrame(opPing, true, []byte("hello")))
+	c.conn.conn.Write(maskedFrame(opContinuation, true, []byte(`"resign"}`)))
+	c.conn.mu.Unlock()
+	fin, opcode, payload, err := c.conn.readFrame()
+	if err != nil || !fin || opcode != opPong || string(payload) != "hello" {
+		t.Errorf("expected a pong, actual: %v %d %q %v", fin, opcode, payload, err)
+	}
+	if e := c.expect("error"); e.Message != "spectators can't resign" {
+		t.Errorf("expected the fragmented message to be read, actual: %s", e.Message)
+	}
+
+	// Clients must mask their frames
+	c.conn.mu.Lock()
+	c.conn.conn.Write([]byte("\x81\x02{}"))
+	c.conn.mu.Unlock()
+	c.conn.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := c.conn.ReadMessage(); err != errClosed {
+		t.Errorf("expected an unmasked frame to close the connection, actual: %v", err)
+	}
+
+	// Browsers may only connect from pages served by the same host
+	for origin, status := range map[string]int{"http://evil.example": http.StatusForbidden, h.http.URL: http.StatusSwitchingProtocols} {
+		req, _ := http.NewRequest(http.MethodGet, h.http.URL+"/live/"+id, nil)
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Upgrade", "websocket")
+		req.Header.Set("Sec-WebSocket-Version", "13")
+		req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+		req.Header.Set("Origin", origin)
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != status {
+			t.Errorf("expected %d for origin %s, actual: %d", status, origin, res.StatusCode)
+		}
+	}
+}
+
+func TestLiveMoveAfterFlag(t *testing.T) {
+	source := clock.NewFakeSource(time.Unix(0, 0))
+	g, _ := createGame(newGame{})
+	tc, _ := clock.ParseTimeControl("60")
+	c, _ := clock.NewClock(tc, source)
+	live := &liveGame{game: g, clock: c, done: make(chan struct{}), spectators: map[*wsConn]bool{}}
+	live.seats[chess.WHITE].token, live.seats[chess.BLACK].token = "white", "black"
+
+	if err := live.move(chess.WHITE, "e4"); err != nil {
+		t.Fatal(err)
+	}
+	source.Advance(61 * time.Second)
+	if err := live.move(chess.BLACK, "e5"); err == nil || len(live.Moves) != 1 || live.Result != chess.WhiteWins || live.reason != "timeout" {
+		t.Errorf("expected black to lose on time instead of moving, actual: %v %d %s %s", err, len(live.Moves), live.Result, live.reason)
+	}
+}
+
+func TestLiveExpire(t *testing.T) {
+	h := newHarness(t)
+	defer h.Close()
+	h.server.mu.Lock()
+	h.server.retain = 0
+	h.server.mu.Unlock()
+
+	id := h.create("")
+	white, _ := h.join(id, command{Role: "white"})
+	h.join(id, command{Role: "black"})
+	white.send(command{Type: "resign"})
+	white.expect("result")
+
+	// The game is forgotten once it's over and its clients disconnected
+	white.conn.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := white.conn.ReadMessage(); err != errClosed {
+		t.Errorf("expected the connection to be closed, actual: %v", err)
+	}
+	var e map[string]string
+	if res := request(t, h.http, "GET", "/live/"+id, "", &e); res.StatusCode != http.StatusNotFound {
+		t.Errorf("expected the game to be removed, actual: %d", res.StatusCode)
+	}
+}
+
+func TestSlowConnection(t *testing.T) {
+	server, client := net.Pipe()
+	conn := newServerConn(server, bufio.NewReader(server))
+
+	// Nothing is read, so the writer blocks on the first message and the queue fills up
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < maxQueued+2; i++ {
+			conn.Send(event{Type: "clock"})
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected sending to a slow connection not to block")
+	}
+	client.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := ioutil.ReadAll(client); err != nil {
+		t.Errorf("expected the slow connection to be dropped, actual: %v", err)
+	}
+	conn.Close()
+}
+
+// maskedFrame encodes a small frame the way clients send them
+func maskedFrame(opcode byte, fin bool, payload []byte) []byte {
+	first := opcode
+	if fin {
+		first |= 0x80
+	}
+	mask := []byte{1, 2, 3, 4}
+	frame := append([]byte{first, 0x80 | byte(len(payload))}, mask...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	return frame
+}
+
+func TestAcceptKey(t *testing.T) {
+	// The example from RFC 6455
+	if key := acceptKey("dGhlIHNhbXBsZSBub25jZQ=="); key != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Errorf("unexpected accept key: %s", key)
+	}
+	data, _ := json.Marshal(event{Type: "clock", Clock: &clockState{White: 1, Black: 2}})
+	if string(data) != `{"type":"clock","ply":0,"clock":{"white":1,"black":2}}` {
+		t.Errorf("unexpected event: %s", data)
+	}
+}
@@ -0,0 +1,34 @@
+// Command chess-server serves an HTTP API for playing and inspecting chess games, so
+// clients can rely on pkg/chess for the rules instead of implementing them themselves.
+//
+// Games are kept in memory:
+//
+//	POST   /games                create a game, optionally from a FEN or of a variant
+//	GET    /games/{id}           the position, legal moves and moves played so far
+//	DELETE /games/{id}           forget a game
+//	GET    /games/{id}/moves     the legal moves
+//	POST   /games/{id}/moves     play a move in SAN or UCI
+//	GET    /games/{id}/pgn       the game in PGN
+//	GET    /games/{id}/svg       a diagram of the position
+//	GET    /position?fen=FEN     the legal moves and state of any position
+//	GET    /svg?fen=FEN          a diagram of any position
+//
+// Live games, with server-side clocks and spectators, are played over a WebSocket:
+//
+//	POST   /live                 create a live game, optionally with a time control
+//	GET    /live/{id}            the game's state, or a WebSocket to play or watch it
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	flag.Parse()
+
+	log.Printf("listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, newServer()))
+}
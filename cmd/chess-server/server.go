@@ -0,0 +1,400 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/clock"
+	"github.com/aaronireland/go-chess/pkg/render"
+)
+
+// server is the HTTP API, holding the games being played in memory
+type server struct {
+	mu    sync.Mutex
+	games map[string]*game
+	live  map[string]*liveGame
+	mux   *http.ServeMux
+
+	source clock.Source  // Time read by the clocks of live games
+	tick   time.Duration // How often live games send the clocks, 0 for only after moves
+	retain time.Duration // How long live games are kept once they're over
+}
+
+// game is a game being played along with a board of its current position
+type game struct {
+	mu sync.Mutex // Guards the game while it's in the server's games
+	*chess.Game
+	board *chess.Board
+}
+
+// maxBody is the largest request body accepted, far more than any request needs
+const maxBody = 64 << 10
+
+// newServer returns a server with no games
+func newServer() *server {
+	s := &server{
+		games:  map[string]*game{},
+		live:   map[string]*liveGame{},
+		mux:    http.NewServeMux(),
+		source: clock.SystemSource,
+		tick:   time.Second,
+		retain: 10 * time.Minute,
+	}
+	s.mux.HandleFunc("/games", s.handleGames)
+	s.mux.HandleFunc("/games/", s.handleGame)
+	s.mux.HandleFunc("/position", s.handlePosition)
+	s.mux.HandleFunc("/svg", s.handleSVG)
+	s.mux.HandleFunc("/live", s.handleLiveGames)
+	s.mux.HandleFunc("/live/", s.handleLiveGame)
+	return s
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// position describes a position: whose turn it is, whether the game is over and the
+// moves that can be played
+type position struct {
+	FEN        string      `json:"fen"`
+	Variant    string      `json:"variant"`
+	Turn       string      `json:"turn"`
+	Check      bool        `json:"check"`
+	Result     string      `json:"result"`
+	LastMove   *legalMove  `json:"lastMove,omitempty"`
+	LegalMoves []legalMove `json:"legalMoves"`
+}
+
+// legalMove is a move in both of the notations clients may submit
+type legalMove struct {
+	UCI string `json:"uci"`
+	SAN string `json:"san"`
+}
+
+// gameState is a game's current position along with the moves played to reach it
+type gameState struct {
+	ID string `json:"id"`
+	position
+	Moves []string `json:"moves"`
+}
+
+// describe returns the position on a board
+func describe(board *chess.Board) position {
+	p := position{
+		FEN:        board.FEN(),
+		Variant:    board.VariantName(),
+		Turn:       board.Turn.String(),
+		Check:      board.InCheck(),
+		Result:     board.Result(),
+		LegalMoves: []legalMove{},
+	}
+	if p.Result == chess.InProgress {
+		for _, m := range board.LegalMoves() {
+			p.LegalMoves = append(p.LegalMoves, legalMove{m.UCI(), board.SAN(m)})
+		}
+	}
+	if m, ok := board.LastMove(); ok {
+		board.UnmakeMove()
+		p.LastMove = &legalMove{m.UCI(), board.SAN(m)}
+		board.MakeMove(m)
+	}
+	return p
+}
+
+// state returns the game's current position and moves
+func (g *game) state(id string) gameState {
+	moves, _ := g.SAN()
+	if moves == nil {
+		moves = []string{}
+	}
+	return gameState{id, describe(g.board), moves}
+}
+
+//-----------------------------------------------------------------------------
+// Handlers
+//-----------------------------------------------------------------------------
+
+// newGame is the request to create a game
+type newGame struct {
+	FEN     string `json:"fen"`
+	Variant string `json:"variant"`
+	White   string `json:"white"`
+	Black   string `json:"black"`
+}
+
+// handleGames creates a game
+func (s *server) handleGames(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodPost) {
+		return
+	}
+	var req newGame
+	if r.ContentLength != 0 && !decode(w, r, &req) {
+		return
+	}
+
+	g, err := createGame(req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// The game isn't shared until it's stored, so its state is read without the lock
+	id := newID()
+	state := g.state(id)
+	s.mu.Lock()
+	s.games[id] = g
+	s.mu.Unlock()
+
+	w.Header().Set("Location", "/games/"+id)
+	writeJSON(w, http.StatusCreated, state)
+}
+
+// createGame returns a new game for the request
+func createGame(req newGame) (*game, error) {
+	v, err := chess.VariantByName(req.Variant)
+	if err != nil {
+		return nil, err
+	}
+	g := &game{}
+	if g.Game, err = chess.NewGame(chess.WithVariant(v), chess.WithFEN(req.FEN)); err != nil {
+		return nil, err
+	}
+	if g.board, err = g.StartingPosition(); err != nil {
+		return nil, err
+	}
+	if req.White != "" {
+		g.Tags["White"] = req.White
+	}
+	if req.Black != "" {
+		g.Tags["Black"] = req.Black
+	}
+	return g, nil
+}
+
+// handleGame serves a game and its moves, PGN and diagram
+func (s *server) handleGame(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/games/"), "/"), "/")
+	id, resource := path[0], ""
+	if len(path) > 1 {
+		resource = path[1]
+	}
+	if len(path) > 2 {
+		writeError(w, http.StatusNotFound, fmt.Errorf("not found"))
+		return
+	}
+
+	// The server's lock only guards the map of games; each game has its own lock, held
+	// while it's read or changed but not while the response is written
+	s.mu.Lock()
+	g, ok := s.games[id]
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no game %q", id))
+		return
+	}
+
+	switch resource {
+	case "":
+		if !allow(w, r, http.MethodGet, http.MethodDelete) {
+			return
+		}
+		if r.Method == http.MethodDelete {
+			s.mu.Lock()
+			delete(s.games, id)
+			s.mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		g.mu.Lock()
+		state := g.state(id)
+		g.mu.Unlock()
+		writeJSON(w, http.StatusOK, state)
+	case "moves":
+		if !allow(w, r, http.MethodGet, http.MethodPost) {
+			return
+		}
+		if r.Method == http.MethodGet {
+			g.mu.Lock()
+			moves := describe(g.board).LegalMoves
+			g.mu.Unlock()
+			writeJSON(w, http.StatusOK, moves)
+			return
+		}
+		s.play(w, r, id, g)
+	case "pgn":
+		if !allow(w, r, http.MethodGet) {
+			return
+		}
+		g.mu.Lock()
+		pgn := g.String()
+		g.mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-chess-pgn")
+		fmt.Fprint(w, pgn)
+	case "svg":
+		if !allow(w, r, http.MethodGet) {
+			return
+		}
+		g.mu.Lock()
+		board := g.board.Copy()
+		g.mu.Unlock()
+		writeSVG(w, r, board)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("not found"))
+	}
+}
+
+// move is the request to play a move
+type move struct {
+	Move string `json:"move"`
+}
+
+// play plays a move in SAN or UCI, ending the game if it's over
+func (s *server) play(w http.ResponseWriter, r *http.Request, id string, g *game) {
+	var req move
+	if !decode(w, r, &req) {
+		return
+	}
+	state, status, err := g.play(id, req.Move)
+	if err != nil {
+		writeError(w, status, err)
+		return
+	}
+	writeJSON(w, status, state)
+}
+
+// play plays a move under the game's lock, returning its new state, or the status to
+// respond with and why the move can't be played
+func (g *game) play(id, move string) (gameState, int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.Result != chess.InProgress {
+		return gameState{}, http.StatusConflict, fmt.Errorf("the game is over: %s", g.Result)
+	}
+	m, err := g.board.ParseMove(move)
+	if err != nil {
+		return gameState{}, http.StatusUnprocessableEntity, err
+	}
+	g.board.MakeMove(m)
+	g.Moves = append(g.Moves, m)
+	g.Result = g.board.Result()
+	return g.state(id), http.StatusOK, nil
+}
+
+// handlePosition describes any position, the starting position if none is given
+func (s *server) handlePosition(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodGet) {
+		return
+	}
+	board, err := boardQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, describe(board))
+}
+
+// handleSVG draws any position, the starting position if none is given
+func (s *server) handleSVG(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodGet) {
+		return
+	}
+	board, err := boardQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeSVG(w, r, board)
+}
+
+//-----------------------------------------------------------------------------
+// Helpers
+//-----------------------------------------------------------------------------
+
+// boardQuery returns the board for the fen and variant query parameters
+func boardQuery(r *http.Request) (*chess.Board, error) {
+	query := r.URL.Query()
+	v, err := chess.VariantByName(query.Get("variant"))
+	if err != nil {
+		return nil, err
+	}
+	return chess.NewBoardWith(chess.WithVariant(v), chess.WithFEN(query.Get("fen")))
+}
+
+// writeSVG draws a board, highlighting its last move, with the flip, coords and size
+// query parameters
+func writeSVG(w http.ResponseWriter, r *http.Request, board *chess.Board) {
+	query := r.URL.Query()
+	opts := render.SVGOptions{Check: true}
+	if m, ok := board.LastMove(); ok {
+		opts.LastMove = &m
+	}
+	if flip, _ := strconv.ParseBool(query.Get("flip")); flip {
+		opts.Orientation = chess.BLACK
+	}
+	opts.Coordinates, _ = strconv.ParseBool(query.Get("coords"))
+	if size := query.Get("size"); size != "" {
+		n, err := strconv.Atoi(size)
+		if err != nil || n <= 0 || n > 256 {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid size %q", size))
+			return
+		}
+		opts.SquareSize = n
+	}
+	w.Header().Set("Content-Type", "image/svg+xml")
+	fmt.Fprint(w, render.RenderSVG(board, opts))
+}
+
+// allow checks the request's method is one of those given, responding with 405 Method
+// Not Allowed if it isn't
+func allow(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, method := range methods {
+		if r.Method == method {
+			return true
+		}
+	}
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+	return false
+}
+
+// decode reads a JSON request body of at most maxBody bytes into v, responding with an
+// error and returning false if it can't
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBody)).Decode(v)
+	var tooLarge *http.MaxBytesError
+	switch {
+	case errors.As(err, &tooLarge):
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("request body larger than %d bytes", maxBody))
+	case err != nil:
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %s", err))
+	}
+	return err == nil
+}
+
+// writeJSON responds with a value encoded as JSON
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError responds with an error as a JSON object, e.g. {"error": "illegal move: e5"}
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+// newID returns a random identifier for a game
+func newID() string {
+	id := make([]byte, 6)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// request sends a request to the server, decoding a JSON response into v
+func request(t *testing.T, ts *httptest.Server, method, path, body string, v interface{}) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if v != nil {
+		if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+			t.Fatalf("%s %s: unexpected error decoding the response: %s", method, path, err)
+		}
+	}
+	return res
+}
+
+func TestGame(t *testing.T) {
+	ts := httptest.NewServer(newServer())
+	defer ts.Close()
+
+	var state gameState
+	res := request(t, ts, "POST", "/games", `{"white": "Alice", "black": "Bob"}`, &state)
+	if res.StatusCode != http.StatusCreated || res.Header.Get("Location") != "/games/"+state.ID {
+		t.Fatalf("expected a game to be created, actual: %d %s", res.StatusCode, res.Header.Get("Location"))
+	}
+	if len(state.LegalMoves) != 20 || state.Turn != "white" || state.Result != "*" || len(state.Moves) != 0 {
+		t.Errorf("unexpected starting position: %+v", state)
+	}
+	path := "/games/" + state.ID
+
+	for _, move := range []string{"e4", "e7e5", "Bc4", "Nc6", "Qh5", "Nf6"} {
+		if res := request(t, ts, "POST", path+"/moves", `{"move": "`+move+`"}`, &state); res.StatusCode != http.StatusOK {
+			t.Fatalf("expected %s to be played, actual: %d", move, res.StatusCode)
+		}
+	}
+	if state.LastMove == nil || *state.LastMove != (legalMove{"g8f6", "Nf6"}) || strings.Join(state.Moves, " ") != "e4 e5 Bc4 Nc6 Qh5 Nf6" {
+		t.Errorf("unexpected moves: %v %v", state.LastMove, state.Moves)
+	}
+
+	var e map[string]string
+	if res := request(t, ts, "POST", path+"/moves", `{"move": "Qxf8"}`, &e); res.StatusCode != http.StatusUnprocessableEntity || e["error"] == "" {
+		t.Errorf("expected an illegal move to be rejected, actual: %d %v", res.StatusCode, e)
+	}
+	var moves []legalMove
+	request(t, ts, "GET", path+"/moves", "", &moves)
+	found := false
+	for _, m := range moves {
+		found = found || m == legalMove{"h5f7", "Qxf7#"}
+	}
+	if !found {
+		t.Errorf("expected Qxf7# to be a legal move, actual: %v", moves)
+	}
+
+	request(t, ts, "POST", path+"/moves", `{"move": "Qxf7#"}`, &state)
+	if state.Result != "1-0" || !state.Check || len(state.LegalMoves) != 0 {
+		t.Errorf("expected the game to be over, actual: %+v", state.position)
+	}
+	if res := request(t, ts, "POST", path+"/moves", `{"move": "Ke7"}`, &e); res.StatusCode != http.StatusConflict {
+		t.Errorf("expected no moves after the game is over, actual: %d %v", res.StatusCode, e)
+	}
+
+	res, _ = http.Get(ts.URL + path + "/pgn")
+	pgn, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if !strings.Contains(string(pgn), `[White "Alice"]`) || !strings.Contains(string(pgn), "4. Qxf7# 1-0") {
+		t.Errorf("unexpected PGN:\n%s", pgn)
+	}
+
+	res, _ = http.Get(ts.URL + path + "/svg?flip=true&coords=1")
+	svg, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if res.Header.Get("Content-Type") != "image/svg+xml" || !strings.HasPrefix(string(svg), "<svg") {
+		t.Errorf("expected an SVG diagram, actual: %s", res.Header.Get("Content-Type"))
+	}
+
+	if res := request(t, ts, "DELETE", path, "", nil); res.StatusCode != http.StatusNoContent {
+		t.Errorf("expected the game to be deleted, actual: %d", res.StatusCode)
+	}
+	if res := request(t, ts, "GET", path, "", &e); res.StatusCode != http.StatusNotFound {
+		t.Errorf("expected the game to be gone, actual: %d", res.StatusCode)
+	}
+}
+
+func TestNewGame(t *testing.T) {
+	ts := httptest.NewServer(newServer())
+	defer ts.Close()
+
+	var state gameState
+	request(t, ts, "POST", "/games", `{"fen": "4k3/8/8/8/8/8/8/4K2R w K - 0 1"}`, &state)
+	if state.FEN != "4k3/8/8/8/8/8/8/4K2R w K - 0 1" || len(state.LegalMoves) != 15 {
+		t.Errorf("expected the game to start from the position, actual: %+v", state.position)
+	}
+	request(t, ts, "POST", "/games", `{"variant": "3check"}`, &state)
+	if state.Variant != "Three-check" || !strings.Contains(state.FEN, " 3+3 ") {
+		t.Errorf("expected a game of Three-check, actual: %+v", state.position)
+	}
+	request(t, ts, "POST", "/games", "", &state)
+	if state.Variant != "Standard" || len(state.LegalMoves) != 20 {
+		t.Errorf("expected a standard game without a request body, actual: %+v", state.position)
+	}
+
+	var e map[string]string
+	for _, body := range []string{`{"fen": "8/8/8"}`, `{"variant": "bogus"}`, `{`} {
+		if res := request(t, ts, "POST", "/games", body, &e); res.StatusCode != http.StatusBadRequest || e["error"] == "" {
+			t.Errorf("expected %s to be rejected, actual: %d %v", body, res.StatusCode, e)
+		}
+	}
+	if res := request(t, ts, "GET", "/games", "", &e); res.StatusCode != http.StatusMethodNotAllowed || res.Header.Get("Allow") != "POST" {
+		t.Errorf("expected only POST to be allowed, actual: %d %s", res.StatusCode, res.Header.Get("Allow"))
+	}
+}
+
+func TestPosition(t *testing.T) {
+	ts := httptest.NewServer(newServer())
+	defer ts.Close()
+
+	var p position
+	request(t, ts, "GET", "/position?fen=r3k2r/8/8/8/8/8/8/R3K2R+b+KQkq+-+0+1", "", &p)
+	if p.Turn != "black" || len(p.LegalMoves) != 26 || p.LastMove != nil {
+		t.Errorf("unexpected position: %+v", p)
+	}
+	request(t, ts, "GET", "/position?fen=7k/5Q2/6K1/8/8/8/8/8+b+-+-+0+1", "", &p)
+	if p.Result != "1/2-1/2" || len(p.LegalMoves) != 0 {
+		t.Errorf("expected stalemate, actual: %+v", p)
+	}
+	var e map[string]string
+	if res := request(t, ts, "GET", "/position?fen=nonsense", "", &e); res.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected an invalid FEN to be rejected, actual: %d", res.StatusCode)
+	}
+
+	res, _ := http.Get(ts.URL + "/svg?size=20")
+	svg, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if !strings.HasPrefix(string(svg), `<svg`) || !strings.Contains(string(svg), `width="160"`) {
+		t.Errorf("expected an SVG diagram of the starting position, actual: %.100s", svg)
+	}
+	if res := request(t, ts, "GET", "/svg?size=big", "", &e); res.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected an invalid size to be rejected, actual: %d", res.StatusCode)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	s := newServer()
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	var e map[string]string
+	large := `{"fen": "` + strings.Repeat(" ", maxBody) + `"}`
+	if res := request(t, ts, "POST", "/games", large, &e); res.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected a large request to be rejected, actual: %d %v", res.StatusCode, e)
+	}
+
+	var busy, idle gameState
+	request(t, ts, "POST", "/games", "", &busy)
+	request(t, ts, "POST", "/games", "", &idle)
+	if res := request(t, ts, "POST", "/games/"+idle.ID+"/moves", large, &e); res.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected a large move to be rejected, actual: %d %v", res.StatusCode, e)
+	}
+
+	// A game in use doesn't hold up the others
+	s.mu.Lock()
+	g := s.games[busy.ID]
+	s.mu.Unlock()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	done := make(chan int)
+	go func() {
+		res, err := http.Post(ts.URL+"/games/"+idle.ID+"/moves", "application/json", strings.NewReader(`{"move": "e4"}`))
+		if err != nil {
+			done <- 0
+			return
+		}
+		res.Body.Close()
+		done <- res.StatusCode
+	}()
+	select {
+	case status := <-done:
+		if status != http.StatusOK {
+			t.Errorf("expected the move to be played, actual: %d", status)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("expected a move in another game to be played while a game is in use")
+	}
+}
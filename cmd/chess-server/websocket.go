@@ -0,0 +1,414 @@
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The parts of the WebSocket protocol live games need, see RFC 6455. Messages are
+// text frames of JSON; pings are answered and fragmented messages reassembled.
+
+// websocketGUID is appended to the client's key to compute the handshake's accept key
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// Frame opcodes
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+)
+
+// maxMessageSize limits the size of the messages a connection accepts
+const maxMessageSize = 1 << 20
+
+// writeTimeout limits how long a write may block on a slow peer
+const writeTimeout = 10 * time.Second
+
+// maxQueued is the number of messages that may wait to be sent to a peer before it's
+// dropped for falling behind
+const maxQueued = 1024
+
+// errClosed is returned when reading from a connection the peer has closed
+var errClosed = errors.New("websocket closed")
+
+// wsConn is a WebSocket connection. Messages may be written from any goroutine but only
+// one goroutine may read. Server connections also have a queue of messages sent by their
+// own writer, so a slow peer never holds up the goroutine sending to it.
+type wsConn struct {
+	conn   net.Conn
+	r      *bufio.Reader
+	client bool // Clients mask the frames they send
+
+	mu     sync.Mutex
+	closed bool
+
+	out       chan []byte   // Messages waiting for the writer, nil for clients
+	closing   chan struct{} // Closed to make the writer send what's queued and close
+	done      chan struct{} // Closed once the writer has stopped
+	closeOnce sync.Once
+	dropOnce  sync.Once
+}
+
+// newServerConn returns the server's side of a connection and starts its writer
+func newServerConn(conn net.Conn, r *bufio.Reader) *wsConn {
+	c := &wsConn{
+		conn:    conn,
+		r:       r,
+		out:     make(chan []byte, maxQueued),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go c.writeLoop()
+	return c
+}
+
+// acceptKey returns the Sec-WebSocket-Accept header for a Sec-WebSocket-Key
+func acceptKey(key string) string {
+	h := sha1.New()
+	io.WriteString(h, key+websocketGUID)
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// headerContains checks whether a comma separated header has a token, ignoring case
+func headerContains(h http.Header, name, token string) bool {
+	for _, value := range h[http.CanonicalHeaderKey(name)] {
+		for _, t := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isWebSocket checks whether a request asks to upgrade to the WebSocket protocol
+func isWebSocket(r *http.Request) bool {
+	return headerContains(r.Header, "Connection", "upgrade") && headerContains(r.Header, "Upgrade", "websocket")
+}
+
+// sameOrigin checks that a request made by a browser comes from a page served by this
+// host. Other clients don't send an Origin header and are allowed.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// upgrade completes the server's side of the opening handshake, responding with an
+// error if the request isn't a valid WebSocket handshake
+func upgrade(w http.ResponseWriter, r *http.Request) (*wsConn, error) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	switch {
+	case r.Method != http.MethodGet || !isWebSocket(r):
+		err := fmt.Errorf("expected a WebSocket handshake")
+		writeError(w, http.StatusBadRequest, err)
+		return nil, err
+	case r.Header.Get("Sec-WebSocket-Version") != "13" || key == "":
+		err := fmt.Errorf("unsupported WebSocket version")
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		writeError(w, http.StatusBadRequest, err)
+		return nil, err
+	case !sameOrigin(r):
+		err := fmt.Errorf("origin %s not allowed", r.Header.Get("Origin"))
+		writeError(w, http.StatusForbidden, err)
+		return nil, err
+	}
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		err := fmt.Errorf("connection can't be upgraded")
+		writeError(w, http.StatusInternalServerError, err)
+		return nil, err
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n", acceptKey(key))
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return newServerConn(conn, rw.Reader), nil
+}
+
+// dialWebSocket opens a client connection to a ws:// URL
+func dialWebSocket(rawurl string) (*wsConn, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "ws" {
+		return nil, fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host += ":80"
+	}
+	conn, err := net.Dial("tcp", host)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, 16)
+	rand.Read(nonce)
+	key := base64.StdEncoding.EncodeToString(nonce)
+	req, _ := http.NewRequest(http.MethodGet, "http://"+u.Host+u.RequestURI(), nil)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	r := bufio.NewReader(conn)
+	res, err := http.ReadResponse(r, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if res.StatusCode != http.StatusSwitchingProtocols || res.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		conn.Close()
+		return nil, fmt.Errorf("websocket handshake failed: %s", res.Status)
+	}
+	return &wsConn{conn: conn, r: r, client: true}, nil
+}
+
+// ReadMessage returns the next text or binary message, answering any pings on the way
+func (c *wsConn) ReadMessage() ([]byte, error) {
+	var message []byte
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		switch opcode {
+		case opPing:
+			c.writeFrame(opPong, payload)
+			continue
+		case opPong:
+			continue
+		case opClose:
+			c.Close()
+			return nil, errClosed
+		case opText, opBinary:
+			if message != nil {
+				return nil, fmt.Errorf("websocket: unexpected new message in a fragmented message")
+			}
+			message = payload
+		case opContinuation:
+			if message == nil {
+				return nil, fmt.Errorf("websocket: unexpected continuation frame")
+			}
+			message = append(message, payload...)
+		default:
+			return nil, fmt.Errorf("websocket: unknown opcode %d", opcode)
+		}
+		if len(message) > maxMessageSize {
+			return nil, fmt.Errorf("websocket: message too large")
+		}
+		if fin {
+			return message, nil
+		}
+	}
+}
+
+// readFrame reads a single frame, unmasking its payload. Frames from a client must be
+// masked and frames from the server must not be.
+func (c *wsConn) readFrame() (bool, byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.r, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+	fin, opcode := header[0]&0x80 != 0, header[0]&0x0f
+	masked, length := header[1]&0x80 != 0, uint64(header[1]&0x7f)
+	if masked == c.client {
+		return false, 0, nil, fmt.Errorf("websocket: unexpected masking of a frame")
+	}
+	switch length {
+	case 126:
+		var n [2]byte
+		if _, err := io.ReadFull(c.r, n[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(n[:]))
+	case 127:
+		var n [8]byte
+		if _, err := io.ReadFull(c.r, n[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(n[:])
+	}
+	if length > maxMessageSize {
+		return false, 0, nil, fmt.Errorf("websocket: frame too large")
+	}
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(c.r, mask[:]); err != nil {
+			return false, 0, nil, err
+		}
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.r, payload); err != nil {
+		return false, 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return fin, opcode, payload, nil
+}
+
+// WriteMessage sends a text message
+func (c *wsConn) WriteMessage(message []byte) error {
+	return c.writeFrame(opText, message)
+}
+
+// writeFrame sends a single unfragmented frame
+func (c *wsConn) writeFrame(opcode byte, payload []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return errClosed
+	}
+
+	frame := []byte{0x80 | opcode}
+	maskBit := byte(0)
+	if c.client {
+		maskBit = 0x80
+	}
+	switch n := len(payload); {
+	case n < 126:
+		frame = append(frame, maskBit|byte(n))
+	case n <= 0xffff:
+		frame = append(frame, maskBit|126, byte(n>>8), byte(n))
+	default:
+		frame = append(frame, maskBit|127)
+		frame = append(frame, make([]byte, 8)...)
+		binary.BigEndian.PutUint64(frame[len(frame)-8:], uint64(n))
+	}
+	if c.client {
+		var mask [4]byte
+		rand.Read(mask[:])
+		frame = append(frame, mask[:]...)
+		for i, b := range payload {
+			frame = append(frame, b^mask[i%4])
+		}
+	} else {
+		frame = append(frame, payload...)
+	}
+
+	c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+// ReadJSON reads the next message into a value
+func (c *wsConn) ReadJSON(v interface{}) error {
+	message, err := c.ReadMessage()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(message, v)
+}
+
+// WriteJSON sends a value as a JSON text message
+func (c *wsConn) WriteJSON(v interface{}) error {
+	message, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return c.WriteMessage(message)
+}
+
+// Send queues a value to be sent as a JSON text message without waiting for it to be
+// written, dropping the connection if too many messages are waiting. Clients send it
+// straight away.
+func (c *wsConn) Send(v interface{}) {
+	message, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	if c.out == nil {
+		c.WriteMessage(message)
+		return
+	}
+	select {
+	case <-c.done:
+	case c.out <- message:
+	default:
+		c.drop()
+	}
+}
+
+// writeLoop writes the queued messages until the connection is closed, then sends the
+// messages still queued and closes it
+func (c *wsConn) writeLoop() {
+	defer close(c.done)
+	for {
+		select {
+		case message := <-c.out:
+			if err := c.WriteMessage(message); err != nil {
+				c.drop()
+				return
+			}
+		case <-c.closing:
+			for len(c.out) > 0 {
+				if err := c.WriteMessage(<-c.out); err != nil {
+					break
+				}
+			}
+			c.close()
+			return
+		}
+	}
+}
+
+// drop closes the connection without a close frame or waiting for pending writes
+func (c *wsConn) drop() {
+	c.dropOnce.Do(func() { c.conn.Close() })
+}
+
+// Close sends the queued messages and a close frame, if the connection isn't closed yet,
+// and closes it
+func (c *wsConn) Close() error {
+	if c.out == nil {
+		return c.close()
+	}
+	c.closeOnce.Do(func() { close(c.closing) })
+	<-c.done
+	return nil
+}
+
+// close sends a close frame, if the connection isn't closed yet, and closes it
+func (c *wsConn) close() error {
+	c.writeFrame(opClose, []byte{0x03, 0xe8}) // 1000, normal closure
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.conn.Close()
+}
@@ -0,0 +1,176 @@
+package chess
+
+import "math/bits"
+
+//-----------------------------------------------------------------------------
+// Attack tables
+//-----------------------------------------------------------------------------
+
+// Leaping pieces (knights, kings and pawns) use precomputed tables of the squares
+// they attack from each square. Sliding pieces use rays in each of the eight
+// directions which are cut short at the first blocker, see
+// <https://www.chessprogramming.org/Classical_Approach>.
+
+const (
+	north = iota
+	northEast
+	east
+	southEast
+	south
+	southWest
+	west
+	northWest
+)
+
+var (
+	knightAttacks [64]Bitboard
+	kingAttacks   [64]Bitboard
+	pawnAttacks   [2][64]Bitboard
+	rays          [8][64]Bitboard
+)
+
+// Bitboards of the a and h files and the first and last ranks
+const (
+	FileA Bitboard = 0x0101010101010101
+	FileH Bitboard = 0x8080808080808080
+	Rank1 Bitboard = 0x00000000000000ff
+	Rank8 Bitboard = 0xff00000000000000
+)
+
+func init() {
+	type offset struct{ x, y int }
+	leaps := func(sq int, offsets []offset) Bitboard {
+		var b Bitboard
+		x, y := BitToCartesian(sq)
+		for _, o := range offsets {
+			if tx, ty := x+o.x, y+o.y; tx >= 0 && tx < FILES && ty >= 0 && ty < RANKS {
+				b.SetBit(CartesianToBit(tx, ty))
+			}
+		}
+		return b
+	}
+
+	directions := []offset{{0, 1}, {1, 1}, {1, 0}, {1, -1}, {0, -1}, {-1, -1}, {-1, 0}, {-1, 1}}
+	knight := []offset{{1, 2}, {2, 1}, {2, -1}, {1, -2}, {-1, -2}, {-2, -1}, {-2, 1}, {-1, 2}}
+
+	for sq := 0; sq < RANKS*FILES; sq++ {
+		knightAttacks[sq] = leaps(sq, knight)
+		kingAttacks[sq] = leaps(sq, directions)
+		pawnAttacks[WHITE][sq] = leaps(sq, []offset{{-1, 1}, {1, 1}})
+		pawnAttacks[BLACK][sq] = leaps(sq, []offset{{-1, -1}, {1, -1}})
+
+		for dir, o := range directions {
+			x, y := BitToCartesian(sq)
+			for x, y = x+o.x, y+o.y; x >= 0 && x < FILES && y >= 0 && y < RANKS; x, y = x+o.x, y+o.y {
+				rays[dir][sq].SetBit(CartesianToBit(x, y))
+			}
+		}
+	}
+}
+
+// rayAttacks gives the squares attacked along a ray up to and including the first blocker
+func rayAttacks(dir, sq int, occupied Bitboard) Bitboard {
+	attacks := rays[dir][sq]
+	if blockers := attacks & occupied; blockers != 0 {
+		var blocker int
+		if dir == north || dir == northEast || dir == east || dir == northWest {
+			blocker = bits.TrailingZeros64(uint64(blockers))
+		} else {
+			blocker = 63 - bits.LeadingZeros64(uint64(blockers))
+		}
+		attacks ^= rays[dir][blocker]
+	}
+	return attacks
+}
+
+// KnightAttacks returns the squares attacked by a knight on the given square
+func KnightAttacks(sq int) Bitboard {
+	return knightAttacks[sq]
+}
+
+// KingAttacks returns the squares attacked by a king on the given square
+func KingAttacks(sq int) Bitboard {
+	return kingAttacks[sq]
+}
+
+// PawnAttacks returns the squares attacked by a pawn of the given color on the given square
+func PawnAttacks(color Color, sq int) Bitboard {
+	return pawnAttacks[color][sq]
+}
+
+// RookAttacks returns the squares attacked by a rook on the given square given the occupied squares
+func RookAttacks(sq int, occupied Bitboard) Bitboard {
+	return rayAttacks(north, sq, occupied) | rayAttacks(east, sq, occupied) |
+		rayAttacks(south, sq, occupied) | rayAttacks(west, sq, occupied)
+}
+
+// BishopAttacks returns the squares attacked by a bishop on the given square given the occupied squares
+func BishopAttacks(sq int, occupied Bitboard) Bitboard {
+	return rayAttacks(northEast, sq, occupied) | rayAttacks(southEast, sq, occupied) |
+		rayAttacks(southWest, sq, occupied) | rayAttacks(northWest, sq, occupied)
+}
+
+// QueenAttacks returns the squares attacked by a queen on the given square given the occupied squares
+func QueenAttacks(sq int, occupied Bitboard) Bitboard {
+	return RookAttacks(sq, occupied) | BishopAttacks(sq, occupied)
+}
+
+// Between returns the squares strictly between two squares on the same rank, file or
+// diagonal, or an empty bitboard if they don't share a line
+func Between(from, to int) Bitboard {
+	for dir := range rays {
+		if rays[dir][from].IsBitSet(to) {
+			return rays[dir][from] & ^rays[dir][to] & ^(Bitboard(1) << uint(to))
+		}
+	}
+	return 0
+}
+
+// AttackersTo returns the pieces of both colors attacking the given square given the occupied squares
+func (b *Board) AttackersTo(sq int, occupied Bitboard) Bitboard {
+	diagonal := b.pieces(WHITE, BISHOP) | b.pieces(BLACK, BISHOP) | b.pieces(WHITE, QUEEN) | b.pieces(BLACK, QUEEN)
+	straight := b.pieces(WHITE, ROOK) | b.pieces(BLACK, ROOK) | b.pieces(WHITE, QUEEN) | b.pieces(BLACK, QUEEN)
+	return (pawnAttacks[BLACK][sq] & b.pieces(WHITE, PAWN)) |
+		(pawnAttacks[WHITE][sq] & b.pieces(BLACK, PAWN)) |
+		(knightAttacks[sq] & (b.pieces(WHITE, KNIGHT) | b.pieces(BLACK, KNIGHT))) |
+		(kingAttacks[sq] & (b.pieces(WHITE, KING) | b.pieces(BLACK, KING))) |
+		(BishopAttacks(sq, occupied) & diagonal) |
+		(RookAttacks(sq, occupied) & straight) |
+		b.fairyAttackers(sq, WHITE, occupied) | b.fairyAttackers(sq, BLACK, occupied)
+}
+
+// IsAttacked checks whether any piece of the given color attacks the given square
+func (b *Board) IsAttacked(sq int, by Color) bool {
+	them := b.pieces
+	if pawnAttacks[by.Opponent()][sq]&them(by, PAWN) != 0 ||
+		knightAttacks[sq]&them(by, KNIGHT) != 0 ||
+		kingAttacks[sq]&them(by, KING) != 0 {
+		return true
+	}
+	queens := them(by, QUEEN)
+	if BishopAttacks(sq, b.Occupied)&(them(by, BISHOP)|queens) != 0 {
+		return true
+	}
+	if RookAttacks(sq, b.Occupied)&(them(by, ROOK)|queens) != 0 {
+		return true
+	}
+	return len(fairies) > 0 && b.fairyAttackers(sq, by, b.Occupied) != 0
+}
+
+// InCheck checks whether the king of the side to move is attacked, or otherwise in
+// check under the rules of the board's variant
+func (b *Board) InCheck() bool {
+	if b.Variant != nil {
+		return b.Variant.InCheck(b)
+	}
+	return b.kingAttacked()
+}
+
+// kingAttacked checks whether the king of the side to move is attacked
+func (b *Board) kingAttacked() bool {
+	king := b.pieces(b.Turn, KING)
+	if king == 0 {
+		return false
+	}
+	return b.IsAttacked(bits.TrailingZeros64(uint64(king)), b.Turn.Opponent())
+}
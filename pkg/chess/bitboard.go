@@ -1,5 +1,7 @@
 package chess
 
+import "math/bits"
+
 // Bitboard is a single 64-bit word/register used to represent the game state of a chess board
 // using a little-endian mapping of bits to the rank/file coordinates of the board.
 // For an 8x8 board, this mapping looks like this:
@@ -145,3 +147,10 @@ func (b Bitboard) Rotate90() Bitboard {
 func (b Bitboard) Rotate270() Bitboard {
 	return b.FlipVertical().FlipDiagonalA1H8()
 }
+
+// popLowestBit clears the least significant set bit and returns its index
+func (b *Bitboard) popLowestBit() int {
+	index := bits.TrailingZeros64(uint64(*b))
+	*b &= *b - 1
+	return index
+}
@@ -28,38 +28,199 @@ const (
 
 // Board is a type of piece-centric representation of a chess board referred to a Bitboard.
 // For more information see: https://www.chessprogramming.org/Bitboards
+//
+// Along with the piece positions the board tracks the rest of the game state needed to
+// generate moves: the side to move, castling rights, en passant target and move counters.
 type Board struct {
 	Positions []Bitboard
 	Pieces    []Piece
 	Occupied  Bitboard // Union of all piece positions gives current occupied squares
+
+	Turn           Color          // Side to move
+	Castling       CastlingRights // Castling rights still available to either side
+	EnPassant      int            // Square a pawn may capture en passant, or NoSquare
+	HalfMoveClock  int            // Plies since the last capture or pawn move
+	FullMoveNumber int            // Starts at 1 and is incremented after black moves
+	Chess960       bool           // Castling rooks may start on any file and UCI castles as king takes rook
+	Pockets        [2]Pocket      // Pieces in hand for each color in Crazyhouse and Bughouse
+	Variant        Variant        // Rules the board is played under, nil for standard chess
+	Checks         [2]int         // Checks given by each color, counted in Three-check
+
+	castlingRooks [4]int   // Original square of the rook for each castling right
+	promoted      Bitboard // Squares of pieces promoted from pawns
+	bughouse      bool     // Captured pieces are passed to the partner board instead of the pocket
+	destroyed     []destroyed
+	hash          uint64
+	history       []undo
+}
+
+// BoardOption sets up a board created by NewBoardWith or a game created by NewGame
+type BoardOption func(*boardSetup)
+
+// boardSetup collects the options a board is created with
+type boardSetup struct {
+	positions []Bitboard
+	variant   Variant
+	fen       string
+}
+
+// WithPositions places the pieces from a bitboard for each entry in Pieces, copying an
+// existing board's positions. White is to move and castling rights are given to any king
+// and rook still on their original squares. The positions of any registered fairy pieces
+// may be left out.
+func WithPositions(positions ...Bitboard) BoardOption {
+	return func(s *boardSetup) {
+		s.positions = append([]Bitboard{}, positions...)
+	}
+}
+
+// WithVariant plays the board by the variant's rules, starting from the variant's starting
+// position unless another is given
+func WithVariant(v Variant) BoardOption {
+	return func(s *boardSetup) {
+		s.variant = v
+	}
+}
+
+// WithFEN sets the board up with a position in Forsyth-Edwards Notation. An empty FEN is
+// ignored, so optional positions can be passed straight through.
+func WithFEN(fen string) BoardOption {
+	return func(s *boardSetup) {
+		s.fen = fen
+	}
 }
 
 // NewBoard returns a new instance of the chess board or optionally copies an existing board
 // by initializing with a copy of the requested board positions
 func NewBoard(positions ...Bitboard) (*Board, error) {
+	return newBoard(positions)
+}
+
+// NewBoardWith returns a new board with the standard starting position, unless options
+// give other positions or a variant to play
+func NewBoardWith(options ...BoardOption) (*Board, error) {
+	var setup boardSetup
+	for _, option := range options {
+		option(&setup)
+	}
+	v := setup.variant
+	if v == Standard {
+		v = nil // Boards with no variant follow the standard rules
+	}
+
+	var board *Board
+	var err error
+	switch {
+	case setup.fen != "" && setup.positions != nil:
+		return nil, fmt.Errorf("a board can't be set up from both bitboards and a FEN")
+	case setup.fen != "":
+		board, err = parseFEN(setup.fen, v)
+	case v != nil && setup.positions == nil:
+		board, err = parseFEN(v.StartFEN(), v)
+	default:
+		board, err = newBoard(setup.positions)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if v != nil {
+		board.Variant = v
+	}
+	return board, nil
+}
 
-	board := Board{Pieces: Pieces}
-	if len(positions) > 0 && len(positions) != len(board.Pieces) {
+// newBoard returns a board with the given positions, or the standard starting position
+func newBoard(positions []Bitboard) (*Board, error) {
+	board := Board{Pieces: Pieces, Turn: WHITE, EnPassant: NoSquare, FullMoveNumber: 1, castlingRooks: standardCastlingRooks}
+	if len(positions) > 0 && len(positions) != len(board.Pieces) && len(positions) != standardPieces {
 		err := fmt.Errorf(
 			"Unable to determine board position, expecting %d bitboards, received %d",
 			len(board.Pieces), len(positions),
 		)
 		return nil, err
 	} else if len(positions) > 0 {
-		board.Positions = make([]Bitboard, len(positions))
+		board.Positions = make([]Bitboard, len(board.Pieces))
 		copy(board.Positions, positions)
 	} else {
-		board.Positions = []Bitboard{
+		board.Positions = make([]Bitboard, len(board.Pieces))
+		copy(board.Positions, []Bitboard{
 			initWhiteRooks, initWhiteKnights, initWhiteBishops, initWhiteQueen, initWhiteKing, initWhitePawns,
 			initBlackRooks, initBlackKnights, initBlackBishops, initBlackQueen, initBlackKing, initBlackPawns,
-		}
+		})
 	}
 
 	board.Occupied = Union(board.Positions...)
+	board.Castling = board.inferCastling()
+	board.hash = board.computeHash()
 
 	return &board, nil
 }
 
+// Copy returns a deep copy of the board, including its move history
+func (b *Board) Copy() *Board {
+	c := *b
+	c.Positions = make([]Bitboard, len(b.Positions))
+	copy(c.Positions, b.Positions)
+	c.history = make([]undo, len(b.history))
+	copy(c.history, b.history)
+	c.destroyed = make([]destroyed, len(b.destroyed))
+	copy(c.destroyed, b.destroyed)
+	return &c
+}
+
+// inferCastling gives castling rights to every king and rook on their original squares
+func (b *Board) inferCastling() CastlingRights {
+	var rights CastlingRights
+	for _, color := range []Color{WHITE, BLACK} {
+		rank := 0
+		if color == BLACK {
+			rank = 7
+		}
+		if !b.pieces(color, KING).IsBitSet(CartesianToBit(4, rank)) {
+			continue
+		}
+		rooks := b.pieces(color, ROOK)
+		if rooks.IsBitSet(CartesianToBit(7, rank)) {
+			rights |= kingSide(color)
+		}
+		if rooks.IsBitSet(CartesianToBit(0, rank)) {
+			rights |= queenSide(color)
+		}
+	}
+	return rights
+}
+
+// pieces returns the bitboard of the piece with the given color and symbol
+func (b *Board) pieces(c Color, s Symbol) Bitboard {
+	if i := PieceIndex(c, s); i >= 0 && i < len(b.Positions) {
+		return b.Positions[i]
+	}
+	return 0
+}
+
+// colorOccupied returns the union of all positions of the pieces of the given color
+func (b *Board) colorOccupied(c Color) Bitboard {
+	var occupied Bitboard
+	for i, p := range b.Pieces {
+		if p.Color == c {
+			occupied |= b.Positions[i]
+		}
+	}
+	return occupied
+}
+
+// pieceAt returns the index of the piece on the given square, or NoPiece
+func (b *Board) pieceAt(sq int) int {
+	if b.Occupied.IsBitSet(sq) {
+		for i := range b.Positions {
+			if b.Positions[i].IsBitSet(sq) {
+				return i
+			}
+		}
+	}
+	return NoPiece
+}
+
 // GetSquare gives whether a square is occupied and if so by which piece for a given index 0-63
 func (b Board) GetSquare(index int) (bool, *Piece) {
 	if b.Occupied.GetBit(index) != 0 { // If 0, this square is unoccupied
@@ -75,17 +236,19 @@ func (b Board) GetSquare(index int) (bool, *Piece) {
 
 // String displays the current board a simple console-friendly unicode grid
 func (b *Board) String() string {
-	s := "A B C D E F G H\n"
+	s := " A B C D E F G H\n"
 	for rank := int(RANKS - 1); rank >= 0; rank-- {
 		s += fmt.Sprintf("%d", rank+1)
 		for file := 0; file < FILES; file++ {
+			if file > 0 {
+				s += " "
+			}
 			index := (rank * FILES) + file
 			if occupied, piece := b.GetSquare(index); occupied {
 				s += piece.Unicode()
 			} else {
 				s += "-"
 			}
-			s += " "
 		}
 		s += "\n"
 	}
@@ -99,6 +262,9 @@ func (b *Board) String() string {
 // PlacePiece marks the provided position index (0-63) as occupied on the bitboard
 // defined by the piece index (i.e. Board.Position[int]) for this board
 func (b *Board) PlacePiece(piece, position int) {
+	if !b.Positions[piece].IsBitSet(position) {
+		b.hash ^= pieceKey(b.Pieces[piece], position)
+	}
 	b.Positions[piece].SetBit(position)
 	b.Occupied.SetBit(position)
 }
@@ -106,6 +272,9 @@ func (b *Board) PlacePiece(piece, position int) {
 // RemovePiece marks the provided position index (0-63) as unoccupied  on the
 // bitboard defined by the piece index (i.e. Board.Position[int]) for this board
 func (b *Board) RemovePiece(piece, position int) {
+	if b.Positions[piece].IsBitSet(position) {
+		b.hash ^= pieceKey(b.Pieces[piece], position)
+	}
 	b.Positions[piece].ClearBit(position)
 	b.Occupied.ClearBit(position)
 }
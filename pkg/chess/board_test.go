@@ -112,8 +112,8 @@ func TestString(t *testing.T) {
 	} else if len(actual) > len(boardStr) {
 		t.Errorf("expected string missing lines, %d expected, %d actual", len(boardStr), len(actual))
 	}
-	for i, line := range actual {
-		if line != actual[i] {
+	for i, line := range boardStr {
+		if i < len(actual) && line != actual[i] {
 			t.Errorf("board.String() expected %s, actual: %s", line, actual[i])
 		}
 	}
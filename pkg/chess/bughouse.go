@@ -0,0 +1,76 @@
+package chess
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Bughouse is a game of two Crazyhouse boards played by two teams of two. White on the
+// first board partners black on the second board and vice versa; the pieces a player
+// captures go into their partner's pocket rather than their own.
+//
+// The boards are played independently, so moves are made through the Bughouse rather
+// than on the boards directly. Moves can't be taken back once a piece has been passed
+// as the partner may already have dropped it.
+type Bughouse struct {
+	Boards [2]*Board
+}
+
+// NewBughouse returns a bughouse game with both boards in the starting position
+func NewBughouse() (*Bughouse, error) {
+	return ParseBughouseFEN(StartFEN + " | " + StartFEN)
+}
+
+// ParseBughouseFEN returns a bughouse game set up from the positions of both boards in
+// Forsyth-Edwards Notation separated by "|", as used in BPGN
+func ParseBughouseFEN(fen string) (*Bughouse, error) {
+	fens := strings.Split(fen, "|")
+	if len(fens) != 2 {
+		return nil, fmt.Errorf("invalid bughouse FEN %q: expected 2 boards, found %d", fen, len(fens))
+	}
+	g := &Bughouse{}
+	for i, f := range fens {
+		board, err := ParseFEN(strings.TrimSpace(f))
+		if err != nil {
+			return nil, err
+		}
+		board.Variant, board.bughouse = Crazyhouse, true
+		g.Boards[i] = board
+	}
+	return g, nil
+}
+
+// Partner returns the board and color of the partner of the player of a color on a board
+func (g *Bughouse) Partner(board int, color Color) (int, Color) {
+	return 1 - board, color.Opponent()
+}
+
+// MakeMove plays a legal move on one of the boards, passing any piece it captures to the
+// mover's partner on the other board
+func (g *Bughouse) MakeMove(board int, m Move) {
+	b := g.Boards[board]
+	if m.IsCapture() {
+		symbol := b.capturedSymbol(m)
+		partnerBoard, partner := g.Partner(board, b.Turn)
+		g.Boards[partnerBoard].addToPocket(partner, symbol)
+	}
+	b.MakeMove(m)
+}
+
+// Play parses a move in UCI or Standard Algebraic Notation and makes it on one of the boards
+func (g *Bughouse) Play(board int, move string) (Move, error) {
+	if board < 0 || board >= len(g.Boards) {
+		return Move{}, fmt.Errorf("invalid board %d", board)
+	}
+	m, err := g.Boards[board].ParseMove(move)
+	if err != nil {
+		return Move{}, err
+	}
+	g.MakeMove(board, m)
+	return m, nil
+}
+
+// FEN returns the positions of both boards in Forsyth-Edwards Notation separated by "|"
+func (g *Bughouse) FEN() string {
+	return g.Boards[0].FEN() + " | " + g.Boards[1].FEN()
+}
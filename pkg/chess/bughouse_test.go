@@ -0,0 +1,53 @@
+package chess
+
+import "testing"
+
+func TestBughouse(t *testing.T) {
+	g, err := NewBughouse()
+	if err != nil {
+		t.Fatalf("unexpected error creating bughouse game: %s", err)
+	}
+	for _, move := range []string{"e4", "d5", "exd5", "Qxd5"} {
+		if _, err := g.Play(0, move); err != nil {
+			t.Fatalf("unexpected error playing %s: %s", move, err)
+		}
+	}
+
+	// White's captured pawn goes to black on the other board and black's to white
+	expected := "rnb1kbnr/ppp1pppp/8/3q4/8/8/PPPP1PPP/RNBQKBNR[] w KQkq - 0 3 | " +
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[Pp] w KQkq - 0 1"
+	if g.FEN() != expected {
+		t.Errorf("expected %s, actual: %s", expected, g.FEN())
+	}
+	if g.Boards[1].Hash() != g.Boards[1].computeHash() {
+		t.Errorf("passing pieces should keep the partner board's hash up to date")
+	}
+
+	if _, err := g.Play(1, "P@e3"); err != nil {
+		t.Errorf("unexpected error dropping a passed pawn: %s", err)
+	}
+	if _, err := g.Play(1, "P@e5"); err != nil {
+		t.Errorf("unexpected error dropping a passed pawn: %s", err)
+	}
+	if _, err := g.Play(1, "P@d3"); err == nil {
+		t.Errorf("white on the second board has no pawns left to drop")
+	}
+
+	if _, err := g.Play(2, "e4"); err == nil {
+		t.Errorf("playing on a third board should fail")
+	}
+	if board, color := g.Partner(1, BLACK); board != 0 || color != WHITE {
+		t.Errorf("expected black on the second board to partner white on the first, actual: %d %s", board, color)
+	}
+
+	g, err = ParseBughouseFEN("4k3/8/8/8/8/8/8/4K3[Q] w - - 0 1|4k3/8/8/8/8/8/8/4K3[] w - - 0 1")
+	if err != nil {
+		t.Fatalf("unexpected error parsing bughouse FEN: %s", err)
+	}
+	if g.Boards[0].Pockets[WHITE].Count(QUEEN) != 1 {
+		t.Errorf("expected white to hold a queen on the first board")
+	}
+	if _, err := ParseBughouseFEN(StartFEN); err == nil {
+		t.Errorf("parsing a single board should fail")
+	}
+}
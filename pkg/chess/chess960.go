@@ -0,0 +1,232 @@
+package chess
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// chess960Knights lists the placements of the two knights on the five squares left
+// after the bishops and queen are placed, in Scharnagl order
+var chess960Knights = [10]string{"NN---", "N-N--", "N--N-", "N---N", "-NN--", "-N-N-", "-N--N", "--NN-", "--N-N", "---NN"}
+
+// Chess960BackRank returns white's first rank in Chess960 starting position n (0-959)
+// using Scharnagl's numbering, e.g. "RNBQKBNR" for the standard position, number 518
+func Chess960BackRank(n int) (string, error) {
+	if n < 0 || n >= 960 {
+		return "", fmt.Errorf("invalid Chess960 position %d: must be between 0 and 959", n)
+	}
+	var rank [8]byte
+	rank[n%4*2+1] = 'B'
+	n /= 4
+	rank[n%4*2] = 'B'
+	n /= 4
+
+	// The queen, knights and finally the rooks and king fill the empty squares in turn
+	fill := func(piece byte, skip int) {
+		for file := range rank {
+			if rank[file] != 0 {
+				continue
+			}
+			if skip == 0 {
+				rank[file] = piece
+				return
+			}
+			skip--
+		}
+	}
+	fill('Q', n%6)
+	n /= 6
+	knights := chess960Knights[n]
+	for i := len(knights) - 1; i >= 0; i-- {
+		if knights[i] == 'N' {
+			fill('N', i)
+		}
+	}
+	fill('R', 0)
+	fill('K', 0)
+	fill('R', 0)
+	return string(rank[:]), nil
+}
+
+// NewChess960Board returns a board set up with Chess960 starting position n (0-959)
+func NewChess960Board(n int) (*Board, error) {
+	rank, err := Chess960BackRank(n)
+	if err != nil {
+		return nil, err
+	}
+	fen := fmt.Sprintf("%s/pppppppp/8/8/8/8/PPPPPPPP/%s w KQkq - 0 1", strings.ToLower(rank), rank)
+	board, err := ParseFEN(fen)
+	if err != nil {
+		return nil, err
+	}
+	board.Chess960 = true
+	return board, nil
+}
+
+// Chess960Number returns the Scharnagl number of the board's starting array, reporting
+// false if the back ranks aren't a Chess960 starting position. Pawns and the remaining
+// pieces are not checked so the number can be recovered during the opening.
+func (b *Board) Chess960Number() (int, bool) {
+	var white, black strings.Builder
+	for file := 0; file < FILES; file++ {
+		for _, s := range []struct {
+			rank  *strings.Builder
+			color Color
+			sq    int
+		}{{&white, WHITE, file}, {&black, BLACK, file + 56}} {
+			occupied, piece := b.GetSquare(s.sq)
+			if !occupied || piece.Color != s.color {
+				return 0, false
+			}
+			s.rank.WriteString(strings.ToUpper(piece.FENSymbol()))
+		}
+	}
+	if white.String() != black.String() {
+		return 0, false
+	}
+	for n := 0; n < 960; n++ {
+		if rank, _ := Chess960BackRank(n); rank == white.String() {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+// outermostRook returns the square of the color's rook furthest towards the edge of the
+// board on the king or queen side of its king, or NoSquare if there is none
+func (b *Board) outermostRook(color Color, kingSide bool) int {
+	rank := 0
+	if color == BLACK {
+		rank = 56
+	}
+	king := b.pieces(color, KING) & (Bitboard(0xff) << uint(rank))
+	if king.Population() != 1 {
+		return NoSquare
+	}
+	kingSquare := king.popLowestBit()
+	rooks := b.pieces(color, ROOK)
+	if kingSide {
+		for sq := rank + 7; sq > kingSquare; sq-- {
+			if rooks.IsBitSet(sq) {
+				return sq
+			}
+		}
+	} else {
+		for sq := rank; sq < kingSquare; sq++ {
+			if rooks.IsBitSet(sq) {
+				return sq
+			}
+		}
+	}
+	return NoSquare
+}
+
+// parseCastling sets the castling rights and rook squares from the castling field of a
+// FEN, which may use the standard KQkq letters, Shredder-FEN rook files (e.g. "HAha") or
+// X-FEN, which mixes the two when an inner rook has the right to castle
+func (b *Board) parseCastling(field string) error {
+	b.Castling, b.castlingRooks = NoCastling, standardCastlingRooks
+	if field == "-" {
+		return nil
+	}
+	for _, r := range field {
+		color, rank := WHITE, 0
+		if r >= 'a' && r <= 'z' {
+			color, rank = BLACK, 56
+			r -= 'a' - 'A'
+		}
+
+		var right CastlingRights
+		rook := NoSquare
+		switch {
+		case r == 'K':
+			right = kingSide(color)
+			if rook = b.outermostRook(color, true); rook == NoSquare {
+				rook = rank + 7
+			}
+		case r == 'Q':
+			right = queenSide(color)
+			if rook = b.outermostRook(color, false); rook == NoSquare {
+				rook = rank
+			}
+		case r >= 'A' && r <= 'H':
+			king := b.pieces(color, KING) & (Bitboard(0xff) << uint(rank))
+			if king.Population() != 1 {
+				return fmt.Errorf("castling right %q without a king on the back rank", r)
+			}
+			rook = rank + int(r-'A')
+			kingSquare := king.popLowestBit()
+			switch {
+			case rook > kingSquare:
+				right = kingSide(color)
+			case rook < kingSquare:
+				right = queenSide(color)
+			default:
+				return fmt.Errorf("castling right %q is on the king's file", r)
+			}
+		default:
+			return fmt.Errorf("unknown castling right %q", r)
+		}
+		if b.Castling&right != 0 {
+			return fmt.Errorf("duplicate castling right %q", r)
+		}
+		b.Castling |= right
+		b.castlingRooks[bits.TrailingZeros8(uint8(right))] = rook
+	}
+
+	// Castling from anything other than the standard squares needs Chess960 rules
+	for i, right := range []CastlingRights{WhiteKingSide, WhiteQueenSide, BlackKingSide, BlackQueenSide} {
+		king := E1
+		if right&(BlackKingSide|BlackQueenSide) != 0 {
+			king = E8
+		}
+		if b.Castling&right != 0 && (b.castlingRooks[i] != standardCastlingRooks[i] || !b.Positions[PieceIndex(Color(i/2), KING)].IsBitSet(king)) {
+			b.Chess960 = true
+		}
+	}
+	return nil
+}
+
+// castlingString formats the castling rights for a FEN. Standard boards use KQkq, a
+// Chess960 board uses X-FEN and shredder gives the rook files for every right.
+func (b *Board) castlingString(shredder bool) string {
+	if !b.Chess960 && !shredder {
+		return b.Castling.String()
+	}
+	s := ""
+	for i, right := range []CastlingRights{WhiteKingSide, WhiteQueenSide, BlackKingSide, BlackQueenSide} {
+		if b.Castling&right == 0 {
+			continue
+		}
+		color, rook := Color(i/2), b.castlingRooks[i]
+		letter := string("KQkq"[i])
+		if shredder || rook != b.outermostRook(color, right&(WhiteKingSide|BlackKingSide) != 0) {
+			letter = string(rune('A' + rook%FILES))
+			if color == BLACK {
+				letter = strings.ToLower(letter)
+			}
+		}
+		s += letter
+	}
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// ShredderFEN returns the position in Forsyth-Edwards Notation with castling rights given
+// by the files of the rooks, e.g. "HAha" for the standard starting position
+func (b *Board) ShredderFEN() string {
+	return b.fen(true)
+}
+
+// UCI formats a move in UCI long algebraic notation. On a Chess960 board castling is
+// written as the king capturing its own rook, e.g. "e1h1", as the king's destination alone
+// can be ambiguous.
+func (b *Board) UCI(m Move) string {
+	if b.Chess960 && m.IsCastle() {
+		return BitToAlgebraic(m.From) + BitToAlgebraic(b.castlingRook(b.castlingRight(m)))
+	}
+	return m.UCI()
+}
@@ -0,0 +1,221 @@
+package chess
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestChess960BackRank(t *testing.T) {
+	for n, expected := range map[int]string{0: "BBQNNRKR", 518: "RNBQKBNR", 959: "RKRNNQBB", 1: "BQNBNRKR"} {
+		if actual, err := Chess960BackRank(n); err != nil || actual != expected {
+			t.Errorf("expected position %d to be %s, actual: %s (%v)", n, expected, actual, err)
+		}
+	}
+	for _, n := range []int{-1, 960} {
+		if _, err := Chess960BackRank(n); err == nil {
+			t.Errorf("position %d should be invalid", n)
+		}
+	}
+
+	seen := map[string]bool{}
+	for n := 0; n < 960; n++ {
+		rank, _ := Chess960BackRank(n)
+		seen[rank] = true
+		b1, b2 := strings.IndexByte(rank, 'B'), strings.LastIndexByte(rank, 'B')
+		r1, k, r2 := strings.IndexByte(rank, 'R'), strings.IndexByte(rank, 'K'), strings.LastIndexByte(rank, 'R')
+		if (b1+b2)%2 == 0 || r1 > k || k > r2 || strings.Count(rank, "N") != 2 || strings.Count(rank, "Q") != 1 {
+			t.Errorf("position %d is not a valid Chess960 back rank: %s", n, rank)
+		}
+
+		board, err := NewChess960Board(n)
+		if err != nil {
+			t.Fatalf("unexpected error setting up position %d: %s", n, err)
+		}
+		if actual, ok := board.Chess960Number(); !ok || actual != n {
+			t.Errorf("expected board to be position %d, actual: %d %t", n, actual, ok)
+		}
+	}
+	if len(seen) != 960 {
+		t.Errorf("expected 960 distinct positions, actual: %d", len(seen))
+	}
+
+	board, _ := ParseFEN("rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq - 0 1")
+	if n, ok := board.Chess960Number(); !ok || n != 518 {
+		t.Errorf("expected the standard position to be 518, actual: %d %t", n, ok)
+	}
+	board, _ = ParseFEN("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBRN w - - 0 1")
+	if _, ok := board.Chess960Number(); ok {
+		t.Errorf("mismatched back ranks should not be a Chess960 position")
+	}
+}
+
+var chess960PerftPositions = []struct {
+	FEN    string
+	Counts []int64
+}{
+	{"bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w HFhf - 2 9", []int64{21, 528, 12189, 326672}},
+	{"2nnrbkr/p1qppppp/8/1ppb4/6PP/3PP3/PPP2P2/BQNNRBKR w HEhe - 1 9", []int64{21, 807, 18002, 667366}},
+	{"b1q1rrkb/pppppppp/3nn3/8/P7/1PPP4/4PPPP/BQNNRKRB w GE - 1 9", []int64{20, 479, 10471, 273318}},
+	{"qbbnnrkr/2pp2pp/p7/1p2pp2/8/P3PP2/1PPP1KPP/QBBNNR1R w hf - 0 9", []int64{22, 593, 13440, 382958}},
+	{"1nbbnrkr/p1p1ppp1/3p4/1p3P1p/3Pq2P/8/PPP1P1P1/QNBBNRKR w HFhf - 0 9", []int64{28, 1120, 31058, 1171749}},
+}
+
+func TestChess960Perft(t *testing.T) {
+	for _, position := range chess960PerftPositions {
+		board, err := ParseFEN(position.FEN)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", position.FEN, err)
+		}
+		if !board.Chess960 {
+			t.Errorf("expected %s to be a Chess960 position", position.FEN)
+		}
+		for i, expected := range position.Counts {
+			if testing.Short() && expected > 100000 {
+				break
+			}
+			if actual := board.Perft(i + 1); actual != expected {
+				t.Errorf("perft(%d) of %s expected %d, actual: %d", i+1, position.FEN, expected, actual)
+			}
+		}
+		if board.ShredderFEN() != position.FEN {
+			t.Errorf("perft should leave the board unchanged, expected %s, actual: %s", position.FEN, board.ShredderFEN())
+		}
+	}
+}
+
+func TestCastlingNotation(t *testing.T) {
+	cases := []struct {
+		FEN      string
+		XFEN     string
+		Shredder string
+	}{
+		{StartFEN, StartFEN, "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w HAha - 0 1"},
+		{
+			"rkrnnqbb/pppppppp/8/8/8/8/PPPPPPPP/RKRNNQBB w KQkq - 0 1",
+			"rkrnnqbb/pppppppp/8/8/8/8/PPPPPPPP/RKRNNQBB w KQkq - 0 1",
+			"rkrnnqbb/pppppppp/8/8/8/8/PPPPPPPP/RKRNNQBB w CAca - 0 1",
+		},
+		{
+			// The inner rook on b1 keeps its right so X-FEN names its file
+			"4k3/8/8/8/8/8/8/RR2K3 w B - 0 1",
+			"4k3/8/8/8/8/8/8/RR2K3 w B - 0 1",
+			"4k3/8/8/8/8/8/8/RR2K3 w B - 0 1",
+		},
+		{
+			"4k3/8/8/8/8/8/8/RR2K3 w Q - 0 1",
+			"4k3/8/8/8/8/8/8/RR2K3 w Q - 0 1",
+			"4k3/8/8/8/8/8/8/RR2K3 w A - 0 1",
+		},
+	}
+	for _, c := range cases {
+		board, err := ParseFEN(c.FEN)
+		if err != nil {
+			t.Errorf("unexpected error parsing %s: %s", c.FEN, err)
+			continue
+		}
+		if board.FEN() != c.XFEN {
+			t.Errorf("expected X-FEN %s, actual: %s", c.XFEN, board.FEN())
+		}
+		if board.ShredderFEN() != c.Shredder {
+			t.Errorf("expected Shredder-FEN %s, actual: %s", c.Shredder, board.ShredderFEN())
+		}
+	}
+
+	for _, fen := range []string{
+		"4k3/8/8/8/8/8/8/RR2K3 w E - 0 1",
+		"4k3/8/8/8/8/8/8/RR6 w A - 0 1",
+		"4k3/8/8/8/8/8/8/RR2K3 w QA - 0 1",
+	} {
+		if _, err := ParseFEN(fen); err == nil {
+			t.Errorf("parsing %q should have failed", fen)
+		}
+	}
+}
+
+func TestChess960Castling(t *testing.T) {
+	cases := []struct {
+		FEN   string
+		UCI   string
+		SAN   string
+		After string
+	}{
+		// King and rook swap squares
+		{"4k3/8/8/8/8/8/8/5KR1 w G - 0 1", "f1g1", "O-O", "4k3/8/8/8/8/8/8/5RK1 b - - 1 1"},
+		// The king doesn't move, only the rook does
+		{"4k3/8/8/8/8/8/8/1RK5 w B - 0 1", "c1b1", "O-O-O", "4k3/8/8/8/8/8/8/2KR4 b - - 1 1"},
+		{"rk6/8/8/8/8/8/8/4K3 b a - 0 1", "b8a8", "O-O-O", "2kr4/8/8/8/8/8/8/4K3 w - - 1 2"},
+	}
+	for _, c := range cases {
+		board, err := ParseFEN(c.FEN)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", c.FEN, err)
+		}
+		m, err := board.ParseSAN(c.SAN)
+		if err != nil {
+			t.Errorf("unexpected error parsing %s in %s: %s", c.SAN, c.FEN, err)
+			continue
+		}
+		if uci := board.UCI(m); uci != c.UCI {
+			t.Errorf("expected %s to be %s in UCI, actual: %s", c.SAN, c.UCI, uci)
+		}
+		if parsed, err := board.ParseUCI(c.UCI); err != nil || parsed != m {
+			t.Errorf("expected %s to parse as %s, actual: %s (%v)", c.UCI, c.SAN, parsed, err)
+		}
+		if san := board.SAN(m); san != c.SAN {
+			t.Errorf("expected %s, actual: %s", c.SAN, san)
+		}
+		hash := board.Hash()
+		board.MakeMove(m)
+		if board.ShredderFEN() != c.After {
+			t.Errorf("expected %s after %s, actual: %s", c.After, c.SAN, board.ShredderFEN())
+		}
+		if board.Hash() != board.computeHash() {
+			t.Errorf("incremental hash doesn't match after %s in %s", c.SAN, c.FEN)
+		}
+		board.UnmakeMove()
+		if board.ShredderFEN() != c.FEN || board.Hash() != hash {
+			t.Errorf("expected %s after taking back %s, actual: %s", c.FEN, c.SAN, board.ShredderFEN())
+		}
+	}
+
+	// Castling is given by the rook's square on a standard board as well
+	board, _ := ParseFEN("r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1")
+	if m, err := board.ParseUCI("e1h1"); err != nil || m.Flags&KingSideCastle == 0 {
+		t.Errorf("expected e1h1 to castle king side, actual: %s (%v)", m, err)
+	}
+
+	// On a Chess960 board the king's destination is an ordinary king move
+	board, _ = ParseFEN("4k3/8/8/8/8/8/8/5K1R w H - 0 1")
+	if m, err := board.ParseUCI("f1g1"); err != nil || m.IsCastle() {
+		t.Errorf("expected f1g1 to be a king move, actual: %s (%v)", m, err)
+	}
+	if m, err := board.ParseSAN("Kg1"); err != nil || m.IsCastle() {
+		t.Errorf("expected Kg1 to be a king move, actual: %s (%v)", m, err)
+	}
+	if m, err := board.ParseUCI("f1h1"); err != nil || !m.IsCastle() {
+		t.Errorf("expected f1h1 to castle, actual: %s (%v)", m, err)
+	}
+
+	// Castling through a square attacked by a rook hidden behind the castling rook
+	board, _ = ParseFEN("4k3/8/8/8/8/8/8/rRK5 w B - 0 1")
+	if _, err := board.ParseSAN("O-O-O"); err == nil {
+		t.Errorf("castling into check from behind the castling rook should be illegal")
+	}
+
+	// Castling out of check with the king already on its destination, where the rook
+	// would block the check
+	board, _ = ParseFEN("4k3/8/8/8/8/8/8/2r3KR w H - 0 1")
+	for _, m := range board.LegalMoves() {
+		if m.IsCastle() {
+			t.Errorf("castling out of check should be illegal, actual: %s", board.UCI(m))
+		}
+	}
+
+	game, err := NewGame(WithFEN("4k3/8/8/8/8/8/8/5KR1 w G - 0 1"))
+	if err != nil || game.Tags["Variant"] != "Chess960" {
+		t.Fatalf("expected game to be tagged as Chess960, actual: %v (%v)", game, err)
+	}
+	if _, err := game.Play("f1g1"); err != nil {
+		t.Errorf("unexpected error castling in a Chess960 game: %s", err)
+	}
+}
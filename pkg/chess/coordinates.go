@@ -9,19 +9,95 @@ import (
 // Coordinate conversions
 //-----------------------------------------------------------------------------
 
+// Dimensions is the size of a board in files and ranks. Files are lettered from "a" and
+// ranks numbered from 1, so the top right square of a 10x10 board is "j10", and squares
+// are numbered from a1 along each rank like the bits of a Bitboard.
+type Dimensions struct {
+	Files, Ranks int
+}
+
+// Standard8x8, Capablanca10x8 and Grand10x10 are the sizes of the standard board and
+// of the boards used by Capablanca chess and Grand chess
+var (
+	Standard8x8    = Dimensions{FILES, RANKS}
+	Capablanca10x8 = Dimensions{10, 8}
+	Grand10x10     = Dimensions{10, 10}
+)
+
+// NewDimensions returns the size of a board, returning an error if it's empty, has more
+// files than there are letters or more squares than fit in a WideBitboard
+func NewDimensions(files, ranks int) (Dimensions, error) {
+	d := Dimensions{files, ranks}
+	if files < 1 || ranks < 1 || files > 26 || files*ranks > MaxSquares {
+		return Dimensions{}, fmt.Errorf("unsupported board size %s", d)
+	}
+	return d, nil
+}
+
+// String returns the size in the form "10x8"
+func (d Dimensions) String() string {
+	return fmt.Sprintf("%dx%d", d.Files, d.Ranks)
+}
+
+// Squares returns the number of squares on the board
+func (d Dimensions) Squares() int {
+	return d.Files * d.Ranks
+}
+
+// Contains checks if the Cartesian coordinates are on the board
+func (d Dimensions) Contains(x, y int) bool {
+	return x >= 0 && x < d.Files && y >= 0 && y < d.Ranks
+}
+
 // AlgebraicToCartesian converts coordinates in algebraic notation to Cartesian coordinates.
-func AlgebraicToCartesian(p string) (int, int) {
-	symbols := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+func (d Dimensions) AlgebraicToCartesian(p string) (int, int) {
 	var x int
-	for i, v := range symbols {
-		if string(p[0]) == v {
-			x = i
-		}
+	if len(p) > 0 && p[0] >= 'a' && int(p[0]-'a') < d.Files {
+		x = int(p[0] - 'a')
 	}
-	y, _ := strconv.Atoi(string(p[1]))
+	y, _ := strconv.Atoi(p[1:])
 	return x, (y - 1)
 }
 
+// BitToCartesian converts an integer bit position to Cartesian coordinates.
+func (d Dimensions) BitToCartesian(p int) (int, int) {
+	return p % d.Files, p / d.Files
+}
+
+// CartesianToAlgebraic converts Cartesian coordinates to coordinates in algebraic notation.
+func (d Dimensions) CartesianToAlgebraic(x int, y int) string {
+	return fmt.Sprintf("%c%d", 'a'+x, y+1)
+}
+
+// CartesianToBit converts Cartesian coordinates to an integer bit position.
+func (d Dimensions) CartesianToBit(x int, y int) int {
+	return y*d.Files + x
+}
+
+// BitToAlgebraic converts an integer bit position to coordinates in algebraic notation.
+func (d Dimensions) BitToAlgebraic(p int) string {
+	return d.CartesianToAlgebraic(d.BitToCartesian(p))
+}
+
+// ParseSquare converts coordinates in algebraic notation to an integer bit position,
+// returning an error if the coordinates are not on the board
+func (d Dimensions) ParseSquare(p string) (int, error) {
+	if len(p) < 2 || p[0] < 'a' || p[1] < '1' || p[1] > '9' {
+		return NoSquare, fmt.Errorf("invalid square: %q", p)
+	}
+	x := int(p[0] - 'a')
+	y, err := strconv.Atoi(p[1:])
+	if err != nil || !d.Contains(x, y-1) {
+		return NoSquare, fmt.Errorf("invalid square: %q", p)
+	}
+	return d.CartesianToBit(x, y-1), nil
+}
+
+// AlgebraicToCartesian converts coordinates in algebraic notation to Cartesian coordinates.
+func AlgebraicToCartesian(p string) (int, int) {
+	return Standard8x8.AlgebraicToCartesian(p)
+}
+
 // AlgebraicToBit converts coordinates in algebraic notation to an integer bit position.
 func AlgebraicToBit(p string) int {
 	x, y := AlgebraicToCartesian(p)
@@ -30,25 +106,41 @@ func AlgebraicToBit(p string) int {
 
 // BitToAlgebraic converts an integer bit position to coordiantes in algebraic notation.
 func BitToAlgebraic(p int) string {
-	x, y := BitToCartesian(p)
-	return CartesianToAlgebraic(x, y)
+	return Standard8x8.BitToAlgebraic(p)
 }
 
 // BitToCartesian converts an integer bit position to Cartesian coordinates.
 func BitToCartesian(p int) (int, int) {
-	x := p % FILES
-	y := p / FILES
-	return x, y
+	return Standard8x8.BitToCartesian(p)
 }
 
 // CartesianToAlgebraic converts Cartesian coordinates to coordinates in algebraic notation.
 func CartesianToAlgebraic(x int, y int) string {
-	symbols := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
-	return fmt.Sprintf("%v%v", symbols[x], y+1)
+	return Standard8x8.CartesianToAlgebraic(x, y)
 }
 
 // CartesianToBit converts Cartesian coordinates to an integer bit position.
 func CartesianToBit(x int, y int) int {
-	bit := y*FILES + x
-	return bit
+	return Standard8x8.CartesianToBit(x, y)
+}
+
+// A1 through H8 are the bit positions of each square on the board and NoSquare marks the
+// absence of a square (e.g. no en passant target)
+const (
+	A1, B1, C1, D1, E1, F1, G1, H1 = 0, 1, 2, 3, 4, 5, 6, 7
+	A2, B2, C2, D2, E2, F2, G2, H2 = 8, 9, 10, 11, 12, 13, 14, 15
+	A3, B3, C3, D3, E3, F3, G3, H3 = 16, 17, 18, 19, 20, 21, 22, 23
+	A4, B4, C4, D4, E4, F4, G4, H4 = 24, 25, 26, 27, 28, 29, 30, 31
+	A5, B5, C5, D5, E5, F5, G5, H5 = 32, 33, 34, 35, 36, 37, 38, 39
+	A6, B6, C6, D6, E6, F6, G6, H6 = 40, 41, 42, 43, 44, 45, 46, 47
+	A7, B7, C7, D7, E7, F7, G7, H7 = 48, 49, 50, 51, 52, 53, 54, 55
+	A8, B8, C8, D8, E8, F8, G8, H8 = 56, 57, 58, 59, 60, 61, 62, 63
+
+	NoSquare = -1
+)
+
+// ParseSquare converts coordinates in algebraic notation to an integer bit position,
+// returning an error if the coordinates are not on the board
+func ParseSquare(p string) (int, error) {
+	return Standard8x8.ParseSquare(p)
 }
@@ -89,3 +89,50 @@ func TestCartesianToBit(t *testing.T) {
 		}
 	}
 }
+
+func TestDimensions(t *testing.T) {
+	cases := []struct {
+		Dimensions Dimensions
+		Square     string
+		Bit        int
+	}{
+		{Standard8x8, "h8", 63},
+		{Capablanca10x8, "b2", 11},
+		{Capablanca10x8, "j8", 79},
+		{Grand10x10, "a10", 90},
+		{Grand10x10, "j10", 99},
+	}
+	for _, c := range cases {
+		if sq, err := c.Dimensions.ParseSquare(c.Square); err != nil || sq != c.Bit {
+			t.Errorf("expected %s on a %s board to be %d, actual: %d (%v)", c.Square, c.Dimensions, c.Bit, sq, err)
+		}
+		if p := c.Dimensions.BitToAlgebraic(c.Bit); p != c.Square {
+			t.Errorf("expected %d on a %s board to be %s, actual: %s", c.Bit, c.Dimensions, c.Square, p)
+		}
+		x, y := c.Dimensions.AlgebraicToCartesian(c.Square)
+		if bit := c.Dimensions.CartesianToBit(x, y); bit != c.Bit {
+			t.Errorf("expected %s on a %s board to be %d, actual: %d", c.Square, c.Dimensions, c.Bit, bit)
+		}
+	}
+
+	for _, c := range []struct {
+		Dimensions Dimensions
+		Square     string
+	}{
+		{Standard8x8, "i1"}, {Standard8x8, "a9"}, {Capablanca10x8, "a9"}, {Grand10x10, "a11"},
+		{Grand10x10, "k1"}, {Grand10x10, "a0"}, {Grand10x10, "a01"}, {Grand10x10, "a"},
+	} {
+		if _, err := c.Dimensions.ParseSquare(c.Square); err == nil {
+			t.Errorf("expected %s not to be on a %s board", c.Square, c.Dimensions)
+		}
+	}
+
+	if _, err := NewDimensions(16, 16); err != nil {
+		t.Errorf("unexpected error for a 16x16 board: %s", err)
+	}
+	for _, size := range [][2]int{{0, 8}, {27, 8}, {17, 16}} {
+		if _, err := NewDimensions(size[0], size[1]); err == nil {
+			t.Errorf("expected an error for a %dx%d board", size[0], size[1])
+		}
+	}
+}
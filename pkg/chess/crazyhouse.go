@@ -0,0 +1,162 @@
+package chess
+
+import (
+	"fmt"
+	"strings"
+)
+
+// pocketSymbols are the pieces that can be held in a pocket, in the order of Pocket
+var pocketSymbols = [5]Symbol{PAWN, KNIGHT, BISHOP, ROOK, QUEEN}
+
+// Pocket counts the captured pieces a side holds in hand in Crazyhouse and Bughouse,
+// ready to be dropped back on the board. Use Count rather than indexing directly.
+type Pocket [5]int
+
+// pocketIndex returns the position of a symbol in a pocket, or -1 for the king and fairy
+// pieces
+func pocketIndex(s Symbol) int {
+	for i, symbol := range pocketSymbols {
+		if symbol == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// Count returns the number of pieces of a type in the pocket
+func (p Pocket) Count(s Symbol) int {
+	if i := pocketIndex(s); i >= 0 {
+		return p[i]
+	}
+	return 0
+}
+
+// Total returns the number of pieces in the pocket
+func (p Pocket) Total() int {
+	total := 0
+	for _, n := range p {
+		total += n
+	}
+	return total
+}
+
+// format lists the pieces in the pocket the way they appear in FEN, strongest first
+func (p Pocket) format(color Color) string {
+	s := ""
+	for i := len(pocketSymbols) - 1; i >= 0; i-- {
+		letter := Piece{Color: color, Symbol: pocketSymbols[i]}.FENSymbol()
+		s += strings.Repeat(letter, p[i])
+	}
+	return s
+}
+
+// addToPocket puts a piece in a side's pocket, keeping the hash up to date. Fairy pieces
+// can't be held in a pocket so are lost when captured.
+func (b *Board) addToPocket(c Color, s Symbol) {
+	i := pocketIndex(s)
+	if i < 0 {
+		return
+	}
+	b.hash ^= pocketKey(c, i, b.Pockets[c][i])
+	b.Pockets[c][i]++
+	b.hash ^= pocketKey(c, i, b.Pockets[c][i])
+}
+
+// removeFromPocket takes a piece out of a side's pocket, keeping the hash up to date
+func (b *Board) removeFromPocket(c Color, s Symbol) {
+	i := pocketIndex(s)
+	if i < 0 {
+		return
+	}
+	b.hash ^= pocketKey(c, i, b.Pockets[c][i])
+	b.Pockets[c][i]--
+	b.hash ^= pocketKey(c, i, b.Pockets[c][i])
+}
+
+// IsDrop checks if the move places a piece from the pocket on the board
+func (m Move) IsDrop() bool {
+	return m.Flags&Drop != 0
+}
+
+// Promoted returns the squares holding pieces that were promoted from pawns, which go
+// back into the pocket as pawns when they are captured
+func (b *Board) Promoted() Bitboard {
+	return b.promoted
+}
+
+// capturedSymbol returns the type of piece a capture puts in the pocket
+func (b *Board) capturedSymbol(m Move) Symbol {
+	sq := m.To
+	if m.Flags&EnPassant != 0 {
+		sq = m.To ^ 8
+	}
+	if b.promoted.IsBitSet(sq) {
+		return PAWN
+	}
+	return b.Pieces[m.Captured].Symbol
+}
+
+// generateDrops adds a drop of every piece in the pocket of the side to move on every
+// empty square, except pawns which may not be dropped on the first or last rank
+func (b *Board) generateDrops(moves []Move) []Move {
+	us := b.Turn
+	if b.Pockets[us].Total() == 0 {
+		return moves
+	}
+	for i, symbol := range pocketSymbols {
+		if b.Pockets[us][i] == 0 {
+			continue
+		}
+		piece := PieceIndex(us, symbol)
+		targets := ^b.Occupied
+		if symbol == PAWN {
+			targets &= Bitboard(0x00ffffffffffff00)
+		}
+		for targets != 0 {
+			moves = append(moves, Move{NoSquare, targets.popLowestBit(), piece, NoPiece, PAWN, Drop})
+		}
+	}
+	return moves
+}
+
+// parsePocket fills the pockets from the FEN notation of their contents, e.g. "QNpp"
+func (b *Board) parsePocket(s string) error {
+	b.Pockets = [2]Pocket{}
+	for _, r := range s {
+		piece := pieceFromFEN(r)
+		if piece == NoPiece {
+			return fmt.Errorf("invalid piece %q in pocket", r)
+		}
+		i := pocketIndex(b.Pieces[piece].Symbol)
+		if i < 0 {
+			return fmt.Errorf("invalid piece %q in pocket", r)
+		}
+		b.Pockets[b.Pieces[piece].Color][i]++
+	}
+	return nil
+}
+
+// parseDrop returns the legal drop written as e.g. "N@f3", "P@e4" or "@e4" for a pawn
+func (b *Board) parseDrop(s string) (Move, error) {
+	i := strings.IndexByte(s, '@')
+	if i < 0 || i > 1 {
+		return Move{}, fmt.Errorf("invalid drop %q", s)
+	}
+	to, err := ParseSquare(s[i+1:])
+	if err != nil {
+		return Move{}, fmt.Errorf("invalid drop %q: %s", s, err)
+	}
+	symbol := PAWN
+	if i == 1 && s[0] != 'P' {
+		if !strings.ContainsRune("NBRQ", rune(s[0])) {
+			return Move{}, fmt.Errorf("invalid drop %q: bad piece", s)
+		}
+		symbol = Symbol(s[0])
+	}
+	for _, m := range b.LegalMoves() {
+		if m.IsDrop() && m.To == to && b.Pieces[m.Piece].Symbol == symbol {
+			return m, nil
+		}
+	}
+	return Move{}, fmt.Errorf("illegal drop %q", s)
+}
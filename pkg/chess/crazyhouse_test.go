@@ -0,0 +1,134 @@
+package chess
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCrazyhousePerft(t *testing.T) {
+	board, err := NewBoardWith(WithVariant(Crazyhouse))
+	if err != nil {
+		t.Fatalf("unexpected error creating board: %s", err)
+	}
+	// Drops first become possible on the fifth ply
+	for i, expected := range []int64{20, 400, 8902, 197281, 4888832} {
+		if testing.Short() && i == 4 {
+			break
+		}
+		if actual := board.Perft(i + 1); actual != expected {
+			t.Errorf("perft(%d) expected %d, actual: %d", i+1, expected, actual)
+		}
+	}
+
+	fen := "r1bqk2r/pppp1ppp/2n2n2/2b1p3/2B1P3/2N2N2/PPPP1PPP/R1BQK2R w KQkq - 6 5"
+	board, _ = ParseFEN(fen)
+	moves := board.Perft(1)
+	board, _ = ParseFEN(strings.Replace(fen, " w", "[Bb] w", 1))
+	if actual := board.Perft(1); actual != moves+32 {
+		t.Errorf("expected %d moves including a bishop drop on each empty square, actual: %d", moves+32, actual)
+	}
+}
+
+func TestCrazyhouseFEN(t *testing.T) {
+	for fen, expected := range map[string]string{
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[] w KQkq - 0 1":             "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[] w KQkq - 0 1",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[pQnPP] w KQkq - 0 1":        "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[QPPnp] w KQkq - 0 1",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR/Rr w KQkq - 0 1":            "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[Rr] w KQkq - 0 1",
+		"4k3/8/8/3Q~4/8/8/8/4K3[] b - - 0 1":                                     "4k3/8/8/3Q~4/8/8/8/4K3[] b - - 0 1",
+		"r1bqk2r/pppp1ppp/2n2n2/2b1p3/2B1P3/2N2N2/PPPP1PPP/R1BQK2R w KQkq - 6 5": "r1bqk2r/pppp1ppp/2n2n2/2b1p3/2B1P3/2N2N2/PPPP1PPP/R1BQK2R w KQkq - 6 5",
+	} {
+		board, err := ParseFEN(fen)
+		if err != nil {
+			t.Errorf("unexpected error parsing %s: %s", fen, err)
+		} else if board.FEN() != expected {
+			t.Errorf("expected %s, actual: %s", expected, board.FEN())
+		}
+	}
+
+	board, _ := ParseFEN("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[QPPnp] w KQkq - 0 1")
+	if board.Variant != Crazyhouse || board.Pockets[WHITE].Count(PAWN) != 2 || board.Pockets[WHITE].Count(QUEEN) != 1 ||
+		board.Pockets[BLACK].Total() != 2 || board.Pockets[BLACK].Count(KING) != 0 {
+		t.Errorf("unexpected pockets: %v", board.Pockets)
+	}
+	if start, _ := NewBoard(); board.Hash() == start.Hash() {
+		t.Errorf("pockets should change the hash")
+	}
+
+	for _, fen := range []string{
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[K] w KQkq - 0 1",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[x] w KQkq - 0 1",
+	} {
+		if _, err := ParseFEN(fen); err == nil {
+			t.Errorf("parsing %q should have failed", fen)
+		}
+	}
+}
+
+func TestDrops(t *testing.T) {
+	cases := []struct {
+		FEN   string
+		Move  string
+		SAN   string
+		After string
+	}{
+		{"4k3/8/8/8/8/8/8/4K3[N] w - - 0 1", "N@f6", "N@f6+", "4k3/8/5N2/8/8/8/8/4K3[] b - - 1 1"},
+		{"4k3/8/8/8/8/8/8/4K3[P] w - - 3 1", "@e7", "P@e7", "4k3/4P3/8/8/8/8/8/4K3[] b - - 0 1"},
+		{"4k3/8/8/8/8/8/8/4K3[P] w - - 3 1", "@e8", "", ""},
+		{"4k3/8/8/8/8/8/8/4K3[P] w - - 3 1", "P@a1", "", ""},
+		{"4k3/8/8/8/8/8/8/4K3[q] w - - 3 1", "Q@d2", "", ""},
+		{"4k3/8/8/8/8/8/8/4K3[N] w - - 3 1", "N@e1", "", ""},
+		// Dropping a piece to block a check
+		{"4k3/4r3/8/8/8/8/8/4K3[B] w - - 0 1", "B@e2", "B@e2", "4k3/4r3/8/8/8/8/4B3/4K3[] b - - 1 1"},
+		{"4k3/4r3/8/8/8/8/8/4K3[B] w - - 0 1", "B@a1", "", ""},
+		// A captured promoted piece goes back to the pocket as a pawn
+		{"4k3/2n5/8/3Q~4/8/8/8/4K3[] b - - 0 1", "Nxd5", "Nxd5", "4k3/8/8/3n4/8/8/8/4K3[p] w - - 0 2"},
+		{"4k3/2n5/8/3Q4/8/8/8/4K3[] b - - 0 1", "Nxd5", "Nxd5", "4k3/8/8/3n4/8/8/8/4K3[q] w - - 0 2"},
+		{"3k4/P7/8/8/8/8/8/4K3[] w - - 0 1", "a8=Q+", "a8=Q+", "Q~2k4/8/8/8/8/8/8/4K3[] b - - 0 1"},
+		{"3k4/8/8/8/8/8/pP6/4K3[] b - - 0 1", "a1=R+", "a1=R+", "3k4/8/8/8/8/8/1P6/r~3K3[] w - - 0 2"},
+	}
+	for _, c := range cases {
+		board, err := ParseFEN(c.FEN)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", c.FEN, err)
+		}
+		m, err := board.ParseMove(c.Move)
+		if c.SAN == "" {
+			if err == nil {
+				t.Errorf("%s should be illegal in %s", c.Move, c.FEN)
+			}
+			continue
+		} else if err != nil {
+			t.Errorf("unexpected error parsing %s in %s: %s", c.Move, c.FEN, err)
+			continue
+		}
+		if san := board.SAN(m); san != c.SAN {
+			t.Errorf("expected %s, actual: %s", c.SAN, san)
+		}
+		if parsed, err := board.ParseSAN(c.SAN); err != nil || parsed != m {
+			t.Errorf("expected %s to parse as %s, actual: %s (%v)", c.SAN, m, parsed, err)
+		}
+		if parsed, err := board.ParseUCI(m.UCI()); err != nil || parsed != m {
+			t.Errorf("expected %s to parse as %s, actual: %s (%v)", m.UCI(), m, parsed, err)
+		}
+
+		board.MakeMove(m)
+		if board.FEN() != c.After {
+			t.Errorf("expected %s after %s, actual: %s", c.After, c.SAN, board.FEN())
+		}
+		if board.Hash() != board.computeHash() {
+			t.Errorf("incremental hash doesn't match after %s in %s", c.SAN, c.FEN)
+		}
+		board.UnmakeMove()
+		if board.FEN() != c.FEN || board.Hash() != board.computeHash() {
+			t.Errorf("expected %s after taking back %s, actual: %s", c.FEN, c.SAN, board.FEN())
+		}
+	}
+
+	game, _ := NewGame(WithFEN("4k3/8/8/8/8/8/8/4K3[N] w - - 0 1"))
+	if game.Tags["Variant"] != "Crazyhouse" {
+		t.Errorf("expected game to be tagged as Crazyhouse, actual: %q", game.Tags["Variant"])
+	}
+	if _, err := game.Play("N@c3"); err != nil {
+		t.Errorf("unexpected error dropping a piece in a Crazyhouse game: %s", err)
+	}
+}
@@ -0,0 +1,336 @@
+package chess
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// PositionSize is the length of a position's binary encoding
+const PositionSize = 32
+
+// A position is encoded in 32 bytes, like a packed FEN: the occupied squares followed by
+// a nibble for the piece on each of them, then the rest of the game state. Only standard
+// and Chess960 positions with at most 32 pieces can be encoded.
+//
+//	0-7:   occupied squares, a big-endian bitboard
+//	8-23:  the index in Pieces of the piece on each occupied square from a1 to h8, two to
+//	       a byte with the first in the high nibble, padded with zeros
+//	24:    bit 0 set if black is to move, bit 1 for Chess960 and the castling rights in
+//	       bits 4-7
+//	25:    en passant square plus one, or zero
+//	26-27: the file of the rook of each castling right, a nibble each in the order of
+//	       the rights, zero for rights not held
+//	28:    halfmove clock
+//	29-30: fullmove number, big-endian
+//	31:    zero
+
+// MarshalBinary encodes the position in PositionSize bytes. Move history isn't kept.
+func (b *Board) MarshalBinary() ([]byte, error) {
+	if b.Variant != nil {
+		return nil, fmt.Errorf("only standard and Chess960 positions can be encoded")
+	}
+	for piece := standardPieces; piece < len(b.Positions); piece++ {
+		if b.Positions[piece] != 0 {
+			return nil, fmt.Errorf("fairy pieces can't be encoded")
+		}
+	}
+	if b.Occupied.Population() > 32 {
+		return nil, fmt.Errorf("more than 32 pieces can't be encoded")
+	}
+	if b.HalfMoveClock < 0 || b.HalfMoveClock > 0xff || b.FullMoveNumber < 1 || b.FullMoveNumber > 0xffff {
+		return nil, fmt.Errorf("move counters %d and %d are out of range", b.HalfMoveClock, b.FullMoveNumber)
+	}
+
+	data := make([]byte, PositionSize)
+	binary.BigEndian.PutUint64(data, uint64(b.Occupied))
+	occupied, n := b.Occupied, 0
+	for occupied != 0 {
+		piece := b.pieceAt(occupied.popLowestBit())
+		data[8+n/2] |= byte(piece) << uint(4*(1-n%2))
+		n++
+	}
+
+	if b.Turn == BLACK {
+		data[24] |= 1
+	}
+	if b.Chess960 {
+		data[24] |= 2
+	}
+	data[24] |= byte(b.Castling&AllCastling) << 4
+	if b.EnPassant != NoSquare {
+		data[25] = byte(b.EnPassant + 1)
+	}
+	var rooks uint16
+	for i := range b.castlingRooks {
+		if b.Castling&(1<<uint(i)) != 0 {
+			rooks |= uint16(b.castlingRooks[i]%FILES) << uint(4*(3-i))
+		}
+	}
+	binary.BigEndian.PutUint16(data[26:], rooks)
+	data[28] = byte(b.HalfMoveClock)
+	binary.BigEndian.PutUint16(data[29:], uint16(b.FullMoveNumber))
+	return data, nil
+}
+
+// UnmarshalBinary sets the board to a position encoded by MarshalBinary
+func (b *Board) UnmarshalBinary(data []byte) error {
+	if len(data) != PositionSize {
+		return fmt.Errorf("invalid position: expected %d bytes, got %d", PositionSize, len(data))
+	}
+	board, err := newBoard(make([]Bitboard, len(Pieces)))
+	if err != nil {
+		return err
+	}
+
+	occupied := Bitboard(binary.BigEndian.Uint64(data))
+	count := occupied.Population()
+	if count > 32 {
+		return fmt.Errorf("invalid position: %d pieces", count)
+	}
+	for n := 0; n < 32; n++ {
+		piece := int(data[8+n/2]>>uint(4*(1-n%2))) & 0xf
+		if n >= count {
+			if piece != 0 {
+				return fmt.Errorf("invalid position: padding isn't zero")
+			}
+			continue
+		}
+		if piece >= standardPieces {
+			return fmt.Errorf("invalid position: unknown piece %d", piece)
+		}
+		board.PlacePiece(piece, occupied.popLowestBit())
+	}
+
+	flags := data[24]
+	if flags&0xc != 0 || data[31] != 0 {
+		return fmt.Errorf("invalid position: unknown flags")
+	}
+	if flags&1 != 0 {
+		board.Turn = BLACK
+	}
+	board.Chess960 = flags&2 != 0
+	board.Castling = CastlingRights(flags >> 4)
+	rooks := binary.BigEndian.Uint16(data[26:])
+	for i := range board.castlingRooks {
+		file := int(rooks>>uint(4*(3-i))) & 0xf
+		if board.Castling&(1<<uint(i)) == 0 {
+			if file != 0 {
+				return fmt.Errorf("invalid position: rook file for a missing castling right")
+			}
+			continue
+		}
+		if file >= FILES {
+			return fmt.Errorf("invalid position: rook file %d", file)
+		}
+		board.castlingRooks[i] = (i/2)*7*FILES + file
+	}
+	if data[25] > 64 {
+		return fmt.Errorf("invalid position: en passant square %d", data[25])
+	}
+	board.EnPassant = int(data[25]) - 1
+	board.HalfMoveClock = int(data[28])
+	board.FullMoveNumber = int(binary.BigEndian.Uint16(data[29:]))
+	if board.FullMoveNumber == 0 {
+		return fmt.Errorf("invalid position: fullmove number 0")
+	}
+
+	if err := board.checkState(); err != nil {
+		return fmt.Errorf("invalid position: %s", err)
+	}
+
+	board.Rehash()
+	*b = *board
+	return nil
+}
+
+// A game is encoded as its tags, result and moves. Every length and number is a varint
+// and each move is its index in MoveIndex order, a single byte when the position has
+// fewer than 128 legal moves.
+//
+//	tags:   count, then length and bytes of each name and value, sorted by name
+//	result: 0 for *, 1 for 1-0, 2 for 0-1, 3 for 1/2-1/2
+//	moves:  count, then the index of each move
+
+// results are the game results in the order of their codes
+var results = []string{InProgress, WhiteWins, BlackWins, Draw}
+
+// MarshalBinary encodes the game's tags, result and moves
+func (g *Game) MarshalBinary() ([]byte, error) {
+	board, err := g.StartingPosition()
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	names := make([]string, 0, len(g.Tags))
+	for name := range g.Tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	data = appendUvarint(data, uint64(len(names)))
+	for _, name := range names {
+		data = appendString(data, name)
+		data = appendString(data, g.Tags[name])
+	}
+
+	result := -1
+	for code, r := range results {
+		if r == g.Result || r == InProgress && g.Result == "" {
+			result = code
+		}
+	}
+	if result < 0 {
+		return nil, fmt.Errorf("unknown result %q", g.Result)
+	}
+	data = append(data, byte(result))
+
+	data = appendUvarint(data, uint64(len(g.Moves)))
+	for ply, m := range g.Moves {
+		i := board.MoveIndex(m)
+		if i < 0 {
+			return nil, fmt.Errorf("illegal move %s at ply %d", m.UCI(), ply+1)
+		}
+		data = appendUvarint(data, uint64(i))
+		board.MakeMove(m)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary sets the game to one encoded by MarshalBinary
+func (g *Game) UnmarshalBinary(data []byte) error {
+	r := byteReader{b: data}
+	game := Game{Tags: map[string]string{}}
+	for n := r.uvarint(); n > 0 && r.err == nil; n-- {
+		name := r.string()
+		game.Tags[name] = r.string()
+	}
+	result := r.byte()
+	if r.err == nil && int(result) >= len(results) {
+		return fmt.Errorf("invalid game: unknown result %d", result)
+	}
+	count := r.uvarint()
+	if r.err != nil {
+		return fmt.Errorf("invalid game: %s", r.err)
+	}
+	game.Result = results[result]
+
+	board, err := game.StartingPosition()
+	if err != nil {
+		return err
+	}
+	for ply := uint64(0); ply < count; ply++ {
+		i := r.uvarint()
+		if r.err != nil {
+			return fmt.Errorf("invalid game: %s", r.err)
+		}
+		m, ok := board.MoveAt(int(i))
+		if !ok {
+			return fmt.Errorf("invalid game: no move %d at ply %d", i, ply+1)
+		}
+		game.Moves = append(game.Moves, m)
+		board.MakeMove(m)
+	}
+	if len(r.b) > 0 {
+		return fmt.Errorf("invalid game: %d bytes after the moves", len(r.b))
+	}
+	*g = game
+	return nil
+}
+
+// MoveIndex returns the index of a move in the position's legal moves sorted by origin,
+// destination and promotion, or -1 if the move isn't legal. The index doesn't depend on
+// the order moves are generated in, so it can be stored in place of the move.
+func (b *Board) MoveIndex(m Move) int {
+	moves := b.sortedMoves()
+	i := sort.Search(len(moves), func(i int) bool { return !moveLess(moves[i], m) })
+	if i == len(moves) || moveLess(m, moves[i]) {
+		return -1
+	}
+	return i
+}
+
+// MoveAt returns the legal move with an index given by MoveIndex
+func (b *Board) MoveAt(i int) (Move, bool) {
+	moves := b.sortedMoves()
+	if i < 0 || i >= len(moves) {
+		return Move{}, false
+	}
+	return moves[i], true
+}
+
+// sortedMoves returns the legal moves in the order they're indexed by
+func (b *Board) sortedMoves() []Move {
+	moves := b.LegalMoves()
+	sort.Slice(moves, func(i, j int) bool { return moveLess(moves[i], moves[j]) })
+	return moves
+}
+
+func moveLess(a, b Move) bool {
+	if a.From != b.From {
+		return a.From < b.From
+	}
+	if a.To != b.To {
+		return a.To < b.To
+	}
+	if a.Promotion != b.Promotion {
+		return a.Promotion < b.Promotion
+	}
+	return a.Piece < b.Piece // Drops of different pieces on a square
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func appendString(b []byte, s string) []byte {
+	b = appendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// byteReader reads bytes, varints and strings, keeping the first error
+type byteReader struct {
+	b   []byte
+	err error
+}
+
+func (r *byteReader) byte() byte {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.b) == 0 {
+		r.err = fmt.Errorf("truncated")
+		return 0
+	}
+	v := r.b[0]
+	r.b = r.b[1:]
+	return v
+}
+
+func (r *byteReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.b)
+	if n <= 0 {
+		r.err = fmt.Errorf("truncated")
+		return 0
+	}
+	r.b = r.b[n:]
+	return v
+}
+
+func (r *byteReader) string() string {
+	n := r.uvarint()
+	if r.err != nil {
+		return ""
+	}
+	if n > uint64(len(r.b)) {
+		r.err = fmt.Errorf("truncated")
+		return ""
+	}
+	s := string(r.b[:n])
+	r.b = r.b[n:]
+	return s
+}
@@ -0,0 +1,71 @@
+//go:build go1.18
+// +build go1.18
+
+package chess
+
+import (
+	"bytes"
+	"testing"
+)
+
+func FuzzBoardBinary(f *testing.F) {
+	for _, fen := range []string{
+		StartFEN,
+		"rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3",
+		"bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w HFhf - 2 9",
+	} {
+		board, _ := ParseFEN(fen)
+		data, _ := board.MarshalBinary()
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var board Board
+		if err := board.UnmarshalBinary(data); err != nil {
+			return
+		}
+		encoded, err := board.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: %s", board.FEN(), err)
+		}
+		if !bytes.Equal(encoded, data) {
+			t.Fatalf("%s: expected %x, got %x", board.FEN(), data, encoded)
+		}
+
+		// Any position that decodes has to be safe to play from
+		fen := board.FEN()
+		for _, m := range board.LegalMoves() {
+			board.MakeMove(m)
+			board.LegalMoves()
+			board.UnmakeMove()
+		}
+		if board.FEN() != fen {
+			t.Fatalf("expected %s after unmaking every move, got %s", fen, board.FEN())
+		}
+	})
+}
+
+func FuzzGameBinary(f *testing.F) {
+	games, _ := ParsePGN(`[Event "Casual"]
+[Result "1-0"]
+
+1. e4 e5 2. Nf3 Nc6 3. Bb5 a6 4. Ba4 Nf6 5. O-O Be7 1-0`)
+	data, _ := games[0].MarshalBinary()
+	f.Add(data)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var game Game
+		if err := game.UnmarshalBinary(data); err != nil {
+			return
+		}
+		encoded, err := game.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Game
+		if err := decoded.UnmarshalBinary(encoded); err != nil {
+			t.Fatal(err)
+		}
+		if decoded.String() != game.String() {
+			t.Fatalf("expected\n%s\ngot\n%s", &game, &decoded)
+		}
+	})
+}
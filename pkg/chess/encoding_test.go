@@ -0,0 +1,173 @@
+package chess
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestBoardBinary(t *testing.T) {
+	for _, fen := range []string{
+		StartFEN,
+		"rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3",
+		"r3k2r/8/8/8/8/8/8/R3K2R b Kq - 99 300",
+		"8/8/4k3/8/8/4K3/8/8 w - - 255 65535",
+		"bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w HFhf - 2 9",
+		"1r2k1r1/8/8/8/8/8/8/R3KR2 w Fb - 0 1",
+	} {
+		board, err := ParseFEN(fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := board.MarshalBinary()
+		if err != nil {
+			t.Errorf("%s: %s", fen, err)
+			continue
+		}
+		if len(data) != PositionSize {
+			t.Errorf("%s: expected %d bytes, got %d", fen, PositionSize, len(data))
+		}
+		var decoded Board
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Errorf("%s: %s", fen, err)
+			continue
+		}
+		if decoded.FEN() != board.FEN() || decoded.Hash() != board.Hash() || decoded.Chess960 != board.Chess960 {
+			t.Errorf("expected %s, got %s", board.FEN(), decoded.FEN())
+		}
+		if moves, expected := len(decoded.LegalMoves()), len(board.LegalMoves()); moves != expected {
+			t.Errorf("%s: expected %d legal moves after decoding, got %d", fen, expected, moves)
+		}
+	}
+
+	for _, fen := range []string{
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[Qp] w KQkq - 0 1",
+		"rnbqkbnr/pppppppp/8/8/8/P7/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+		"8/8/4k3/8/8/4K3/8/8 w - - 256 100",
+	} {
+		board, _ := ParseFEN(fen)
+		if _, err := board.MarshalBinary(); err == nil {
+			t.Errorf("%s: expected an error encoding the position", fen)
+		}
+	}
+
+	board, _ := NewBoard()
+	data, _ := board.MarshalBinary()
+	for name, corrupt := range map[string]func([]byte) []byte{
+		"truncated":        func(b []byte) []byte { return b[:31] },
+		"padding":          func(b []byte) []byte { b[0] &^= 0x80; return b },
+		"unknown piece":    func(b []byte) []byte { b[8] = 0xc0; return b },
+		"flags":            func(b []byte) []byte { b[24] |= 4; return b },
+		"en passant":       func(b []byte) []byte { b[25] = 65; return b },
+		"rook file":        func(b []byte) []byte { b[26] |= 0x80; return b },
+		"missing castling": func(b []byte) []byte { b[24] &^= 0x10; return b },
+		"fullmove":         func(b []byte) []byte { b[29], b[30] = 0, 0; return b },
+		"en passant rank":  func(b []byte) []byte { b[25] = 21; return b },
+		"en passant pawn":  func(b []byte) []byte { b[25] = 45; return b },
+		"castling rook":    func(b []byte) []byte { b[26] = 0x60; return b },
+		"back rank pawn":   func(b []byte) []byte { b[8] = b[8]&0xf0 | byte(PieceIndex(WHITE, PAWN)); return b },
+	} {
+		var decoded Board
+		if err := decoded.UnmarshalBinary(corrupt(append([]byte(nil), data...))); err == nil {
+			t.Errorf("%s: expected an error decoding the position", name)
+		}
+	}
+}
+
+func TestGameBinary(t *testing.T) {
+	games, err := ParsePGN(`[Event "Casual"]
+[White "Alice"]
+[Black "Bob"]
+[Result "1-0"]
+
+1. e4 e5 2. Nf3 Nc6 3. Bb5 a6 4. Ba4 Nf6 5. O-O Be7 6. Re1 b5 7. Bb3 d6 8. c3 O-O 1-0
+
+[Variant "Crazyhouse"]
+[Result "*"]
+
+1. e4 d5 2. exd5 Qxd5 3. Nc3 Qa5 4. P@b4 Qxb4 *
+
+[Variant "Chess960"]
+[SetUp "1"]
+[FEN "bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w HFhf - 2 9"]
+[Result "1/2-1/2"]
+
+9. e4 e5 1/2-1/2
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, game := range games {
+		data, err := game.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Game
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if decoded.String() != game.String() {
+			t.Errorf("expected\n%s\ngot\n%s", game, &decoded)
+		}
+		if err := new(Game).UnmarshalBinary(data[:len(data)-1]); err == nil {
+			t.Error("expected an error decoding a truncated game")
+		}
+		if err := new(Game).UnmarshalBinary(append(data, 0)); err == nil {
+			t.Error("expected an error decoding a game followed by more bytes")
+		}
+	}
+
+	// The tags, the result, the move count and a byte for each of the 16 moves
+	game := &Game{Tags: map[string]string{}, Moves: games[0].Moves, Result: WhiteWins}
+	if data, _ := game.MarshalBinary(); len(data) != 19 {
+		t.Errorf("expected a 19 byte game, got %d", len(data))
+	}
+	game.Result = "2-0"
+	if _, err := game.MarshalBinary(); err == nil {
+		t.Error("expected an error encoding an unknown result")
+	}
+}
+
+// TestBinaryRandomGames round trips every position of random games and the games
+func TestBinaryRandomGames(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for n := 0; n < 50; n++ {
+		game, _ := NewGame()
+		board, _ := NewBoard()
+		for ply := 0; ply < 200; ply++ {
+			data, err := board.MarshalBinary()
+			if err != nil {
+				t.Fatalf("%s: %s", board.FEN(), err)
+			}
+			var decoded Board
+			if err := decoded.UnmarshalBinary(data); err != nil || decoded.FEN() != board.FEN() || decoded.Hash() != board.Hash() {
+				t.Fatalf("%s changed by encoding to %s: %v", board.FEN(), decoded.FEN(), err)
+			}
+			if again, _ := decoded.MarshalBinary(); !bytes.Equal(again, data) {
+				t.Fatalf("%s: encoding changed by decoding", board.FEN())
+			}
+
+			moves := board.LegalMoves()
+			if len(moves) == 0 {
+				break
+			}
+			m := moves[random.Intn(len(moves))]
+			if i := board.MoveIndex(m); i < 0 {
+				t.Fatalf("%s: no index for %s", board.FEN(), m.UCI())
+			} else if at, _ := board.MoveAt(i); at != m {
+				t.Fatalf("%s: expected %s at index %d, got %s", board.FEN(), m.UCI(), i, at.UCI())
+			}
+			game.Moves = append(game.Moves, m)
+			board.MakeMove(m)
+		}
+
+		data, err := game.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Game
+		if err := decoded.UnmarshalBinary(data); err != nil || decoded.String() != game.String() {
+			t.Fatalf("game changed by encoding: %v\n%s", err, game)
+		}
+	}
+}
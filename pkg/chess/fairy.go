@@ -0,0 +1,328 @@
+package chess
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//-----------------------------------------------------------------------------
+// Fairy pieces
+//-----------------------------------------------------------------------------
+
+// FairyPiece defines a piece type beyond the standard six by how it moves in Betza
+// notation, e.g. "BN" for a piece moving as a bishop or knight. Once registered with
+// RegisterPiece the piece can be placed on boards, read and written in FEN and SAN and
+// is included in move generation.
+type FairyPiece struct {
+	Symbol  Symbol    // Uppercase letter used in FEN and SAN
+	Name    string    // Descriptive name, e.g. "archbishop"
+	Betza   string    // Movement in Betza notation
+	Value   uint8     // Value in pawns
+	Unicode [2]string // Glyphs for the white and black piece, if Unicode has them
+}
+
+// Archbishop, Chancellor, Amazon, Camel and Nightrider are common fairy pieces ready to
+// be registered
+var (
+	Archbishop = FairyPiece{'A', "archbishop", "BN", 7, [2]string{"\U0001FA56", "\U0001FA59"}}
+	Chancellor = FairyPiece{'C', "chancellor", "RN", 8, [2]string{"\U0001FA55", "\U0001FA58"}}
+	Amazon     = FairyPiece{'M', "amazon", "QN", 12, [2]string{"\U0001FA54", "\U0001FA57"}}
+	Camel      = FairyPiece{'L', "camel", "C", 2, [2]string{}}
+	Nightrider = FairyPiece{'H', "nightrider", "NN", 5, [2]string{}}
+)
+
+// standardPieces is the number of entries in Pieces before any fairy pieces are registered
+const standardPieces = 12
+
+// fairy is a registered fairy piece with its parsed movement
+type fairy struct {
+	FairyPiece
+	movements []Movement
+}
+
+// fairies are the registered fairy pieces in the order they were registered
+var fairies []fairy
+
+// registering is held while a piece is registered, so pieces can be registered from
+// several goroutines
+var registering sync.Mutex
+
+// RegisterPiece adds a white and black piece of a new type to Pieces, PieceNames and
+// PieceUnicodes, returning an error if the symbol is taken or the movement can't be
+// parsed. Registering the same piece again does nothing. Pieces should be registered
+// before any boards are created, as boards only hold positions for the pieces that
+// existed when they were created. The tables pieces are added to aren't locked when
+// they're read, so register pieces in an init function or before any boards are used
+// by other goroutines.
+func RegisterPiece(f FairyPiece) error {
+	registering.Lock()
+	defer registering.Unlock()
+	for _, registered := range fairies {
+		if registered.FairyPiece == f {
+			return nil
+		}
+	}
+	if f.Symbol < 'A' || f.Symbol > 'Z' || f.Symbol == 'P' {
+		return fmt.Errorf("invalid symbol %q for %s: expected an uppercase letter other than P", rune(f.Symbol), f.Name)
+	}
+	if _, ok := PieceNames[f.Symbol]; ok {
+		return fmt.Errorf("symbol %q for %s is already used by the %s", rune(f.Symbol), f.Name, PieceNames[f.Symbol])
+	}
+	movements, err := ParseBetza(f.Betza)
+	if err != nil {
+		return fmt.Errorf("invalid movement for %s: %s", f.Name, err)
+	}
+
+	fairies = append(fairies, fairy{f, movements})
+	PieceNames[f.Symbol] = f.Name
+	for c, glyph := range f.Unicode {
+		piece := Piece{Color(c), f.Symbol, f.Value, len(Pieces)}
+		Pieces = append(Pieces, piece)
+		if glyph != "" {
+			PieceUnicodes[piece] = glyph
+		}
+		fairyKeys = append(fairyKeys, newFairyKeys(piece.Color, piece.Symbol))
+	}
+
+	// Fairy pieces join exchanges in order of value, before the king
+	seeOrder = append(seeOrder[:0:0], seeOrder[:len(seeOrder)-1]...)
+	seeOrder = append(seeOrder, f.Symbol)
+	sort.SliceStable(seeOrder, func(i, j int) bool {
+		return Pieces[PieceIndex(WHITE, seeOrder[i])].Value < Pieces[PieceIndex(WHITE, seeOrder[j])].Value
+	})
+	seeOrder = append(seeOrder, KING)
+	return nil
+}
+
+// reach returns the squares a fairy piece of a color on a square can move to, if capture
+// is false, or capture on, if capture is true. Riders stop at the first occupied square,
+// which is included.
+func (f *fairy) reach(c Color, sq int, occupied Bitboard, capture bool) Bitboard {
+	var reach Bitboard
+	x, y := BitToCartesian(sq)
+	for _, m := range f.movements {
+		if (capture && !m.Capture) || (!capture && !m.Move) {
+			continue
+		}
+		dx, dy := m.X, m.Y
+		if c == BLACK {
+			dx, dy = -dx, -dy
+		}
+		for i, tx, ty := 1, x+dx, y+dy; Standard8x8.Contains(tx, ty); i, tx, ty = i+1, tx+dx, ty+dy {
+			to := CartesianToBit(tx, ty)
+			reach.SetBit(to)
+			if occupied.IsBitSet(to) || i == m.Range {
+				break
+			}
+		}
+	}
+	return reach
+}
+
+// generateFairyMoves adds the moves of the side to move's fairy pieces
+func (b *Board) generateFairyMoves(moves []Move, enemy Bitboard, capturesOnly bool) []Move {
+	for i := range fairies {
+		f := &fairies[i]
+		piece := PieceIndex(b.Turn, f.Symbol)
+		if piece >= len(b.Positions) {
+			continue
+		}
+		for from := b.Positions[piece]; from != 0; {
+			sq := from.popLowestBit()
+			targets := f.reach(b.Turn, sq, b.Occupied, true) & enemy
+			if !capturesOnly {
+				targets |= f.reach(b.Turn, sq, b.Occupied, false) &^ b.Occupied
+			}
+			for targets != 0 {
+				to := targets.popLowestBit()
+				moves = append(moves, Move{sq, to, piece, b.pieceAt(to), PAWN, 0})
+			}
+		}
+	}
+	return moves
+}
+
+// fairyAttackers returns the fairy pieces of a color attacking a square given the
+// occupied squares
+func (b *Board) fairyAttackers(sq int, c Color, occupied Bitboard) Bitboard {
+	var attackers Bitboard
+	for i := range fairies {
+		f := &fairies[i]
+		for from := b.pieces(c, f.Symbol); from != 0; {
+			fromSq := from.popLowestBit()
+			if f.reach(c, fromSq, occupied, true).IsBitSet(sq) {
+				attackers.SetBit(fromSq)
+			}
+		}
+	}
+	return attackers
+}
+
+// hasFairyPieces checks whether any fairy pieces are on the board
+func (b *Board) hasFairyPieces() bool {
+	for i := standardPieces; i < len(b.Positions); i++ {
+		if b.Positions[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+//-----------------------------------------------------------------------------
+// Betza notation
+//-----------------------------------------------------------------------------
+
+// Movement is one direction a piece can move in, given from white's point of view with
+// Y towards the eighth rank. Range is the maximum number of steps, with 0 for a rider
+// that moves any distance. Move and Capture give whether the movement can be used to
+// move to an empty square and to capture.
+type Movement struct {
+	X, Y    int
+	Range   int
+	Move    bool
+	Capture bool
+}
+
+// betzaAtoms are the basic leaps of Betza notation
+var betzaAtoms = map[byte][2]int{
+	'W': {1, 0}, 'F': {1, 1}, 'D': {2, 0}, 'N': {2, 1}, 'A': {2, 2},
+	'H': {3, 0}, 'C': {3, 1}, 'Z': {3, 2}, 'G': {3, 3},
+}
+
+// betzaCompounds are the standard pieces as the atoms they combine, and whether those
+// atoms ride
+var betzaCompounds = map[byte]struct {
+	atoms string
+	rider bool
+}{
+	'K': {"WF", false}, 'R': {"W", true}, 'B': {"F", true}, 'Q': {"WF", true},
+}
+
+// ParseBetza returns the movements of a piece described in Betza notation, e.g. "N" for
+// a knight, "NN" for a nightrider or "fmWfcF" for a pawn without its double step.
+//
+// Atoms (W, F, D, N, A, H, C, Z and G, plus K, R, B and Q for the standard pieces) are
+// repeated (e.g. "WW") to ride any distance, or followed by a number (e.g. "W4") to ride
+// at most that far. Modifiers before an atom restrict it: m to moves and c to captures;
+// f, b, l and r to forward, backward, left and right; v and s to vertical and sideways.
+// A forward or backward modifier directly followed by left or right (e.g. "fl") selects
+// the directions that are both.
+func ParseBetza(notation string) ([]Movement, error) {
+	var movements []Movement
+	for i := 0; i < len(notation); {
+		start := i
+		for i < len(notation) && notation[i] >= 'a' && notation[i] <= 'z' {
+			i++
+		}
+		modifiers := notation[start:i]
+		if i == len(notation) {
+			return nil, fmt.Errorf("invalid Betza notation %q: modifiers %q have no atom", notation, modifiers)
+		}
+		atoms, moveRange := string(notation[i]), 1
+		if compound, ok := betzaCompounds[notation[i]]; ok {
+			atoms = compound.atoms
+			if compound.rider {
+				moveRange = 0
+			}
+		} else if _, ok := betzaAtoms[notation[i]]; !ok {
+			return nil, fmt.Errorf("invalid Betza notation %q: unknown atom %q", notation, notation[i])
+		}
+		i++
+
+		if i < len(notation) && notation[i] == notation[i-1] {
+			moveRange = 0
+			i++
+		} else if i < len(notation) && notation[i] >= '0' && notation[i] <= '9' {
+			for moveRange = 0; i < len(notation) && notation[i] >= '0' && notation[i] <= '9'; i++ {
+				moveRange = moveRange*10 + int(notation[i]-'0')
+			}
+		}
+
+		move, capture, directions, err := parseBetzaModifiers(modifiers)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Betza notation %q: %s", notation, err)
+		}
+		for _, atom := range []byte(atoms) {
+			for _, v := range betzaVectors(betzaAtoms[atom]) {
+				if directions(v[0], v[1]) {
+					movements = append(movements, Movement{v[0], v[1], moveRange, move, capture})
+				}
+			}
+		}
+	}
+	if len(movements) == 0 {
+		return nil, fmt.Errorf("invalid Betza notation %q: no movements", notation)
+	}
+	return movements, nil
+}
+
+// parseBetzaModifiers returns whether an atom with the modifiers can move and capture,
+// and which of its directions are allowed
+func parseBetzaModifiers(modifiers string) (bool, bool, func(x, y int) bool, error) {
+	move := !strings.ContainsRune(modifiers, 'c') || strings.ContainsRune(modifiers, 'm')
+	capture := !strings.ContainsRune(modifiers, 'm') || strings.ContainsRune(modifiers, 'c')
+
+	single := map[byte]func(x, y int) bool{
+		'f': func(x, y int) bool { return y > 0 },
+		'b': func(x, y int) bool { return y < 0 },
+		'l': func(x, y int) bool { return x < 0 },
+		'r': func(x, y int) bool { return x > 0 },
+		'v': func(x, y int) bool { return abs(y) > abs(x) },
+		's': func(x, y int) bool { return abs(x) > abs(y) },
+	}
+	var allowed []func(x, y int) bool
+	for i := 0; i < len(modifiers); i++ {
+		c := modifiers[i]
+		if c == 'm' || c == 'c' {
+			continue
+		}
+		direction, ok := single[c]
+		if !ok {
+			return false, false, nil, fmt.Errorf("unsupported modifier %q", c)
+		}
+		if (c == 'f' || c == 'b') && i+1 < len(modifiers) && (modifiers[i+1] == 'l' || modifiers[i+1] == 'r') {
+			vertical, horizontal := direction, single[modifiers[i+1]]
+			direction = func(x, y int) bool { return vertical(x, y) && horizontal(x, y) }
+			i++
+		}
+		allowed = append(allowed, direction)
+	}
+
+	directions := func(x, y int) bool {
+		for _, direction := range allowed {
+			if direction(x, y) {
+				return true
+			}
+		}
+		return len(allowed) == 0
+	}
+	return move, capture, directions, nil
+}
+
+// betzaVectors returns the distinct directions of a leap, e.g. the eight of a knight
+func betzaVectors(atom [2]int) [][2]int {
+	var vectors [][2]int
+	seen := map[[2]int]bool{}
+	for _, v := range [][2]int{{atom[0], atom[1]}, {atom[1], atom[0]}} {
+		for _, sx := range []int{1, -1} {
+			for _, sy := range []int{1, -1} {
+				vector := [2]int{v[0] * sx, v[1] * sy}
+				if !seen[vector] {
+					seen[vector] = true
+					vectors = append(vectors, vector)
+				}
+			}
+		}
+	}
+	return vectors
+}
+
+// abs returns the absolute value of an integer
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,171 @@
+package chess
+
+import (
+	"sync"
+	"testing"
+)
+
+func registerFairyPieces(t *testing.T) {
+	for _, f := range []FairyPiece{Archbishop, Chancellor, Amazon, Camel, Nightrider} {
+		if err := RegisterPiece(f); err != nil {
+			t.Fatalf("unexpected error registering the %s: %s", f.Name, err)
+		}
+	}
+}
+
+func TestParseBetza(t *testing.T) {
+	for notation, expected := range map[string]struct {
+		Movements, Riders, MoveOnly, CaptureOnly int
+	}{
+		"N":      {8, 0, 0, 0},
+		"NN":     {8, 8, 0, 0},
+		"BN":     {12, 4, 0, 0},
+		"Q":      {8, 8, 0, 0},
+		"fK":     {3, 0, 0, 0},
+		"fmWfcF": {3, 0, 1, 2},
+		"W4":     {4, 0, 0, 0},
+		"flF":    {1, 0, 0, 0},
+		"vRsW":   {4, 2, 0, 0},
+		"C":      {8, 0, 0, 0},
+	} {
+		movements, err := ParseBetza(notation)
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %s", notation, err)
+			continue
+		}
+		var riders, moveOnly, captureOnly int
+		for _, m := range movements {
+			if m.Range == 0 {
+				riders++
+			}
+			if !m.Capture {
+				moveOnly++
+			}
+			if !m.Move {
+				captureOnly++
+			}
+		}
+		if len(movements) != expected.Movements || riders != expected.Riders ||
+			moveOnly != expected.MoveOnly || captureOnly != expected.CaptureOnly {
+			t.Errorf("unexpected movements for %q: %+v", notation, movements)
+		}
+	}
+
+	if movements, _ := ParseBetza("W4"); movements[0].Range != 4 {
+		t.Errorf("expected W4 to ride up to 4 squares, actual: %+v", movements[0])
+	}
+	for _, notation := range []string{"", "X", "fm", "qN", "P"} {
+		if _, err := ParseBetza(notation); err == nil {
+			t.Errorf("parsing %q should have failed", notation)
+		}
+	}
+}
+
+func TestRegisterPiece(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, f := range []FairyPiece{Archbishop, Chancellor, Amazon, Camel, Nightrider} {
+				if err := RegisterPiece(f); err != nil {
+					t.Errorf("unexpected error registering the %s: %s", f.Name, err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if len(Pieces) != standardPieces+10 || len(fairies) != 5 || len(fairyKeys) != 10 {
+		t.Errorf("expected each piece to be registered once, actual: %d pieces", len(Pieces))
+	}
+	if err := RegisterPiece(Archbishop); err != nil {
+		t.Errorf("registering the same piece twice should do nothing, actual: %s", err)
+	}
+	for _, f := range []FairyPiece{
+		{Symbol: 'N', Name: "knight", Betza: "N"},
+		{Symbol: 'A', Name: "alfil", Betza: "A"},
+		{Symbol: 'x', Name: "lowercase", Betza: "W"},
+		{Symbol: 'P', Name: "pawn", Betza: "fmW"},
+		{Symbol: 'Y', Name: "nothing", Betza: "?"},
+	} {
+		if err := RegisterPiece(f); err == nil {
+			t.Errorf("registering %+v should have failed", f)
+		}
+	}
+
+	archbishop := Pieces[PieceIndex(BLACK, Archbishop.Symbol)]
+	if archbishop.Color != BLACK || archbishop.Value != 7 || archbishop.Symbol.String() != "archbishop" {
+		t.Errorf("unexpected archbishop: %+v", archbishop)
+	}
+	if archbishop.Unicode() != "\U0001FA59" || Pieces[PieceIndex(WHITE, Camel.Symbol)].Unicode() != "L" {
+		t.Errorf("unexpected glyphs for fairy pieces")
+	}
+
+	board, _ := NewBoard()
+	if len(board.Positions) != len(Pieces) {
+		t.Errorf("expected a position for every piece, actual: %d", len(board.Positions))
+	}
+	if _, err := ParseFEN("4k3/8/8/8/8/8/8/4K3[A] w - - 0 1"); err == nil {
+		t.Error("a fairy piece in a Crazyhouse pocket should be rejected")
+	}
+
+	// Keys come from the pieces rather than the order they were registered in
+	board, _ = ParseFEN("4k3/8/8/8/8/8/8/A3K2h w - - 0 1")
+	if board.Hash() != 0x6533c67b5f781bd5 {
+		t.Errorf("expected the hash to be 0x6533c67b5f781bd5, actual: %#016x", board.Hash())
+	}
+}
+
+func TestFairyMoves(t *testing.T) {
+	registerFairyPieces(t)
+	cases := []struct {
+		FEN     string
+		Moves   int
+		InCheck bool
+	}{
+		// Seven bishop moves, two knight moves and five king moves
+		{"4k3/8/8/8/8/8/8/A3K3 w - - 0 1", 14, false},
+		{"4k3/8/8/8/8/8/8/L3K3 w - - 0 1", 7, false},
+		{"4k3/8/8/8/8/8/8/C3K3 w - - 0 1", 17, false},
+		{"4k3/8/8/8/8/8/8/M3K3 w - - 0 1", 24, false},
+		{"8/8/8/8/8/8/8/H3K3 w - - 0 1", 11, false},
+		// Knight moves give check and riders can be blocked, pinning the blocker
+		{"4k3/8/3A4/8/8/8/8/4K3 b - - 0 1", 2, true},
+		{"8/3k4/8/8/8/8/8/H3K3 b - - 0 1", 8, true},
+		{"8/3k4/8/8/8/1p6/8/H3K3 b - - 0 1", 8, false},
+		{"8/8/8/8/8/8/2k5/L3K3 b - - 0 1", 6, false},
+	}
+	for _, c := range cases {
+		board, err := ParseFEN(c.FEN)
+		if err != nil {
+			t.Errorf("unexpected error parsing %s: %s", c.FEN, err)
+			continue
+		}
+		if board.FEN() != c.FEN {
+			t.Errorf("expected %s, actual: %s", c.FEN, board.FEN())
+		}
+		if moves := board.LegalMoves(); len(moves) != c.Moves {
+			t.Errorf("expected %d moves in %s, actual: %d %v", c.Moves, c.FEN, len(moves), moves)
+		}
+		if board.InCheck() != c.InCheck {
+			t.Errorf("expected check to be %t in %s", c.InCheck, c.FEN)
+		}
+	}
+
+	board, _ := ParseFEN("4k3/8/8/8/8/8/8/A3K3 w - - 0 1")
+	m, err := board.ParseMove("Ac2")
+	if err != nil {
+		t.Fatalf("unexpected error parsing Ac2: %s", err)
+	}
+	if san := board.SAN(m); san != "Ac2" {
+		t.Errorf("expected Ac2, actual: %s", san)
+	}
+	start := board.Hash()
+	board.MakeMove(m)
+	if board.Hash() != board.computeHash() || board.Hash() == start {
+		t.Errorf("incremental hash doesn't match after moving a fairy piece")
+	}
+	if board.IsInsufficientMaterial() {
+		t.Errorf("a fairy piece should be sufficient material")
+	}
+}
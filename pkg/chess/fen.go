@@ -0,0 +1,225 @@
+package chess
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StartFEN is the Forsyth-Edwards Notation of the standard starting position
+const StartFEN = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
+
+// FENSymbol returns the letter used for the piece in FEN, uppercase for white and
+// lowercase for black
+func (p Piece) FENSymbol() string {
+	symbol := p.Symbol
+	if symbol == PAWN {
+		symbol = 'P'
+	}
+	if p.Color == BLACK {
+		return strings.ToLower(string(rune(symbol)))
+	}
+	return string(rune(symbol))
+}
+
+// pieceFromFEN returns the index of the piece for a FEN letter, or NoPiece
+func pieceFromFEN(r rune) int {
+	color := WHITE
+	if r >= 'a' && r <= 'z' {
+		color = BLACK
+		r -= 'a' - 'A'
+	}
+	symbol := Symbol(r)
+	if symbol == 'P' {
+		symbol = PAWN
+	} else if _, ok := PieceNames[symbol]; !ok {
+		return NoPiece
+	}
+	return PieceIndex(color, symbol)
+}
+
+// ParseFEN returns a new board set up from a position in Forsyth-Edwards Notation. The
+// move counters may be omitted, in which case they default to 0 and 1. A pocket after
+// the board, e.g. "[Qp]", sets the board up for Crazyhouse with "~" marking promoted pieces.
+// A count of the checks left to give after the en passant square, e.g. "3+2", or of the
+// checks given at the end, e.g. "+0+1", sets the board up for Three-check.
+func ParseFEN(fen string) (*Board, error) {
+	return parseFEN(fen, nil)
+}
+
+// parseFEN parses a position to be played under a variant's rules, which only decide
+// where pawns may stand
+func parseFEN(fen string, v Variant) (*Board, error) {
+	fields := strings.Fields(fen)
+	checks := ""
+	if n := len(fields); (n == 5 || n == 7) && strings.Contains(fields[4], "+") {
+		checks, fields = fields[4], append(fields[:4:4], fields[5:]...)
+	} else if n == 7 && strings.HasPrefix(fields[6], "+") {
+		checks, fields = fields[6], fields[:6]
+	}
+	if len(fields) != 4 && len(fields) != 6 {
+		return nil, fmt.Errorf("invalid FEN %q: expected 6 fields, found %d", fen, len(fields))
+	}
+
+	board, err := newBoard(make([]Bitboard, len(Pieces)))
+	if err != nil {
+		return nil, err
+	}
+
+	// Crazyhouse pockets follow the board either in brackets, e.g. "RNBQKBNR[Qp]", or as
+	// a ninth rank, e.g. "RNBQKBNR/Qp"
+	placement := fields[0]
+	ranks := strings.Split(placement, "/")
+	if i := strings.IndexByte(placement, '['); i >= 0 && strings.HasSuffix(placement, "]") {
+		ranks = strings.Split(placement[:i], "/")
+		ranks = append(ranks, placement[i+1:len(placement)-1])
+	}
+	if len(ranks) == RANKS+1 {
+		if v != nil && v != Crazyhouse {
+			return nil, fmt.Errorf("invalid FEN %q: pockets can't be used in %s", fen, v.Name())
+		}
+		if err := board.parsePocket(ranks[RANKS]); err != nil {
+			return nil, fmt.Errorf("invalid FEN %q: %s", fen, err)
+		}
+		board.Variant, ranks = Crazyhouse, ranks[:RANKS]
+	}
+	if len(ranks) != RANKS {
+		return nil, fmt.Errorf("invalid FEN %q: expected %d ranks, found %d", fen, RANKS, len(ranks))
+	}
+	for i, row := range ranks {
+		rank, file := RANKS-1-i, 0
+		for _, r := range row {
+			if r >= '1' && r <= '8' {
+				file += int(r - '0')
+				continue
+			}
+			if r == '~' && file > 0 {
+				board.promoted.SetBit(CartesianToBit(file-1, rank))
+				continue
+			}
+			piece := pieceFromFEN(r)
+			if piece == NoPiece {
+				return nil, fmt.Errorf("invalid FEN %q: unknown piece %q", fen, r)
+			}
+			if file >= FILES {
+				return nil, fmt.Errorf("invalid FEN %q: rank %d has more than %d files", fen, rank+1, FILES)
+			}
+			board.PlacePiece(piece, CartesianToBit(file, rank))
+			file++
+		}
+		if file != FILES {
+			return nil, fmt.Errorf("invalid FEN %q: rank %d does not have %d files", fen, rank+1, FILES)
+		}
+	}
+
+	if err := board.checkPawns(v); err != nil {
+		return nil, fmt.Errorf("invalid FEN %q: %s", fen, err)
+	}
+
+	switch fields[1] {
+	case "w":
+		board.Turn = WHITE
+	case "b":
+		board.Turn = BLACK
+	default:
+		return nil, fmt.Errorf("invalid FEN %q: unknown side to move %q", fen, fields[1])
+	}
+
+	if err := board.parseCastling(fields[2]); err != nil {
+		return nil, fmt.Errorf("invalid FEN %q: %s", fen, err)
+	}
+
+	board.EnPassant = NoSquare
+	if fields[3] != "-" {
+		sq, err := ParseSquare(fields[3])
+		if err != nil || (sq/FILES != 2 && sq/FILES != 5) {
+			return nil, fmt.Errorf("invalid FEN %q: bad en passant square %q", fen, fields[3])
+		}
+		board.EnPassant = sq
+	}
+
+	if checks != "" {
+		if err := board.parseChecks(checks); err != nil {
+			return nil, fmt.Errorf("invalid FEN %q: %s", fen, err)
+		}
+	}
+
+	board.HalfMoveClock, board.FullMoveNumber = 0, 1
+	if len(fields) == 6 {
+		if board.HalfMoveClock, err = strconv.Atoi(fields[4]); err != nil || board.HalfMoveClock < 0 {
+			return nil, fmt.Errorf("invalid FEN %q: bad halfmove clock %q", fen, fields[4])
+		}
+		if board.FullMoveNumber, err = strconv.Atoi(fields[5]); err != nil || board.FullMoveNumber < 1 {
+			return nil, fmt.Errorf("invalid FEN %q: bad fullmove number %q", fen, fields[5])
+		}
+	}
+
+	board.Rehash()
+	return board, nil
+}
+
+// FEN returns the position in Forsyth-Edwards Notation. Castling rights on a Chess960
+// board are given in X-FEN, which only differs from standard FEN when an inner rook may castle.
+func (b *Board) FEN() string {
+	return b.fen(false)
+}
+
+func (b *Board) fen(shredder bool) string {
+	var s strings.Builder
+	for rank := RANKS - 1; rank >= 0; rank-- {
+		empty := 0
+		for file := 0; file < FILES; file++ {
+			occupied, piece := b.GetSquare(CartesianToBit(file, rank))
+			if !occupied {
+				empty++
+				continue
+			}
+			if empty > 0 {
+				s.WriteString(strconv.Itoa(empty))
+				empty = 0
+			}
+			s.WriteString(piece.FENSymbol())
+			if b.Variant == Crazyhouse && b.promoted.IsBitSet(CartesianToBit(file, rank)) {
+				s.WriteByte('~')
+			}
+		}
+		if empty > 0 {
+			s.WriteString(strconv.Itoa(empty))
+		}
+		if rank > 0 {
+			s.WriteByte('/')
+		}
+	}
+	if b.Variant == Crazyhouse {
+		fmt.Fprintf(&s, "[%s%s]", b.Pockets[WHITE].format(WHITE), b.Pockets[BLACK].format(BLACK))
+	}
+
+	turn := "w"
+	if b.Turn == BLACK {
+		turn = "b"
+	}
+	enPassant := "-"
+	if b.EnPassant != NoSquare {
+		enPassant = BitToAlgebraic(b.EnPassant)
+	}
+	fmt.Fprintf(&s, " %s %s %s", turn, b.castlingString(shredder), enPassant)
+	if b.Variant == ThreeCheck {
+		fmt.Fprintf(&s, " %d+%d", 3-b.Checks[WHITE], 3-b.Checks[BLACK])
+	}
+	fmt.Fprintf(&s, " %d %d", b.HalfMoveClock, b.FullMoveNumber)
+	return s.String()
+}
+
+// checkPawns rejects pawns on the first or eighth rank, which no game can reach. White
+// pawns start on the first rank in Horde.
+func (b *Board) checkPawns(v Variant) error {
+	const backRanks = Bitboard(0xff000000000000ff)
+	white, black := b.pieces(WHITE, PAWN)&backRanks, b.pieces(BLACK, PAWN)&backRanks
+	if v == Horde {
+		white &^= 0xff
+	}
+	if pawns := white | black; pawns != 0 {
+		return fmt.Errorf("pawn on %s", BitToAlgebraic(pawns.popLowestBit()))
+	}
+	return nil
+}
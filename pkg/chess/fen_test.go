@@ -0,0 +1,62 @@
+package chess
+
+import "testing"
+
+func TestParseFEN(t *testing.T) {
+	board, err := ParseFEN(StartFEN)
+	if err != nil {
+		t.Fatalf("unexpected error parsing start position: %s", err)
+	}
+	start, _ := NewBoard()
+	for i := range start.Positions {
+		if board.Positions[i] != start.Positions[i] {
+			t.Errorf("expected %s bitboard 0x%016x, actual: 0x%016x", Pieces[i], start.Positions[i], board.Positions[i])
+		}
+	}
+	if board.Hash() != start.Hash() || board.Castling != AllCastling || board.Turn != WHITE {
+		t.Errorf("parsed start position should match a new board")
+	}
+
+	for _, fen := range []string{
+		StartFEN,
+		"rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq e6 0 2",
+		"r3k2r/8/8/8/8/8/8/4K2R b Kq - 5 40",
+		"8/8/8/8/8/8/8/k6K w - - 0 1",
+	} {
+		board, err := ParseFEN(fen)
+		if err != nil {
+			t.Errorf("unexpected error parsing %s: %s", fen, err)
+		} else if board.FEN() != fen {
+			t.Errorf("expected %s, actual: %s", fen, board.FEN())
+		}
+	}
+
+	for _, fen := range []string{
+		"",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP w KQkq - 0 1",
+		"rnbqkbnr/pppppppp/9/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNRR w KQkq - 0 1",
+		"rnbqkbnx/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR x KQkq - 0 1",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQxq - 0 1",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq e4 0 1",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - a 1",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 0",
+		"4k3/8/8/8/8/8/8/p3K3 b - - 0 1",
+		"P3k3/8/8/8/8/8/8/4K3 w - - 0 1",
+		"4k3/8/8/8/8/8/8/P3K3 w - - 0 1",
+		"p3k3/8/8/8/8/8/8/4K3 b - - 0 1",
+	} {
+		if _, err := ParseFEN(fen); err == nil {
+			t.Errorf("parsing %q should have failed", fen)
+		}
+	}
+
+	// White pawns start on the first rank in Horde
+	if _, err := NewBoardWith(WithVariant(Horde), WithFEN("4k3/8/8/8/8/8/8/P7 w - - 0 1")); err != nil {
+		t.Errorf("unexpected error parsing a Horde pawn on the first rank: %s", err)
+	}
+	if _, err := NewBoardWith(WithVariant(Horde), WithFEN("4k3/8/8/8/8/8/8/p7 b - - 0 1")); err == nil {
+		t.Error("a black pawn on the first rank should be rejected in Horde")
+	}
+}
@@ -0,0 +1,193 @@
+package chess
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The text encodings below are used by encoding/json, encoding/xml and encoding/gob,
+// so boards, pieces and bitboards can be passed through APIs in their usual notation.
+
+// MarshalText encodes the color as "white" or "black"
+func (c Color) MarshalText() ([]byte, error) {
+	if c != WHITE && c != BLACK {
+		return nil, fmt.Errorf("invalid color %d", c)
+	}
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText decodes "white" or "black", or "w" or "b" as in FEN, ignoring case
+func (c *Color) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "white", "w":
+		*c = WHITE
+	case "black", "b":
+		*c = BLACK
+	default:
+		return fmt.Errorf("invalid color %q", text)
+	}
+	return nil
+}
+
+// MarshalText encodes the symbol as its uppercase letter, "P" for pawns
+func (s Symbol) MarshalText() ([]byte, error) {
+	if _, ok := PieceNames[s]; !ok {
+		return nil, fmt.Errorf("invalid symbol %q", rune(s))
+	}
+	if s == PAWN {
+		return []byte("P"), nil
+	}
+	return []byte(string(rune(s))), nil
+}
+
+// UnmarshalText decodes a piece letter in either case
+func (s *Symbol) UnmarshalText(text []byte) error {
+	letter := []rune(strings.ToUpper(string(text)))
+	if len(letter) != 1 {
+		return fmt.Errorf("invalid symbol %q", text)
+	}
+	symbol := Symbol(letter[0])
+	if symbol == 'P' {
+		symbol = PAWN
+	}
+	if _, ok := PieceNames[symbol]; !ok {
+		return fmt.Errorf("invalid symbol %q", text)
+	}
+	*s = symbol
+	return nil
+}
+
+// MarshalText encodes the piece as its color's initial and its letter, e.g. "wN" or "bP"
+func (p Piece) MarshalText() ([]byte, error) {
+	symbol, err := p.Symbol.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	if p.Color != WHITE && p.Color != BLACK {
+		return nil, fmt.Errorf("invalid color %d", p.Color)
+	}
+	return append([]byte{"wb"[p.Color]}, symbol...), nil
+}
+
+// UnmarshalText decodes a piece encoded by MarshalText into the matching entry of Pieces
+func (p *Piece) UnmarshalText(text []byte) error {
+	if len(text) < 2 {
+		return fmt.Errorf("invalid piece %q", text)
+	}
+	var color Color
+	var symbol Symbol
+	if color.UnmarshalText(text[:1]) != nil || symbol.UnmarshalText(text[1:]) != nil {
+		return fmt.Errorf("invalid piece %q", text)
+	}
+	i := PieceIndex(color, symbol)
+	if i == NoPiece {
+		return fmt.Errorf("invalid piece %q", text)
+	}
+	*p = Pieces[i]
+	return nil
+}
+
+// Squares returns the squares set in the bitboard in algebraic notation, from a1 to h8
+func (b Bitboard) Squares() []string {
+	squares := []string{}
+	for b != 0 {
+		squares = append(squares, BitToAlgebraic(b.popLowestBit()))
+	}
+	return squares
+}
+
+// MarshalText encodes the bitboard in hexadecimal, e.g. "0x000000000000ff00"
+func (b Bitboard) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("0x%016x", uint64(b))), nil
+}
+
+// UnmarshalText decodes a bitboard in hexadecimal with a "0x" prefix or a list of
+// squares separated by commas or spaces, e.g. "e4,d5"
+func (b *Bitboard) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		v, err := strconv.ParseUint(s[2:], 16, 64)
+		if err != nil {
+			return fmt.Errorf("invalid bitboard %q", text)
+		}
+		*b = Bitboard(v)
+		return nil
+	}
+	var bitboard Bitboard
+	for _, square := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' }) {
+		sq, err := ParseSquare(strings.ToLower(square))
+		if err != nil {
+			return fmt.Errorf("invalid bitboard %q: %s", text, err)
+		}
+		bitboard.SetBit(sq)
+	}
+	*b = bitboard
+	return nil
+}
+
+// MarshalText encodes the board's position in FEN. Rules the FEN can't record, such as
+// a variant other than Crazyhouse or Three-check or Chess960 castling from the standard
+// squares, are given before it separated by a colon, e.g. "Atomic:rnbqkbnr/...". The
+// move history isn't kept.
+func (b *Board) MarshalText() ([]byte, error) {
+	fen := b.FEN()
+	parsed, err := parseFEN(fen, b.Variant)
+	if err != nil {
+		return nil, err
+	}
+	var rules []string
+	if parsed.VariantName() != b.VariantName() {
+		rules = append(rules, b.VariantName())
+	}
+	if b.Chess960 && !parsed.Chess960 {
+		rules = append(rules, "Chess960")
+	}
+	if len(rules) == 0 {
+		return []byte(fen), nil
+	}
+	return []byte(strings.Join(rules, ",") + ":" + fen), nil
+}
+
+// UnmarshalText sets the board to a position encoded by MarshalText
+func (b *Board) UnmarshalText(text []byte) error {
+	fen, variant, chess960 := string(text), Standard, false
+	if i := strings.IndexByte(fen, ':'); i >= 0 {
+		for _, name := range strings.Split(fen[:i], ",") {
+			if strings.EqualFold(name, "Chess960") {
+				chess960 = true
+				continue
+			}
+			v, err := VariantByName(name)
+			if err != nil {
+				return err
+			}
+			variant = v
+		}
+		fen = fen[i+1:]
+	}
+
+	if fen == "" {
+		return fmt.Errorf("invalid board: no FEN")
+	}
+	board, err := NewBoardWith(WithVariant(variant), WithFEN(fen))
+	if err != nil {
+		return err
+	}
+	if chess960 {
+		board.Chess960 = true
+	}
+	*b = *board
+	return nil
+}
+
+// GobEncode encodes the board as text for encoding/gob, which otherwise would use the
+// binary encoding that only covers standard and Chess960 positions
+func (b *Board) GobEncode() ([]byte, error) {
+	return b.MarshalText()
+}
+
+// GobDecode decodes a board encoded by GobEncode
+func (b *Board) GobDecode(data []byte) error {
+	return b.UnmarshalText(data)
+}
@@ -0,0 +1,137 @@
+package chess
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMarshalPieces(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Pieces  []Piece
+		Turn    Color
+		Symbols []Symbol
+	}{[]Piece{WhiteKnight, BlackQueen, WhitePawn}, BLACK, []Symbol{PAWN, KING}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"Pieces":["wN","bQ","wP"],"Turn":"black","Symbols":["P","K"]}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+
+	var decoded struct {
+		Pieces  []Piece
+		Turn    Color
+		Symbols []Symbol
+	}
+	if err := json.Unmarshal([]byte(`{"Pieces":["wN","bq","wP"],"Turn":"b","Symbols":["p","K"]}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Pieces, []Piece{WhiteKnight, BlackQueen, WhitePawn}) || decoded.Turn != BLACK ||
+		!reflect.DeepEqual(decoded.Symbols, []Symbol{PAWN, KING}) {
+		t.Errorf("unexpected decoding %+v", decoded)
+	}
+
+	for _, text := range []string{`"xN"`, `"wX"`, `"w"`, `"wNN"`} {
+		var p Piece
+		if err := json.Unmarshal([]byte(text), &p); err == nil {
+			t.Errorf("expected an error decoding piece %s", text)
+		}
+	}
+	var c Color
+	if err := json.Unmarshal([]byte(`"red"`), &c); err == nil {
+		t.Error("expected an error decoding an unknown color")
+	}
+	if _, err := json.Marshal(Color(2)); err == nil {
+		t.Error("expected an error encoding an invalid color")
+	}
+	if _, err := json.Marshal(Symbol('X')); err == nil {
+		t.Error("expected an error encoding an unknown symbol")
+	}
+}
+
+func TestMarshalBitboard(t *testing.T) {
+	data, _ := json.Marshal(initWhitePawns)
+	if string(data) != `"0x000000000000ff00"` {
+		t.Errorf("expected the pawns in hexadecimal, got %s", data)
+	}
+	var b Bitboard
+	if err := json.Unmarshal(data, &b); err != nil || b != initWhitePawns {
+		t.Errorf("expected the pawns back, got %x, %v", uint64(b), err)
+	}
+	if err := json.Unmarshal([]byte(`"e4, D5 h8"`), &b); err != nil || !reflect.DeepEqual(b.Squares(), []string{"e4", "d5", "h8"}) {
+		t.Errorf("expected e4, d5 and h8, got %v, %v", b.Squares(), err)
+	}
+	if err := json.Unmarshal([]byte(`""`), &b); err != nil || b != 0 {
+		t.Errorf("expected an empty bitboard, got %x, %v", uint64(b), err)
+	}
+	for _, text := range []string{`"0xg"`, `"e9"`, `"e4;d5"`} {
+		if err := json.Unmarshal([]byte(text), &b); err == nil {
+			t.Errorf("expected an error decoding bitboard %s", text)
+		}
+	}
+}
+
+func TestMarshalBoard(t *testing.T) {
+	chess960, _ := ParseFEN(StartFEN)
+	chess960.Chess960 = true
+	atomic, _ := NewBoardWith(WithVariant(Atomic))
+	crazyhouse, _ := NewBoardWith(WithVariant(Crazyhouse), WithFEN("r1bqkbnr/pppp1ppp/2n5/4p3/4P3/5N2/PPPP1PPP/RNBQKB1R[Pp] w KQkq - 2 3"))
+	threeCheck, _ := ParseFEN("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3+2 0 1")
+	shredder, _ := ParseFEN("bqnb1rkr/pp3ppp/3ppn2/2p5/5P2/P2P4/NPP1P1PP/BQ1BNRKR w HFhf - 2 9")
+	standard, _ := NewBoard()
+	standard.Play("e2e4")
+
+	for _, board := range []*Board{standard, chess960, atomic, crazyhouse, threeCheck, shredder} {
+		data, err := json.Marshal(board)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Board
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("%s: %s", data, err)
+		}
+		if decoded.FEN() != board.FEN() || decoded.VariantName() != board.VariantName() || decoded.Chess960 != board.Chess960 ||
+			decoded.Hash() != board.Hash() {
+			t.Errorf("expected %s, got %s", board.FEN(), decoded.FEN())
+		}
+	}
+
+	if data, _ := json.Marshal(standard); string(data) != `"rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1"` {
+		t.Errorf("expected the FEN, got %s", data)
+	}
+	if data, _ := json.Marshal(atomic); string(data) != `"Atomic:`+StartFEN+`"` {
+		t.Errorf("expected the FEN after the variant, got %s", data)
+	}
+	var board Board
+	for _, text := range []string{`"Bogus:` + StartFEN + `"`, `"8/8/8 w - - 0 1"`} {
+		if err := json.Unmarshal([]byte(text), &board); err == nil {
+			t.Errorf("expected an error decoding board %s", text)
+		}
+	}
+}
+
+func TestGobBoard(t *testing.T) {
+	type position struct {
+		Board  *Board
+		Piece  Piece
+		Pawns  Bitboard
+		Player Color
+	}
+	atomic, _ := NewBoardWith(WithVariant(Atomic), WithFEN("rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq - 0 1"))
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(position{atomic, BlackKing, initBlackPawns, BLACK}); err != nil {
+		t.Fatal(err)
+	}
+	var decoded position
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Board.FEN() != atomic.FEN() || decoded.Board.Variant != Atomic || decoded.Piece != BlackKing ||
+		decoded.Pawns != initBlackPawns || decoded.Player != BLACK {
+		t.Errorf("unexpected decoding %+v", decoded)
+	}
+}
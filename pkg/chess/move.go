@@ -0,0 +1,347 @@
+package chess
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// CastlingRights is a bit set of the castling moves each side may still make
+type CastlingRights uint8
+
+// WhiteKingSide WhiteQueenSide etc... are the individual castling rights
+const (
+	WhiteKingSide CastlingRights = 1 << iota
+	WhiteQueenSide
+	BlackKingSide
+	BlackQueenSide
+
+	NoCastling  CastlingRights = 0
+	AllCastling CastlingRights = WhiteKingSide | WhiteQueenSide | BlackKingSide | BlackQueenSide
+)
+
+func kingSide(c Color) CastlingRights {
+	if c == WHITE {
+		return WhiteKingSide
+	}
+	return BlackKingSide
+}
+
+func queenSide(c Color) CastlingRights {
+	if c == WHITE {
+		return WhiteQueenSide
+	}
+	return BlackQueenSide
+}
+
+// String formats the castling rights the way they appear in FEN, e.g. "KQkq" or "-"
+func (c CastlingRights) String() string {
+	s := ""
+	for i, right := range []CastlingRights{WhiteKingSide, WhiteQueenSide, BlackKingSide, BlackQueenSide} {
+		if c&right != 0 {
+			s += string("KQkq"[i])
+		}
+	}
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// MoveFlag marks moves with special rules
+type MoveFlag uint8
+
+// DoublePush is a pawn moving two squares from its starting rank
+// EnPassant is a pawn capturing a pawn that has just made a double push
+// KingSideCastle and QueenSideCastle move the king and a rook together
+// Drop places a piece from the pocket on an empty square in Crazyhouse
+const (
+	DoublePush MoveFlag = 1 << iota
+	EnPassant
+	KingSideCastle
+	QueenSideCastle
+	Drop
+)
+
+// Move describes a move from one square to another. Piece and Captured are indices into
+// Board.Pieces (Captured is NoPiece for quiet moves) and Promotion is the symbol of the
+// piece a pawn promotes to, or PAWN when there is no promotion. Drops have no origin
+// square, i.e. From is NoSquare.
+type Move struct {
+	From      int
+	To        int
+	Piece     int
+	Captured  int
+	Promotion Symbol
+	Flags     MoveFlag
+}
+
+// IsCapture checks if the move captures a piece
+func (m Move) IsCapture() bool {
+	return m.Captured != NoPiece
+}
+
+// IsCastle checks if the move is castling on either side
+func (m Move) IsCastle() bool {
+	return m.Flags&(KingSideCastle|QueenSideCastle) != 0
+}
+
+// IsPromotion checks if the move is a pawn promotion
+func (m Move) IsPromotion() bool {
+	return m.Promotion != PAWN
+}
+
+// UCI formats the move in the long algebraic notation used by the Universal Chess
+// Interface, e.g. "e2e4", "e1g1", "e7e8q" or "N@f3" for a drop
+func (m Move) UCI() string {
+	if m.IsDrop() {
+		symbol := Pieces[m.Piece].Symbol
+		if symbol == PAWN {
+			symbol = 'P'
+		}
+		return string(rune(symbol)) + "@" + BitToAlgebraic(m.To)
+	}
+	s := BitToAlgebraic(m.From) + BitToAlgebraic(m.To)
+	if m.IsPromotion() {
+		s += strings.ToLower(string(rune(m.Promotion)))
+	}
+	return s
+}
+
+func (m Move) String() string {
+	return m.UCI()
+}
+
+// undo stores the state of the board needed to take back a move
+type undo struct {
+	move          Move
+	castling      CastlingRights
+	enPassant     int
+	halfMoveClock int
+	promoted      Bitboard
+	checks        [2]int
+	destroyed     int
+	hash          uint64
+}
+
+// standardCastlingRooks are the rook squares for each castling right in standard chess
+var standardCastlingRooks = [4]int{H1, A1, H8, A8}
+
+// castlingRight returns the castling right used by a castling move
+func (b *Board) castlingRight(m Move) CastlingRights {
+	color := b.Pieces[m.Piece].Color
+	if m.Flags&KingSideCastle != 0 {
+		return kingSide(color)
+	}
+	return queenSide(color)
+}
+
+// castlingRook returns the original square of the rook for a castling right
+func (b *Board) castlingRook(right CastlingRights) int {
+	return b.castlingRooks[bits.TrailingZeros8(uint8(right))]
+}
+
+// castlingRookSquares returns the rook's origin and destination squares for a castling move
+func (b *Board) castlingRookSquares(m Move) (int, int) {
+	rank := m.From - m.From%FILES
+	if m.Flags&KingSideCastle != 0 {
+		return b.castlingRook(b.castlingRight(m)), rank + 5
+	}
+	return b.castlingRook(b.castlingRight(m)), rank + 3
+}
+
+// castlingLost returns the castling rights a move gives up: every right of a king that
+// moves and the right of any castling rook that moves or is captured
+func (b *Board) castlingLost(m Move) CastlingRights {
+	var lost CastlingRights
+	if piece := b.Pieces[m.Piece]; piece.Symbol == KING {
+		lost = kingSide(piece.Color) | queenSide(piece.Color)
+	}
+	for i, rook := range b.castlingRooks {
+		if rook == m.From || rook == m.To {
+			lost |= 1 << uint(i)
+		}
+	}
+	return lost
+}
+
+// MakeMove plays a move on the board. The move must be legal for the current position,
+// e.g. one returned by LegalMoves, ParseSAN or ParseUCI; it can be taken back with UnmakeMove.
+func (b *Board) MakeMove(m Move) {
+	b.history = append(b.history, undo{m, b.Castling, b.EnPassant, b.HalfMoveClock, b.promoted, b.Checks, len(b.destroyed), b.hash})
+	b.hash ^= b.enPassantKey() ^ castlingKey(b.Castling) ^ turnKey(b.Turn)
+
+	if m.IsCapture() {
+		captureSquare := m.To
+		if m.Flags&EnPassant != 0 {
+			captureSquare = m.To - 8
+			if b.Turn == BLACK {
+				captureSquare = m.To + 8
+			}
+		}
+		if b.Variant == Crazyhouse && !b.bughouse {
+			b.addToPocket(b.Turn, b.capturedSymbol(m))
+		}
+		b.RemovePiece(m.Captured, captureSquare)
+		b.promoted.ClearBit(captureSquare)
+	}
+
+	if m.IsDrop() {
+		b.removeFromPocket(b.Turn, b.Pieces[m.Piece].Symbol)
+		b.PlacePiece(m.Piece, m.To)
+	} else {
+		b.movePieces(m)
+	}
+
+	b.Castling &^= b.castlingLost(m)
+	if b.Variant != nil {
+		b.Variant.AfterMove(b, m)
+	}
+	b.EnPassant = NoSquare
+	if m.Flags&DoublePush != 0 {
+		b.EnPassant = (m.From + m.To) / 2
+	}
+	if m.IsCapture() || b.Pieces[m.Piece].Symbol == PAWN {
+		b.HalfMoveClock = 0
+	} else {
+		b.HalfMoveClock++
+	}
+	if b.Turn == BLACK {
+		b.FullMoveNumber++
+	}
+	b.Turn = b.Turn.Opponent()
+
+	b.hash ^= b.enPassantKey() ^ castlingKey(b.Castling) ^ turnKey(b.Turn)
+}
+
+// UnmakeMove takes back the last move made on the board, returning it. If no moves
+// have been made an error is returned.
+func (b *Board) UnmakeMove() (Move, error) {
+	if len(b.history) == 0 {
+		return Move{}, fmt.Errorf("no moves to take back")
+	}
+	u := b.history[len(b.history)-1]
+	b.history = b.history[:len(b.history)-1]
+	m := u.move
+
+	b.Turn = b.Turn.Opponent()
+	if b.Turn == BLACK {
+		b.FullMoveNumber--
+	}
+
+	b.Castling = u.castling
+	b.EnPassant = u.enPassant
+	b.HalfMoveClock = u.halfMoveClock
+	defer func() { b.hash = u.hash }()
+
+	for len(b.destroyed) > u.destroyed {
+		d := b.destroyed[len(b.destroyed)-1]
+		b.destroyed = b.destroyed[:len(b.destroyed)-1]
+		b.PlacePiece(d.piece, d.square)
+	}
+	b.promoted = u.promoted
+	b.Checks = u.checks
+	if m.IsDrop() {
+		b.RemovePiece(m.Piece, m.To)
+		b.addToPocket(b.Turn, b.Pieces[m.Piece].Symbol)
+		return m, nil
+	}
+	if m.From == NoSquare {
+		return m, nil
+	}
+
+	rook, rookFrom, rookTo := PieceIndex(b.Turn, ROOK), NoSquare, NoSquare
+	if m.IsCastle() {
+		rookFrom, rookTo = b.castlingRookSquares(m)
+		b.RemovePiece(rook, rookTo)
+	}
+
+	if m.IsPromotion() {
+		b.RemovePiece(PieceIndex(b.Turn, m.Promotion), m.To)
+	} else {
+		b.RemovePiece(m.Piece, m.To)
+	}
+	b.PlacePiece(m.Piece, m.From)
+
+	if m.IsCastle() {
+		b.PlacePiece(rook, rookFrom)
+	}
+
+	if m.IsCapture() {
+		captureSquare := m.To
+		if m.Flags&EnPassant != 0 {
+			captureSquare = m.To - 8
+			if b.Turn == BLACK {
+				captureSquare = m.To + 8
+			}
+		}
+		b.PlacePiece(m.Captured, captureSquare)
+		if b.Variant == Crazyhouse && !b.bughouse {
+			b.removeFromPocket(b.Turn, b.capturedSymbol(m))
+		}
+	}
+
+	return m, nil
+}
+
+// movePieces moves the piece, and the rook when castling, for a move that isn't a drop,
+// keeping track of promoted pieces
+func (b *Board) movePieces(m Move) {
+	// In Chess960 the king and rook may land on each other's squares when castling, so
+	// both are lifted before either is put down
+	rook, rookFrom, rookTo := PieceIndex(b.Turn, ROOK), NoSquare, NoSquare
+	if m.IsCastle() {
+		rookFrom, rookTo = b.castlingRookSquares(m)
+		b.RemovePiece(rook, rookFrom)
+	}
+
+	b.RemovePiece(m.Piece, m.From)
+	if m.IsPromotion() {
+		b.PlacePiece(PieceIndex(b.Turn, m.Promotion), m.To)
+	} else {
+		b.PlacePiece(m.Piece, m.To)
+	}
+
+	if m.IsCastle() {
+		b.PlacePiece(rook, rookTo)
+	}
+
+	if m.IsPromotion() || b.promoted.IsBitSet(m.From) {
+		b.promoted.ClearBit(m.From)
+		b.promoted.SetBit(m.To)
+	}
+}
+
+// MakeNullMove passes the turn to the opponent without moving a piece. Null moves are
+// not legal chess moves but are used by search algorithms; take back with UnmakeMove.
+func (b *Board) MakeNullMove() {
+	b.history = append(b.history, undo{nullMove, b.Castling, b.EnPassant, b.HalfMoveClock, b.promoted, b.Checks, len(b.destroyed), b.hash})
+	b.hash ^= b.enPassantKey() ^ turnKey(b.Turn)
+	b.EnPassant = NoSquare
+	b.HalfMoveClock++
+	if b.Turn == BLACK {
+		b.FullMoveNumber++
+	}
+	b.Turn = b.Turn.Opponent()
+	b.hash ^= turnKey(b.Turn)
+}
+
+// nullMove is recorded in the board history for a null move
+var nullMove = Move{NoSquare, NoSquare, NoPiece, NoPiece, PAWN, 0}
+
+// History returns the moves made on the board since it was set up, oldest first
+func (b *Board) History() []Move {
+	moves := make([]Move, len(b.history))
+	for i, u := range b.history {
+		moves[i] = u.move
+	}
+	return moves
+}
+
+// LastMove returns the last move made on the board, if there is one
+func (b *Board) LastMove() (Move, bool) {
+	if len(b.history) == 0 {
+		return Move{}, false
+	}
+	return b.history[len(b.history)-1].move, true
+}
@@ -0,0 +1,308 @@
+package chess
+
+import "math/bits"
+
+//-----------------------------------------------------------------------------
+// Move generation
+//-----------------------------------------------------------------------------
+
+var promotions = []Symbol{QUEEN, ROOK, BISHOP, KNIGHT}
+
+// PseudoLegalMoves returns every move for the side to move that obeys the movement rules
+// of the pieces, without checking whether the move would leave the king in check
+func (b *Board) PseudoLegalMoves() []Move {
+	return b.generateMoves(make([]Move, 0, 64), false)
+}
+
+// PseudoLegalCaptures returns the captures and promotions for the side to move, without
+// checking whether the move would leave the king in check
+func (b *Board) PseudoLegalCaptures() []Move {
+	return b.generateMoves(make([]Move, 0, 16), true)
+}
+
+// LegalMoves returns every legal move for the side to move
+func (b *Board) LegalMoves() []Move {
+	moves := b.PseudoLegalMoves()
+	legal := moves[:0]
+	for _, m := range moves {
+		if b.IsLegal(m) {
+			legal = append(legal, m)
+		}
+	}
+	return legal
+}
+
+// IsLegal checks whether a pseudo-legal move leaves the mover's own king safe, or
+// otherwise obeys the rules of the board's variant
+func (b *Board) IsLegal(m Move) bool {
+	if b.Variant != nil {
+		return b.Variant.IsLegal(b, m)
+	}
+	return b.leavesKingSafe(m)
+}
+
+// leavesKingSafe checks whether a pseudo-legal move leaves the mover's own king safe
+func (b *Board) leavesKingSafe(m Move) bool {
+	us := b.Turn
+	b.MakeMove(m)
+	king := b.pieces(us, KING)
+	legal := king == 0 || !b.IsAttacked(bits.TrailingZeros64(uint64(king)), b.Turn)
+	b.UnmakeMove()
+	return legal
+}
+
+// HasLegalMoves checks whether the side to move has at least one legal move
+func (b *Board) HasLegalMoves() bool {
+	for _, m := range b.PseudoLegalMoves() {
+		if b.IsLegal(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindMove returns the legal move between two squares with the given promotion (PAWN if
+// the move is not a promotion)
+func (b *Board) FindMove(from, to int, promotion Symbol) (Move, bool) {
+	for _, m := range b.LegalMoves() {
+		if m.From == from && m.To == to && m.Promotion == promotion {
+			return m, true
+		}
+	}
+	return Move{}, false
+}
+
+func (b *Board) generateMoves(moves []Move, capturesOnly bool) []Move {
+	us, them := b.Turn, b.Turn.Opponent()
+	own, enemy := b.colorOccupied(us), b.colorOccupied(them)
+	targets := ^own
+	if capturesOnly {
+		targets = enemy
+	}
+
+	moves = b.generatePawnMoves(moves, enemy, capturesOnly)
+
+	for _, symbol := range []Symbol{KNIGHT, BISHOP, ROOK, QUEEN, KING} {
+		piece := PieceIndex(us, symbol)
+		for from := b.Positions[piece]; from != 0; {
+			sq := from.popLowestBit()
+			var attacks Bitboard
+			switch symbol {
+			case KNIGHT:
+				attacks = knightAttacks[sq]
+			case BISHOP:
+				attacks = BishopAttacks(sq, b.Occupied)
+			case ROOK:
+				attacks = RookAttacks(sq, b.Occupied)
+			case QUEEN:
+				attacks = QueenAttacks(sq, b.Occupied)
+			case KING:
+				attacks = kingAttacks[sq]
+			}
+			for attacks &= targets; attacks != 0; {
+				to := attacks.popLowestBit()
+				moves = append(moves, Move{sq, to, piece, b.pieceAt(to), PAWN, 0})
+			}
+		}
+	}
+
+	moves = b.generateFairyMoves(moves, enemy, capturesOnly)
+
+	if !capturesOnly {
+		moves = b.generateCastling(moves)
+		moves = b.generateDrops(moves)
+	}
+
+	if b.Variant != nil {
+		moves = b.Variant.GenerateMoves(b, moves)
+		if capturesOnly {
+			filtered := moves[:0]
+			for _, m := range moves {
+				if m.IsCapture() || m.IsPromotion() {
+					filtered = append(filtered, m)
+				}
+			}
+			moves = filtered
+		}
+	}
+	return moves
+}
+
+func (b *Board) generatePawnMoves(moves []Move, enemy Bitboard, capturesOnly bool) []Move {
+	us := b.Turn
+	piece := PieceIndex(us, PAWN)
+	forward, startRank, lastRank := 8, 1, 7
+	if us == BLACK {
+		forward, startRank, lastRank = -8, 6, 0
+	}
+
+	add := func(m Move) {
+		if m.To/FILES == lastRank {
+			for _, promotion := range promotions {
+				m.Promotion = promotion
+				moves = append(moves, m)
+			}
+		} else {
+			moves = append(moves, m)
+		}
+	}
+
+	for pawns := b.Positions[piece]; pawns != 0; {
+		from := pawns.popLowestBit()
+		if from/FILES == lastRank {
+			continue // Only reachable by setting a board up by hand
+		}
+
+		if to := from + forward; !b.Occupied.IsBitSet(to) {
+			if !capturesOnly || to/FILES == lastRank {
+				add(Move{from, to, piece, NoPiece, PAWN, 0})
+			}
+			if to2 := to + forward; !capturesOnly && from/FILES == startRank && !b.Occupied.IsBitSet(to2) {
+				moves = append(moves, Move{from, to2, piece, NoPiece, PAWN, DoublePush})
+			}
+		}
+
+		for attacks := pawnAttacks[us][from] & enemy; attacks != 0; {
+			to := attacks.popLowestBit()
+			add(Move{from, to, piece, b.pieceAt(to), PAWN, 0})
+		}
+
+		if b.EnPassant != NoSquare && pawnAttacks[us][from].IsBitSet(b.EnPassant) {
+			moves = append(moves, Move{from, b.EnPassant, piece, PieceIndex(us.Opponent(), PAWN), PAWN, EnPassant})
+		}
+	}
+	return moves
+}
+
+func (b *Board) generateCastling(moves []Move) []Move {
+	us, them := b.Turn, b.Turn.Opponent()
+	rank := 0
+	if us == BLACK {
+		rank = 56
+	}
+	king, rook := PieceIndex(us, KING), PieceIndex(us, ROOK)
+	if b.Castling&(kingSide(us)|queenSide(us)) == 0 || b.Positions[king].Population() != 1 {
+		return moves
+	}
+	kingSquare := bits.TrailingZeros64(uint64(b.Positions[king]))
+	if kingSquare/FILES != rank/FILES {
+		return moves
+	}
+
+	for _, c := range []struct {
+		right          CastlingRights
+		flag           MoveFlag
+		kingTo, rookTo int
+	}{
+		{kingSide(us), KingSideCastle, rank + 6, rank + 5},
+		{queenSide(us), QueenSideCastle, rank + 2, rank + 3},
+	} {
+		rookSquare := b.castlingRook(c.right)
+		if b.Castling&c.right == 0 || !b.Positions[rook].IsBitSet(rookSquare) {
+			continue
+		}
+		// Apart from the king and rook themselves every square either crosses must be
+		// empty, and the king may not start on or pass through an attacked square. The
+		// destination is checked by IsLegal once the rook has moved out of the way, so
+		// the king's own square is added back for a king already on its destination.
+		others := b.Occupied &^ (Bitboard(1)<<uint(kingSquare) | Bitboard(1)<<uint(rookSquare))
+		if others&(span(kingSquare, c.kingTo)|span(rookSquare, c.rookTo)) != 0 {
+			continue
+		}
+		attacked := false
+		path := span(kingSquare, c.kingTo)&^(Bitboard(1)<<uint(c.kingTo)) | Bitboard(1)<<uint(kingSquare)
+		for path != 0 && !attacked {
+			attacked = b.IsAttacked(path.popLowestBit(), them)
+		}
+		if !attacked {
+			moves = append(moves, Move{kingSquare, c.kingTo, king, NoPiece, PAWN, c.flag})
+		}
+	}
+	return moves
+}
+
+// span returns the squares from one square to another on the same line, inclusive
+func span(from, to int) Bitboard {
+	return Between(from, to) | Bitboard(1)<<uint(from) | Bitboard(1)<<uint(to)
+}
+
+//-----------------------------------------------------------------------------
+// Game termination
+//-----------------------------------------------------------------------------
+
+// IsCheckmate checks whether the side to move is in check and has no legal moves
+func (b *Board) IsCheckmate() bool {
+	return b.InCheck() && !b.HasLegalMoves()
+}
+
+// IsStalemate checks whether the side to move is not in check and has no legal moves
+func (b *Board) IsStalemate() bool {
+	return !b.InCheck() && !b.HasLegalMoves()
+}
+
+// IsInsufficientMaterial checks whether neither side has enough material left to
+// checkmate: king against king, king and minor piece against king, or kings and
+// bishops all on squares of the same color
+func (b *Board) IsInsufficientMaterial() bool {
+	for _, symbol := range []Symbol{PAWN, ROOK, QUEEN} {
+		if b.pieces(WHITE, symbol)|b.pieces(BLACK, symbol) != 0 {
+			return false
+		}
+	}
+	if b.hasFairyPieces() {
+		return false
+	}
+	knights := b.pieces(WHITE, KNIGHT) | b.pieces(BLACK, KNIGHT)
+	bishops := b.pieces(WHITE, BISHOP) | b.pieces(BLACK, BISHOP)
+	minors := knights.Population() + bishops.Population()
+	if minors <= 1 {
+		return true
+	}
+	const darkSquares = Bitboard(0xaa55aa55aa55aa55)
+	return knights == 0 && (bishops&darkSquares == 0 || bishops&^darkSquares == 0)
+}
+
+// IsFiftyMoveDraw checks whether fifty moves by each side have been made without a
+// capture or pawn move, allowing either player to claim a draw
+func (b *Board) IsFiftyMoveDraw() bool {
+	return b.HalfMoveClock >= 100
+}
+
+// Repetitions counts how many times the current position has occurred in the board's
+// history, including the current occurrence
+func (b *Board) Repetitions() int {
+	count := 1
+	for i := len(b.history) - 1; i >= 0 && i >= len(b.history)-b.HalfMoveClock; i-- {
+		if b.history[i].hash == b.hash {
+			count++
+		}
+	}
+	return count
+}
+
+// IsThreefoldRepetition checks whether the current position has occurred three times
+func (b *Board) IsThreefoldRepetition() bool {
+	return b.Repetitions() >= 3
+}
+
+// Perft counts the leaf nodes of the legal move tree to the given depth, which is used to
+// verify move generation against known results. See <https://www.chessprogramming.org/Perft>.
+func (b *Board) Perft(depth int) int64 {
+	if depth <= 0 {
+		return 1
+	}
+	var nodes int64
+	for _, m := range b.PseudoLegalMoves() {
+		if !b.IsLegal(m) {
+			continue
+		}
+		if depth == 1 {
+			nodes++
+			continue
+		}
+		b.MakeMove(m)
+		nodes += b.Perft(depth - 1)
+		b.UnmakeMove()
+	}
+	return nodes
+}
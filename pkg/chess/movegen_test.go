@@ -0,0 +1,99 @@
+package chess
+
+import "testing"
+
+var perftPositions = []struct {
+	FEN    string
+	Counts []int64
+}{
+	{StartFEN, []int64{20, 400, 8902, 197281}},
+	{"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1", []int64{48, 2039, 97862}},
+	{"8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1", []int64{14, 191, 2812, 43238}},
+	{"r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1", []int64{6, 264, 9467}},
+	{"rnbq1k1r/pp1Pbppp/2p5/8/2B5/8/PPP1NnPP/RNBQK2R w KQ - 1 8", []int64{44, 1486, 62379}},
+}
+
+func TestPerft(t *testing.T) {
+	for _, position := range perftPositions {
+		board, err := ParseFEN(position.FEN)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", position.FEN, err)
+		}
+		hash := board.Hash()
+		for i, expected := range position.Counts {
+			if actual := board.Perft(i + 1); actual != expected {
+				t.Errorf("perft(%d) of %s expected %d, actual: %d", i+1, position.FEN, expected, actual)
+			}
+		}
+		if board.FEN() != position.FEN || board.Hash() != hash {
+			t.Errorf("perft should leave the board unchanged, expected %s, actual: %s", position.FEN, board.FEN())
+		}
+	}
+}
+
+func TestMakeUnmakeMove(t *testing.T) {
+	board, _ := NewBoard()
+	if _, err := board.UnmakeMove(); err == nil {
+		t.Errorf("taking back a move on a new board should error")
+	}
+	if err := board.Play("e4", "c5", "Nf3"); err != nil {
+		t.Fatalf("unexpected error playing moves: %s", err)
+	}
+	expected := "rnbqkbnr/pp1ppppp/8/2p5/4P3/5N2/PPPP1PPP/RNBQKB1R b KQkq - 1 2"
+	if board.FEN() != expected {
+		t.Errorf("expected %s, actual: %s", expected, board.FEN())
+	}
+	if len(board.History()) != 3 {
+		t.Errorf("expected 3 moves of history, actual: %d", len(board.History()))
+	}
+	for i := 0; i < 3; i++ {
+		board.UnmakeMove()
+	}
+	if board.FEN() != StartFEN {
+		t.Errorf("expected start position after taking back all moves, actual: %s", board.FEN())
+	}
+
+	board.MakeNullMove()
+	if board.Turn != BLACK {
+		t.Errorf("null move should pass the turn to black")
+	}
+	board.UnmakeMove()
+	if board.FEN() != StartFEN {
+		t.Errorf("expected start position after taking back null move, actual: %s", board.FEN())
+	}
+}
+
+func TestGameTermination(t *testing.T) {
+	tests := tests{}
+
+	mate, _ := ParseFEN("rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3")
+	tests = append(tests, test{mate.IsCheckmate(), true, "Fool's mate should be checkmate", nil})
+	tests = append(tests, test{mate.IsStalemate(), false, "Fool's mate should not be stalemate", nil})
+
+	stalemate, _ := ParseFEN("7k/5Q2/6K1/8/8/8/8/8 b - - 0 1")
+	tests = append(tests, test{stalemate.IsStalemate(), true, "Black king with no moves should be stalemate", nil})
+	tests = append(tests, test{stalemate.IsCheckmate(), false, "Stalemate should not be checkmate", nil})
+
+	for fen, insufficient := range map[string]bool{
+		"8/8/4k3/8/8/3K4/8/8 w - - 0 1":    true,
+		"8/8/4k3/8/8/3KN3/8/8 w - - 0 1":   true,
+		"8/8/2b1k3/8/8/3KB3/8/8 w - - 0 1": false,
+		"8/8/3bk3/8/8/3KB3/8/8 w - - 0 1":  true,
+		"8/8/4k3/8/8/3KR3/8/8 w - - 0 1":   false,
+		"8/8/4k3/8/8/2NKN3/8/8 w - - 0 1":  false,
+	} {
+		board, _ := ParseFEN(fen)
+		tests = append(tests, test{board.IsInsufficientMaterial(), insufficient, "Insufficient material " + fen, nil})
+	}
+
+	board, _ := NewBoard()
+	board.Play("Nf3", "Nf6", "Ng1", "Ng8", "Nf3", "Nf6", "Ng1")
+	tests = append(tests, test{board.IsThreefoldRepetition(), false, "Position has only occurred twice", nil})
+	board.Play("Ng8")
+	tests = append(tests, test{board.IsThreefoldRepetition(), true, "Start position has occurred three times", nil})
+
+	fifty, _ := ParseFEN("8/8/4k3/8/8/3KR3/8/8 w - - 100 80")
+	tests = append(tests, test{fifty.IsFiftyMoveDraw(), true, "Halfmove clock of 100 should be a fifty move draw", nil})
+
+	tests.Run(t)
+}
@@ -0,0 +1,222 @@
+package chess
+
+import (
+	"fmt"
+	"strings"
+)
+
+//-----------------------------------------------------------------------------
+// Move notation
+//-----------------------------------------------------------------------------
+
+// SAN formats a legal move in Standard Algebraic Notation for the current position,
+// e.g. "e4", "Nbd7", "exd5", "O-O", "e8=Q#" or "N@f3"
+func (b *Board) SAN(m Move) string {
+	var s string
+	piece := b.Pieces[m.Piece]
+
+	switch {
+	case m.IsDrop():
+		s = m.UCI()
+	case m.Flags&KingSideCastle != 0:
+		s = "O-O"
+	case m.Flags&QueenSideCastle != 0:
+		s = "O-O-O"
+	case piece.Symbol == PAWN:
+		if m.IsCapture() {
+			s = BitToAlgebraic(m.From)[:1] + "x"
+		}
+		s += BitToAlgebraic(m.To)
+		if m.IsPromotion() {
+			s += "=" + string(rune(m.Promotion))
+		}
+	default:
+		s = string(rune(piece.Symbol)) + b.disambiguate(m)
+		if m.IsCapture() {
+			s += "x"
+		}
+		s += BitToAlgebraic(m.To)
+	}
+
+	b.MakeMove(m)
+	if b.InCheck() {
+		if b.HasLegalMoves() {
+			s += "+"
+		} else {
+			s += "#"
+		}
+	}
+	b.UnmakeMove()
+	return s
+}
+
+// disambiguate returns the file, rank or square needed to tell a piece move apart from
+// moves by other pieces of the same type to the same square
+func (b *Board) disambiguate(m Move) string {
+	var sameFile, sameRank, ambiguous bool
+	for _, other := range b.LegalMoves() {
+		if other.Piece != m.Piece || other.To != m.To || other.From == m.From {
+			continue
+		}
+		ambiguous = true
+		sameFile = sameFile || other.From%FILES == m.From%FILES
+		sameRank = sameRank || other.From/FILES == m.From/FILES
+	}
+	from := BitToAlgebraic(m.From)
+	switch {
+	case !ambiguous:
+		return ""
+	case !sameFile:
+		return from[:1]
+	case !sameRank:
+		return from[1:]
+	}
+	return from
+}
+
+// ParseSAN returns the legal move described in Standard Algebraic Notation. Check and
+// annotation suffixes are optional and zeros are accepted for castling.
+func (b *Board) ParseSAN(san string) (Move, error) {
+	s := strings.TrimRight(strings.TrimSpace(san), "+#!?")
+	s = strings.Replace(s, "0", "O", -1)
+	if strings.HasSuffix(s, "e.p.") {
+		s = strings.TrimSpace(strings.TrimSuffix(s, "e.p."))
+	}
+
+	if strings.ContainsRune(s, '@') {
+		return b.parseDrop(s)
+	}
+
+	if s == "O-O" || s == "O-O-O" {
+		flag := KingSideCastle
+		if s == "O-O-O" {
+			flag = QueenSideCastle
+		}
+		for _, m := range b.LegalMoves() {
+			if m.Flags&flag != 0 {
+				return m, nil
+			}
+		}
+		return Move{}, fmt.Errorf("illegal move %q: castling is not allowed", san)
+	}
+
+	symbol := PAWN
+	if len(s) > 0 && s[0] >= 'A' && s[0] <= 'Z' && Symbol(s[0]).String() != "" {
+		symbol = Symbol(s[0])
+		s = s[1:]
+	}
+
+	promotion := PAWN
+	if n := len(s); symbol == PAWN && n >= 3 {
+		if i := strings.IndexByte(s, '='); i >= 0 {
+			p := strings.ToUpper(s[i+1:])
+			if len(p) != 1 || !strings.ContainsAny(p, "QRBNK") {
+				return Move{}, fmt.Errorf("invalid move %q: bad promotion", san)
+			}
+			promotion, s = Symbol(p[0]), s[:i]
+		} else if strings.ContainsRune("QRBNKqrbnk", rune(s[n-1])) && s[n-2] >= '1' && s[n-2] <= '8' {
+			promotion, s = Symbol(strings.ToUpper(s[n-1:])[0]), s[:n-1]
+		}
+	}
+
+	if len(s) < 2 {
+		return Move{}, fmt.Errorf("invalid move %q", san)
+	}
+	to, err := ParseSquare(s[len(s)-2:])
+	if err != nil {
+		return Move{}, fmt.Errorf("invalid move %q: %s", san, err)
+	}
+	s = strings.TrimSuffix(strings.TrimSuffix(s[:len(s)-2], "x"), "-")
+
+	fromFile, fromRank := -1, -1
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'h':
+			fromFile = int(r - 'a')
+		case r >= '1' && r <= '8':
+			fromRank = int(r - '1')
+		default:
+			return Move{}, fmt.Errorf("invalid move %q", san)
+		}
+	}
+
+	var matches []Move
+	for _, m := range b.LegalMoves() {
+		if m.To != to || m.Promotion != promotion || b.Pieces[m.Piece].Symbol != symbol || m.IsCastle() {
+			continue
+		}
+		if (fromFile >= 0 && m.From%FILES != fromFile) || (fromRank >= 0 && m.From/FILES != fromRank) {
+			continue
+		}
+		matches = append(matches, m)
+	}
+
+	switch len(matches) {
+	case 0:
+		return Move{}, fmt.Errorf("illegal move %q", san)
+	case 1:
+		return matches[0], nil
+	}
+	return Move{}, fmt.Errorf("ambiguous move %q", san)
+}
+
+// ParseUCI returns the legal move described in UCI long algebraic notation, e.g. "e2e4"
+// or "e7e8q", or a drop such as "N@f3". Castling may be given as the king capturing its own rook, e.g. "e1h1",
+// which is the only form accepted on a Chess960 board.
+func (b *Board) ParseUCI(uci string) (Move, error) {
+	if strings.ContainsRune(uci, '@') {
+		return b.parseDrop(uci)
+	}
+	if len(uci) != 4 && len(uci) != 5 {
+		return Move{}, fmt.Errorf("invalid move %q", uci)
+	}
+	from, err := ParseSquare(uci[0:2])
+	if err != nil {
+		return Move{}, fmt.Errorf("invalid move %q: %s", uci, err)
+	}
+	to, err := ParseSquare(uci[2:4])
+	if err != nil {
+		return Move{}, fmt.Errorf("invalid move %q: %s", uci, err)
+	}
+	promotion := PAWN
+	if len(uci) == 5 {
+		p := strings.ToUpper(uci[4:])
+		if !strings.ContainsAny(p, "QRBNK") {
+			return Move{}, fmt.Errorf("invalid move %q: bad promotion", uci)
+		}
+		promotion = Symbol(p[0])
+	}
+	for _, m := range b.LegalMoves() {
+		if m.From != from || m.Promotion != promotion {
+			continue
+		}
+		if m.IsCastle() {
+			if to == b.castlingRook(b.castlingRight(m)) || (!b.Chess960 && to == m.To) {
+				return m, nil
+			}
+		} else if m.To == to {
+			return m, nil
+		}
+	}
+	return Move{}, fmt.Errorf("illegal move %q", uci)
+}
+
+// ParseMove returns the legal move described in either UCI or Standard Algebraic Notation
+func (b *Board) ParseMove(s string) (Move, error) {
+	if m, err := b.ParseUCI(s); err == nil {
+		return m, nil
+	}
+	return b.ParseSAN(s)
+}
+
+// Play parses a move in UCI or Standard Algebraic Notation and makes it on the board
+func (b *Board) Play(moves ...string) error {
+	for _, s := range moves {
+		m, err := b.ParseMove(s)
+		if err != nil {
+			return err
+		}
+		b.MakeMove(m)
+	}
+	return nil
+}
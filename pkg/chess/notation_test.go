@@ -0,0 +1,82 @@
+package chess
+
+import "testing"
+
+func TestSAN(t *testing.T) {
+	cases := []struct {
+		FEN string
+		UCI string
+		SAN string
+	}{
+		{StartFEN, "g1f3", "Nf3"},
+		{StartFEN, "e2e4", "e4"},
+		{"r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", "e1g1", "O-O"},
+		{"r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", "e1c1", "O-O-O"},
+		{"r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", "a1a8", "Rxa8+"},
+		{"rnbqkbnr/ppp1pppp/8/3p4/4P3/8/PPPP1PPP/RNBQKBNR w KQkq d6 0 2", "e4d5", "exd5"},
+		{"rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3", "e5f6", "exf6"},
+		{"8/4P3/8/8/8/8/k7/4K3 w - - 0 1", "e7e8q", "e8=Q"},
+		{"3r4/4P3/8/8/8/8/k7/4K3 w - - 0 1", "e7d8n", "exd8=N"},
+		{"4k3/8/4K3/8/8/8/8/R7 w - - 0 1", "a1a8", "Ra8#"},
+		{"4k3/8/8/8/8/5N2/8/1N2K3 w - - 0 1", "b1d2", "Nbd2"},
+		{"4k3/8/8/8/8/1N6/8/1N2K3 w - - 0 1", "b1d2", "N1d2"},
+		{"4k3/8/8/8/8/1N3N2/8/1N2K3 w - - 0 1", "b3d2", "Nb3d2"},
+		{"rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3", "e1f2", ""},
+	}
+
+	for _, c := range cases {
+		board, err := ParseFEN(c.FEN)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", c.FEN, err)
+		}
+		m, err := board.ParseUCI(c.UCI)
+		if c.SAN == "" {
+			if err == nil {
+				t.Errorf("%s should be illegal in %s", c.UCI, c.FEN)
+			}
+			continue
+		} else if err != nil {
+			t.Errorf("unexpected error parsing %s in %s: %s", c.UCI, c.FEN, err)
+			continue
+		}
+		if san := board.SAN(m); san != c.SAN {
+			t.Errorf("expected %s for %s in %s, actual: %s", c.SAN, c.UCI, c.FEN, san)
+		}
+		parsed, err := board.ParseSAN(c.SAN)
+		if err != nil {
+			t.Errorf("unexpected error parsing %s in %s: %s", c.SAN, c.FEN, err)
+		} else if parsed != m {
+			t.Errorf("expected %s to parse as %s, actual: %s", c.SAN, m, parsed)
+		}
+	}
+}
+
+func TestParseSANVariants(t *testing.T) {
+	board, _ := ParseFEN("3r4/4P3/8/8/8/1N3N2/8/1N2K2R w K - 0 1")
+	for san, uci := range map[string]string{
+		"0-0":    "e1g1",
+		"O-O+":   "e1g1",
+		"exd8Q":  "e7d8q",
+		"exd8=q": "e7d8q",
+		"e8=R!?": "e7e8r",
+		"Nb3-d2": "b3d2",
+		"Kf2":    "e1f2",
+		"Rh1xh1": "",
+		"Nd2":    "",
+		"e8":     "",
+		"Qd1":    "",
+		"z9":     "",
+		"exd8=K": "",
+	} {
+		m, err := board.ParseSAN(san)
+		if uci == "" {
+			if err == nil {
+				t.Errorf("parsing %q should have failed, actual: %s", san, m)
+			}
+		} else if err != nil {
+			t.Errorf("unexpected error parsing %q: %s", san, err)
+		} else if m.UCI() != uci {
+			t.Errorf("expected %q to parse as %s, actual: %s", san, uci, m)
+		}
+	}
+}
@@ -0,0 +1,453 @@
+package chess
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Game results as they appear in PGN
+const (
+	WhiteWins  = "1-0"
+	BlackWins  = "0-1"
+	Draw       = "1/2-1/2"
+	InProgress = "*"
+)
+
+// sevenTagRoster is the set of tags every PGN game has, in the order they are exported
+var sevenTagRoster = []string{"Event", "Site", "Date", "Round", "White", "Black", "Result"}
+
+// Game is a chess game as recorded in Portable Game Notation: the tags of the header,
+// the moves played (from the standard starting position unless the FEN tag says
+// otherwise) and the result
+type Game struct {
+	Tags   map[string]string
+	Moves  []Move
+	Result string
+
+	position *gamePosition // Board after Moves, kept by Play
+}
+
+// gamePosition is the board after a game's moves, along with the moves and tags it was
+// built from so Play can tell when they've been changed
+type gamePosition struct {
+	board        *Board
+	moves        []Move
+	last         Move
+	fen, variant string
+}
+
+// NewGame returns a game with no moves starting from the standard position, or from
+// the position or variant given by the options. Positions that can only arise in
+// Chess960, Crazyhouse or Three-check are tagged with the variant, and the FEN is only
+// tagged when it isn't the variant's starting position.
+func NewGame(options ...BoardOption) (*Game, error) {
+	var setup boardSetup
+	for _, option := range options {
+		option(&setup)
+	}
+	board, err := NewBoardWith(options...)
+	if err != nil {
+		return nil, err
+	}
+	g := &Game{Tags: map[string]string{}, Result: InProgress}
+	fen := setup.fen
+	if fen == "" && setup.positions != nil {
+		fen = board.FEN()
+	}
+	start := StartFEN
+	if board.Variant != nil {
+		start = board.Variant.StartFEN()
+	}
+	if fen != "" && board.FEN() != start {
+		g.Tags["SetUp"] = "1"
+		g.Tags["FEN"] = fen
+	}
+	if board.Chess960 {
+		g.Tags["Variant"] = "Chess960"
+	} else if board.Variant != nil {
+		g.Tags["Variant"] = board.Variant.Name()
+	}
+	return g, nil
+}
+
+// StartingPosition returns a new board set up with the game's starting position,
+// following the rules of the variant in the Variant tag. Unknown variants are played
+// with the standard rules.
+func (g *Game) StartingPosition() (*Board, error) {
+	variant := g.Tags["Variant"]
+	switch strings.ToLower(strings.Replace(variant, " ", "", -1)) {
+	case "chess960", "fischerandom", "fischerrandom":
+		board, err := g.setUp(Standard)
+		if err != nil {
+			return nil, err
+		}
+		board.Chess960 = true
+		return board, nil
+	}
+	v, err := VariantByName(variant)
+	if err != nil {
+		v = Standard
+	}
+	return g.setUp(v)
+}
+
+// setUp returns a board following a variant's rules set up from the FEN tag or the
+// variant's starting position
+func (g *Game) setUp(v Variant) (*Board, error) {
+	return NewBoardWith(WithVariant(v), WithFEN(g.Tags["FEN"]))
+}
+
+// Board returns a new board with all of the game's moves played on it
+func (g *Game) Board() (*Board, error) {
+	board, err := g.StartingPosition()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range g.Moves {
+		board.MakeMove(m)
+	}
+	return board, nil
+}
+
+// Play parses a move in UCI or Standard Algebraic Notation and adds it to the game. The
+// board is kept between calls rather than replayed from the start, and rebuilt when
+// Moves is assigned or changes length or the FEN or Variant tag changes; moves edited in
+// place before the last one aren't noticed.
+func (g *Game) Play(move string) (Move, error) {
+	p := g.position
+	if p == nil || !p.matches(g) {
+		board, err := g.Board()
+		if err != nil {
+			return Move{}, err
+		}
+		p = &gamePosition{board: board, fen: g.Tags["FEN"], variant: g.Tags["Variant"]}
+		g.position = p
+	}
+	m, err := p.board.ParseMove(move)
+	if err != nil {
+		return Move{}, err
+	}
+	p.board.MakeMove(m)
+	g.Moves = append(g.Moves, m)
+	p.moves, p.last = g.Moves, m
+	return m, nil
+}
+
+// matches checks whether the board is still the position after the game's moves
+func (p *gamePosition) matches(g *Game) bool {
+	n := len(g.Moves)
+	if n != len(p.moves) || p.fen != g.Tags["FEN"] || p.variant != g.Tags["Variant"] {
+		return false
+	}
+	return n == 0 || (&g.Moves[0] == &p.moves[0] && g.Moves[n-1] == p.last)
+}
+
+// SAN returns the game's moves in Standard Algebraic Notation
+func (g *Game) SAN() ([]string, error) {
+	board, err := g.StartingPosition()
+	if err != nil {
+		return nil, err
+	}
+	sans := make([]string, len(g.Moves))
+	for i, m := range g.Moves {
+		sans[i] = board.SAN(m)
+		board.MakeMove(m)
+	}
+	return sans, nil
+}
+
+// String formats the game in Portable Game Notation
+func (g *Game) String() string {
+	var s strings.Builder
+
+	result := g.Result
+	if result == "" {
+		result = InProgress
+	}
+	for _, name := range sevenTagRoster {
+		value, ok := g.Tags[name]
+		if name == "Result" {
+			value, ok = result, true
+		}
+		if !ok {
+			value = "?"
+		}
+		writeTag(&s, name, value)
+	}
+	var others []string
+	for name := range g.Tags {
+		if !isRosterTag(name) {
+			others = append(others, name)
+		}
+	}
+	sort.Strings(others)
+	for _, name := range others {
+		writeTag(&s, name, g.Tags[name])
+	}
+	s.WriteString("\n")
+
+	board, err := g.StartingPosition()
+	if err != nil {
+		board, _ = NewBoard()
+	}
+	var tokens []string
+	for i, m := range g.Moves {
+		if board.Turn == WHITE {
+			tokens = append(tokens, fmt.Sprintf("%d.", board.FullMoveNumber))
+		} else if i == 0 {
+			tokens = append(tokens, fmt.Sprintf("%d...", board.FullMoveNumber))
+		}
+		tokens = append(tokens, board.SAN(m))
+		board.MakeMove(m)
+	}
+	tokens = append(tokens, result)
+
+	// Export format limits lines to 80 characters
+	line := 0
+	for i, token := range tokens {
+		if i > 0 && line+1+len(token) > 80 {
+			s.WriteString("\n")
+			line = 0
+		} else if i > 0 {
+			s.WriteString(" ")
+			line++
+		}
+		s.WriteString(token)
+		line += len(token)
+	}
+	s.WriteString("\n")
+
+	return s.String()
+}
+
+func isRosterTag(name string) bool {
+	for _, tag := range sevenTagRoster {
+		if tag == name {
+			return true
+		}
+	}
+	return false
+}
+
+func writeTag(s *strings.Builder, name, value string) {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, `"`, `\"`, -1)
+	fmt.Fprintf(s, "[%s \"%s\"]\n", name, value)
+}
+
+//-----------------------------------------------------------------------------
+// PGN parsing
+//-----------------------------------------------------------------------------
+
+// PGNReader reads games one at a time from a stream of Portable Game Notation.
+// Comments, variations and numeric annotation glyphs are skipped.
+type PGNReader struct {
+	r        *bufio.Reader
+	games    int
+	line     int
+	lastRune rune
+}
+
+// NewPGNReader returns a reader of the games in a PGN stream
+func NewPGNReader(r io.Reader) *PGNReader {
+	return &PGNReader{r: bufio.NewReader(r), line: 1}
+}
+
+// ParsePGN parses every game in a string of Portable Game Notation
+func ParsePGN(pgn string) ([]*Game, error) {
+	var games []*Game
+	reader := NewPGNReader(strings.NewReader(pgn))
+	for {
+		game, err := reader.Next()
+		if err == io.EOF {
+			return games, nil
+		} else if err != nil {
+			return games, err
+		}
+		games = append(games, game)
+	}
+}
+
+// Next returns the next game in the stream, or io.EOF when there are no more games
+func (p *PGNReader) Next() (*Game, error) {
+	var game *Game
+	var board *Board
+	started, inMovetext := false, false
+	depth := 0
+
+	fail := func(format string, args ...interface{}) (*Game, error) {
+		// Skip the rest of the game so the following game can still be read
+		for {
+			r, err := p.peek()
+			if err != nil || (r == '[' && p.atLineStart()) {
+				break
+			}
+			p.read()
+		}
+		return nil, fmt.Errorf("pgn game %d, line %d: %s", p.games, p.line, fmt.Sprintf(format, args...))
+	}
+
+	for {
+		r, err := p.peek()
+		if err == io.EOF {
+			if !started {
+				return nil, io.EOF
+			}
+			return game, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if !started && !unicode.IsSpace(r) {
+			started = true
+			p.games++
+			game = &Game{Tags: map[string]string{}, Result: InProgress}
+		}
+
+		switch {
+		case unicode.IsSpace(r):
+			p.read()
+		case r == '%' && p.atLineStart():
+			p.skipLine()
+		case r == ';':
+			p.skipLine()
+		case r == '{':
+			if err := p.skipUntil('}'); err != nil {
+				return fail("unterminated comment")
+			}
+		case r == '(':
+			p.read()
+			depth++
+		case r == ')':
+			p.read()
+			depth--
+		case r == '[' && depth == 0:
+			if inMovetext {
+				// A new game is starting without the previous one having a result
+				return game, nil
+			}
+			name, value, err := p.readTag()
+			if err != nil {
+				return fail("%s", err)
+			}
+			game.Tags[name] = value
+		default:
+			token := p.readToken()
+			if depth > 0 || token == "" || strings.HasPrefix(token, "$") {
+				continue
+			}
+			if !inMovetext {
+				inMovetext = true
+				if board, err = game.StartingPosition(); err != nil {
+					return fail("%s", err)
+				}
+			}
+			switch token {
+			case WhiteWins, BlackWins, Draw, InProgress:
+				game.Result = token
+				return game, nil
+			}
+			// Strip move numbers, which may be attached to the move, e.g. "1.e4"
+			token = strings.TrimLeft(strings.TrimLeft(token, "0123456789"), ".")
+			if token == "" {
+				continue
+			}
+			m, err := board.ParseSAN(token)
+			if err != nil {
+				return fail("%s", err)
+			}
+			board.MakeMove(m)
+			game.Moves = append(game.Moves, m)
+		}
+	}
+}
+
+func (p *PGNReader) peek() (rune, error) {
+	r, _, err := p.r.ReadRune()
+	if err != nil {
+		return 0, err
+	}
+	return r, p.r.UnreadRune()
+}
+
+func (p *PGNReader) read() rune {
+	r, _, err := p.r.ReadRune()
+	if err != nil {
+		return 0
+	}
+	if r == '\n' {
+		p.line++
+	}
+	p.lastRune = r
+	return r
+}
+
+func (p *PGNReader) atLineStart() bool {
+	return p.lastRune == '\n' || p.lastRune == 0
+}
+
+func (p *PGNReader) skipLine() {
+	for r := p.read(); r != '\n' && r != 0; r = p.read() {
+	}
+}
+
+func (p *PGNReader) skipUntil(end rune) error {
+	for r := p.read(); r != end; r = p.read() {
+		if r == 0 {
+			return io.ErrUnexpectedEOF
+		}
+	}
+	return nil
+}
+
+// readToken reads a symbol token up to the next whitespace or delimiter
+func (p *PGNReader) readToken() string {
+	var s strings.Builder
+	for {
+		r, err := p.peek()
+		if err != nil || unicode.IsSpace(r) || strings.ContainsRune("[]{}();", r) {
+			if s.Len() == 0 && err == nil {
+				p.read() // unexpected delimiter, e.g. a stray ']'
+			}
+			return s.String()
+		}
+		s.WriteRune(p.read())
+	}
+}
+
+// readTag reads a tag pair, e.g. [Event "F/S Return Match"]
+func (p *PGNReader) readTag() (string, string, error) {
+	p.read() // [
+	var name, value strings.Builder
+	for {
+		r := p.read()
+		if r == 0 || r == '\n' {
+			return "", "", fmt.Errorf("unterminated tag")
+		} else if r == '"' {
+			break
+		} else if !unicode.IsSpace(r) {
+			name.WriteRune(r)
+		}
+	}
+	for {
+		r := p.read()
+		if r == 0 || r == '\n' {
+			return "", "", fmt.Errorf("unterminated tag value")
+		} else if r == '\\' {
+			r = p.read()
+		} else if r == '"' {
+			break
+		}
+		value.WriteRune(r)
+	}
+	for r := p.read(); r != ']'; r = p.read() {
+		if r == 0 || r == '\n' {
+			return "", "", fmt.Errorf("unterminated tag")
+		}
+	}
+	return name.String(), value.String(), nil
+}
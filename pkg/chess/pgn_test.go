@@ -0,0 +1,143 @@
+package chess
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+const fischerSpassky = `[Event "F/S Return Match"]
+[Site "Belgrade, Serbia JUG"]
+[Date "1992.11.04"]
+[Round "29"]
+[White "Fischer, Robert J."]
+[Black "Spassky, Boris V."]
+[Result "1/2-1/2"]
+
+1. e4 e5 2. Nf3 Nc6 3. Bb5 a6 {This opening is called the Ruy Lopez.} 4. Ba4
+Nf6 5. O-O Be7 6. Re1 b5 7. Bb3 d6 8. c3 O-O 9. h3 Nb8 10. d4 Nbd7 11. c4 c6
+12. cxb5 axb5 13. Nc3 Bb7 14. Bg5 b4 15. Nb1 h6 16. Bh4 c5 17. dxe5 Nxe4 18.
+Bxe7 Qxe7 19. exd6 Qf6 20. Nbd2 Nxd6 21. Nc4 Nxc4 22. Bxc4 Nb6 23. Ne5 Rae8 24.
+Bxf7+ Rxf7 25. Nxf7 Rxe1+ 26. Qxe1 Kxf7 27. Qe3 Qg5 28. Qxg5 hxg5 29. b3 Ke6 30.
+a3 Kd6 31. axb4 cxb4 32. Ra5 Nd5 33. f3 Bc8 34. Kf2 Bf5 35. Ra7 g6 36. Ra6+ Kc5
+37. Ke1 Nf4 38. g3 Nxh3 39. Kd2 Kb5 40. Rd6 Kc5 41. Ra6 Nf2 42. g4 Bd3 43. Re6
+1/2-1/2
+`
+
+func TestParsePGN(t *testing.T) {
+	games, err := ParsePGN(fischerSpassky)
+	if err != nil {
+		t.Fatalf("unexpected error parsing PGN: %s", err)
+	}
+	if len(games) != 1 {
+		t.Fatalf("expected 1 game, actual: %d", len(games))
+	}
+	game := games[0]
+	if len(game.Moves) != 85 || game.Result != Draw || game.Tags["White"] != "Fischer, Robert J." {
+		t.Errorf("unexpected game: %d moves, result %s, tags %v", len(game.Moves), game.Result, game.Tags)
+	}
+
+	// Comments are dropped when exporting, otherwise the game should be unchanged
+	expected := strings.Replace(fischerSpassky, " {This opening is called the Ruy Lopez.}", "", 1)
+	exported := game.String()
+	reparsed, err := ParsePGN(exported)
+	if err != nil || len(reparsed) != 1 || len(reparsed[0].Moves) != len(game.Moves) {
+		t.Errorf("exported game should parse back to the same game: %s\n%s", err, exported)
+	}
+	if strings.Join(strings.Fields(exported), " ") != strings.Join(strings.Fields(expected), " ") {
+		t.Errorf("expected export:\n%s\nactual:\n%s", expected, exported)
+	}
+	for _, line := range strings.Split(exported, "\n") {
+		if len(line) > 80 {
+			t.Errorf("exported line longer than 80 characters: %s", line)
+		}
+	}
+}
+
+func TestPGNReader(t *testing.T) {
+	pgn := `[Event "One"]
+[FEN "4k3/8/8/8/8/8/4P3/4K3 w - - 0 1"]
+[SetUp "1"]
+
+1. e4 (1. e3 {variation} Kd7) Kd7 $1 2.Kd2 ; rest of line comment
+% escaped line
+*
+
+[Event "Two"]
+1. e4 e5 2. Qh5 Nc6 3. Bc4 Nf6 4. Qxf7# 1-0
+[Event "Three"]
+1. e4 e5 2. Ke3
+[Event "Four"]
+1. d4 1-0`
+
+	reader := NewPGNReader(strings.NewReader(pgn))
+	expected := []struct {
+		Event string
+		Moves int
+		Err   bool
+	}{
+		{"One", 3, false},
+		{"Two", 7, false},
+		{"", 0, true},
+		{"Four", 1, false},
+	}
+	for i, e := range expected {
+		game, err := reader.Next()
+		if e.Err {
+			if err == nil {
+				t.Errorf("game %d should have failed to parse", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error parsing game %d: %s", i+1, err)
+			continue
+		}
+		if game.Tags["Event"] != e.Event || len(game.Moves) != e.Moves {
+			t.Errorf("game %d expected event %s with %d moves, actual: %s with %d moves",
+				i+1, e.Event, e.Moves, game.Tags["Event"], len(game.Moves))
+		}
+	}
+	if _, err := reader.Next(); err != io.EOF {
+		t.Errorf("expected end of games, actual: %v", err)
+	}
+}
+
+func TestGame(t *testing.T) {
+	game, err := NewGame(WithFEN("4k3/8/8/8/8/8/4P3/4K3 b - - 0 1"))
+	if err != nil {
+		t.Fatalf("unexpected error creating game: %s", err)
+	}
+	if _, err := game.Play("Kd7"); err != nil {
+		t.Errorf("unexpected error playing move: %s", err)
+	}
+	if _, err := game.Play("e2e4"); err != nil {
+		t.Errorf("unexpected error playing move: %s", err)
+	}
+	if _, err := game.Play("e5"); err == nil {
+		t.Errorf("illegal move should not be played")
+	}
+	sans, _ := game.SAN()
+	if strings.Join(sans, " ") != "Kd7 e4" {
+		t.Errorf("expected moves Kd7 e4, actual: %v", sans)
+	}
+	if !strings.Contains(game.String(), "\n1... Kd7 2. e4 *\n") {
+		t.Errorf("expected movetext to start with black's move number, actual:\n%s", game)
+	}
+
+	// Play keeps its board while the moves are only added by Play, and starts again
+	// when they're changed
+	board := game.position.board
+	if _, err := game.Play("Kc6"); err != nil || game.position.board != board {
+		t.Errorf("expected the board to be kept, actual: %v", err)
+	}
+	game.Moves = game.Moves[:1]
+	if _, err := game.Play("e3"); err != nil {
+		t.Errorf("unexpected error playing e3 after taking back moves: %s", err)
+	}
+	game.Tags["FEN"] = "4k3/8/8/8/8/8/3P4/4K3 w - - 0 1"
+	game.Moves = nil
+	if _, err := game.Play("d4"); err != nil || game.position.board.FEN() != "4k3/8/8/8/3P4/8/8/4K3 b - d3 0 1" {
+		t.Errorf("expected d4 from the new position, actual: %v", err)
+	}
+}
@@ -104,6 +104,11 @@ func (c Color) String() string {
 	return ""
 }
 
+// Opponent returns the other color
+func (c Color) Opponent() Color {
+	return c ^ 1
+}
+
 func (s Symbol) String() string {
 	if name, ok := PieceNames[s]; ok {
 		return name
@@ -124,7 +129,8 @@ func (p Piece) Unicode() string {
 		}
 	}
 
-	return ""
+	// Fairy pieces without a glyph fall back to their letter
+	return p.FENSymbol()
 }
 
 // SameType checks piece equality regardless of color
@@ -137,6 +143,34 @@ func (p Piece) Equals(p2 Piece) bool {
 	return p.Color == p2.Color && p.SameType(p2)
 }
 
+// NoPiece is the piece index used when a square or move doesn't involve a piece
+const NoPiece = -1
+
+// PieceIndex returns the index into Pieces (and Board.Positions) of the piece with the
+// given color and symbol, or NoPiece if there isn't one
+func PieceIndex(c Color, s Symbol) int {
+	switch s {
+	case ROOK:
+		return int(c)*6 + WhiteRook.Index
+	case KNIGHT:
+		return int(c)*6 + WhiteKnight.Index
+	case BISHOP:
+		return int(c)*6 + WhiteBishop.Index
+	case QUEEN:
+		return int(c)*6 + WhiteQueen.Index
+	case KING:
+		return int(c)*6 + WhiteKing.Index
+	case PAWN:
+		return int(c)*6 + WhitePawn.Index
+	}
+	for _, p := range Pieces {
+		if p.Color == c && p.Symbol == s {
+			return p.Index
+		}
+	}
+	return NoPiece
+}
+
 func pawn(c Color, i int) Piece {
 	return Piece{c, PAWN, 1, i}
 }
@@ -0,0 +1,149 @@
+package chess
+
+import "math/bits"
+
+// seeOrder is the order attackers join an exchange, least valuable first
+var seeOrder = []Symbol{PAWN, KNIGHT, BISHOP, ROOK, QUEEN, KING}
+
+// leastValuableAttacker returns the square and index of the cheapest piece of the given
+// color among the attackers, or NoSquare when there are none
+func (b *Board) leastValuableAttacker(attackers Bitboard, c Color) (int, int) {
+	for _, s := range seeOrder {
+		i := PieceIndex(c, s)
+		if i == NoPiece {
+			continue
+		}
+		if bb := attackers & b.Positions[i]; bb != 0 {
+			return bits.TrailingZeros64(uint64(bb)), i
+		}
+	}
+	return NoSquare, NoPiece
+}
+
+// sliders returns the bishops, rooks and queens of both colors, the pieces that can
+// attack through a square once the piece on it has moved
+func (b *Board) sliders() (Bitboard, Bitboard) {
+	queens := b.pieces(WHITE, QUEEN) | b.pieces(BLACK, QUEEN)
+	diagonal := b.pieces(WHITE, BISHOP) | b.pieces(BLACK, BISHOP) | queens
+	straight := b.pieces(WHITE, ROOK) | b.pieces(BLACK, ROOK) | queens
+	return diagonal, straight
+}
+
+// SEE (Static Exchange Evaluation) returns the material balance, in Piece.Value units,
+// of the sequence of captures on the destination square of the move when both sides
+// always capture with their least valuable piece and may stop capturing at any time.
+// Pieces lined up behind each other (x-rays) join the exchange in turn and a king only
+// captures when the square is no longer defended. Castling always evaluates to 0.
+// See <https://www.chessprogramming.org/Static_Exchange_Evaluation>.
+func (b *Board) SEE(m Move) int {
+	if m.IsCastle() {
+		return 0
+	}
+
+	var gain [32]int
+	occupied := b.Occupied &^ (Bitboard(1) << uint(m.From))
+	if m.IsCapture() {
+		gain[0] = int(b.Pieces[m.Captured].Value)
+		if m.Flags&EnPassant != 0 {
+			occupied &^= Bitboard(1) << uint(m.To^8)
+		}
+	}
+	onSquare := int(b.Pieces[m.Piece].Value)
+	if m.IsPromotion() {
+		promoted := int(b.Pieces[PieceIndex(b.Pieces[m.Piece].Color, m.Promotion)].Value)
+		gain[0] += promoted - onSquare
+		onSquare = promoted
+	}
+
+	diagonal, straight := b.sliders()
+	attackers := b.AttackersTo(m.To, occupied) & occupied
+	side := b.Pieces[m.Piece].Color.Opponent()
+	depth := 0
+	for depth+1 < len(gain) {
+		sq, i := b.leastValuableAttacker(attackers, side)
+		if sq == NoSquare {
+			break
+		}
+		if b.Pieces[i].Symbol == KING && attackers&b.colorOccupied(side.Opponent()) != 0 {
+			break // the king can't capture on a defended square
+		}
+		depth++
+		gain[depth] = onSquare - gain[depth-1]
+		onSquare = int(b.Pieces[i].Value)
+
+		// Uncover any sliders behind the capturing piece
+		occupied &^= Bitboard(1) << uint(sq)
+		attackers |= BishopAttacks(m.To, occupied)&diagonal | RookAttacks(m.To, occupied)&straight
+		attackers &= occupied
+		side = side.Opponent()
+	}
+
+	// Either side may stand pat rather than continue a losing exchange
+	for ; depth > 0; depth-- {
+		if gain[depth] > -gain[depth-1] {
+			gain[depth-1] = -gain[depth]
+		}
+	}
+	return gain[0]
+}
+
+// SEEGreaterOrEqual checks if the static exchange evaluation of the move is at least
+// the threshold. It gives the same answer as SEE but stops as soon as the result is
+// known, which makes it suitable for pruning captures during a search.
+func (b *Board) SEEGreaterOrEqual(m Move, threshold int) bool {
+	if m.IsCastle() {
+		return 0 >= threshold
+	}
+	if m.IsPromotion() || m.Flags&EnPassant != 0 {
+		return b.SEE(m) >= threshold
+	}
+
+	// swap is the balance the side to move must beat, from the point of view of the
+	// side that just captured
+	swap := -threshold
+	if m.IsCapture() {
+		swap += int(b.Pieces[m.Captured].Value)
+	}
+	if swap < 0 {
+		return false // even if the moved piece isn't recaptured
+	}
+	swap = int(b.Pieces[m.Piece].Value) - swap
+	if swap <= 0 {
+		return true // even if the moved piece is lost for nothing
+	}
+
+	diagonal, straight := b.sliders()
+	occupied := b.Occupied &^ (Bitboard(1)<<uint(m.From) | Bitboard(1)<<uint(m.To))
+	attackers := b.AttackersTo(m.To, occupied)
+	side := b.Pieces[m.Piece].Color
+	result := true
+	for {
+		side = side.Opponent()
+		attackers &= occupied
+		sq, i := b.leastValuableAttacker(attackers, side)
+		if sq == NoSquare {
+			break
+		}
+		result = !result
+		if b.Pieces[i].Symbol == KING {
+			// The king can only capture if the other side has run out of attackers
+			if attackers&b.colorOccupied(side.Opponent()) != 0 {
+				result = !result
+			}
+			break
+		}
+		if swap = int(b.Pieces[i].Value) - swap; swap < boolToInt(result) {
+			break
+		}
+		occupied &^= Bitboard(1) << uint(sq)
+		attackers |= BishopAttacks(m.To, occupied)&diagonal | RookAttacks(m.To, occupied)&straight
+	}
+	return result
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,71 @@
+package chess
+
+import "testing"
+
+func TestSEE(t *testing.T) {
+	cases := []struct {
+		FEN string
+		UCI string
+		SEE int
+	}{
+		{"1k1r4/1pp4p/p7/4p3/8/P5P1/1PP4P/2K1R3 w - - 0 1", "e1e5", 1},
+		{"1k1r3q/1ppn3p/p4b2/4p3/8/P2N2P1/1PP1R1BP/2K1Q3 w - - 0 1", "d3e5", -2},
+		{"4r1k1/8/8/4p3/8/8/4R3/4R1K1 w - - 0 1", "e2e5", 1},   // x-ray rook recaptures
+		{"4r1k1/8/8/4p3/8/8/4R3/6K1 w - - 0 1", "e2e5", -4},    // the rook is lost for a pawn
+		{"8/8/4k3/3p4/4P3/8/8/4K3 w - - 0 1", "e4d5", 0},       // the king recaptures
+		{"8/8/4k3/3p4/4P3/8/8/3RK3 w - - 0 1", "e4d5", 1},      // the king can't recapture
+		{"3r3k/4P3/8/8/8/8/8/4K3 w - - 0 1", "e7d8q", 13},      // capture and promote
+		{"3r3k/4P3/8/8/8/8/8/4K3 w - - 0 1", "e7e8q", -1},      // the queen is lost
+		{"8/8/8/3pP3/8/8/8/4K2k w - d6 0 1", "e5d6", 1},        // en passant
+		{"4k3/8/8/3p4/8/8/8/3QK3 w - - 0 1", "d1d5", 1},        // undefended
+		{"4k3/8/2p5/3p4/8/8/8/3QK3 w - - 0 1", "d1d5", -8},     // defended by a pawn
+		{"4k3/8/4n3/8/8/8/8/3QK3 w - - 0 1", "d1d4", -9},       // a quiet move into an attack
+		{"4k3/8/8/8/8/8/8/3QK3 w - - 0 1", "d1d4", 0},          // a safe quiet move
+		{"r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", "e1g1", 0},    // castling
+		{"7k/8/8/3r4/8/3R4/3Q4/6K1 w - - 0 1", "d3d5", 5},      // queen behind the rook
+		{"4k3/8/8/3p4/8/8/8/4K3[N] w - - 0 1", "N@c4", -3},     // a drop into an attack
+		{"4k3/8/8/3p4/8/8/8/4K3[N] w - - 0 1", "N@c3", 0},      // a safe drop
+		{"2r4k/2r5/8/2p5/8/8/2R5/2R3K1 w - - 0 1", "c2c5", -4}, // the last word goes to black
+	}
+
+	for _, c := range cases {
+		board, err := ParseFEN(c.FEN)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", c.FEN, err)
+		}
+		m, err := board.ParseUCI(c.UCI)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s in %s: %s", c.UCI, c.FEN, err)
+		}
+		if actual := board.SEE(m); actual != c.SEE {
+			t.Errorf("expected SEE %d for %s in %s, actual: %d", c.SEE, c.UCI, c.FEN, actual)
+		}
+		if !board.SEEGreaterOrEqual(m, c.SEE) || board.SEEGreaterOrEqual(m, c.SEE+1) {
+			t.Errorf("expected SEE >= %d but not %d for %s in %s", c.SEE, c.SEE+1, c.UCI, c.FEN)
+		}
+	}
+}
+
+func TestSEEGreaterOrEqual(t *testing.T) {
+	// The fast path must agree with the full exchange for every move and threshold
+	for _, fen := range []string{
+		StartFEN,
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1",
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R b KQkq - 0 1",
+		"1k1r3q/1ppn3p/p4b2/4p3/8/P2N2P1/1PP1R1BP/2K1Q3 w - - 0 1",
+		"r1bqkb1r/pppp1ppp/2n2n2/4p2Q/2B1P3/8/PPPP1PPP/RNB1K1NR w KQkq - 4 4",
+	} {
+		board, err := ParseFEN(fen)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", fen, err)
+		}
+		for _, m := range board.LegalMoves() {
+			see := board.SEE(m)
+			for threshold := -10; threshold <= 10; threshold++ {
+				if actual := board.SEEGreaterOrEqual(m, threshold); actual != (see >= threshold) {
+					t.Errorf("expected %t for %s >= %d in %s (SEE %d)", see >= threshold, m, threshold, fen, see)
+				}
+			}
+		}
+	}
+}
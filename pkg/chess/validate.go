@@ -0,0 +1,83 @@
+package chess
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Validate checks that the position could arise in a game of standard chess: each side
+// has one king and the side not to move isn't in check, there are no pawns on the first
+// or last rank, neither side has more pieces than it could have promoted to, castling
+// rights belong to a king and rook on their original squares and the en passant square
+// is behind a pawn that has just made a double push.
+func (b *Board) Validate() error {
+	for _, c := range []Color{WHITE, BLACK} {
+		if kings := b.pieces(c, KING).Population(); kings != 1 {
+			return fmt.Errorf("%s has %d kings", c, kings)
+		}
+		if pieces := b.colorOccupied(c).Population(); pieces > 16 {
+			return fmt.Errorf("%s has %d pieces", c, pieces)
+		}
+		pawns := b.pieces(c, PAWN).Population()
+		if pawns > 8 {
+			return fmt.Errorf("%s has %d pawns", c, pawns)
+		}
+		promoted := 0
+		for symbol, count := range map[Symbol]int{QUEEN: 1, ROOK: 2, BISHOP: 2, KNIGHT: 2} {
+			if n := b.pieces(c, symbol).Population(); n > count {
+				promoted += n - count
+			}
+		}
+		if promoted > 8-pawns {
+			return fmt.Errorf("%s has more promoted pieces than missing pawns", c)
+		}
+	}
+
+	if b.IsAttacked(b.kingSquare(b.Turn.Opponent()), b.Turn) {
+		return fmt.Errorf("%s is in check but it's %s's move", b.Turn.Opponent(), b.Turn)
+	}
+	return b.checkState()
+}
+
+// checkState checks that there are no pawns on the first or last rank, castling rights
+// belong to a king and rook on their original squares and the en passant square is behind
+// a pawn that has just made a double push
+func (b *Board) checkState() error {
+	const backRanks = Bitboard(0xff000000000000ff)
+	if (b.pieces(WHITE, PAWN)|b.pieces(BLACK, PAWN))&backRanks != 0 {
+		return fmt.Errorf("pawns on the first or last rank")
+	}
+
+	for i, right := range []CastlingRights{WhiteKingSide, WhiteQueenSide, BlackKingSide, BlackQueenSide} {
+		if b.Castling&right == 0 {
+			continue
+		}
+		c, backRank := Color(i/2), Bitboard(0xff)
+		if c == BLACK {
+			backRank <<= 56
+		}
+		king := b.pieces(c, KING) & backRank
+		if !b.pieces(c, ROOK).IsBitSet(b.castlingRooks[i]) || king == 0 {
+			return fmt.Errorf("castling right %s without a king and rook on their squares", right)
+		}
+		if !b.Chess960 && !king.IsBitSet(E1+56*int(c)) {
+			return fmt.Errorf("castling right %s without a king and rook on their squares", right)
+		}
+		kingSquare := bits.TrailingZeros64(uint64(king))
+		if kingSide := right&(WhiteKingSide|BlackKingSide) != 0; kingSide != (b.castlingRooks[i] > kingSquare) {
+			return fmt.Errorf("castling right %s with the rook on the wrong side of the king", right)
+		}
+	}
+
+	if b.EnPassant != NoSquare {
+		rank, pawn, from := 5, b.EnPassant-8, b.EnPassant+8
+		if b.Turn == BLACK {
+			rank, pawn, from = 2, b.EnPassant+8, b.EnPassant-8
+		}
+		if b.EnPassant/FILES != rank || !b.pieces(b.Turn.Opponent(), PAWN).IsBitSet(pawn) ||
+			b.Occupied.IsBitSet(b.EnPassant) || b.Occupied.IsBitSet(from) {
+			return fmt.Errorf("en passant square %s doesn't follow a double push", BitToAlgebraic(b.EnPassant))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package chess
+
+import "testing"
+
+func TestValidate(t *testing.T) {
+	for _, fen := range []string{
+		StartFEN,
+		"rnbqkbnr/ppp1pppp/8/3pP3/8/8/PPPP1PPP/RNBQKBNR w KQkq d6 0 3",
+		"4k3/8/8/8/8/8/8/4K2R w K - 0 1",
+		"QQQQk3/8/8/8/8/8/4PPPP/4K3 b - - 0 1",
+		"bqnb1rkr/pppppppp/8/8/8/8/PPPPPPPP/BQNB1RKR w HFhf - 0 1",
+	} {
+		board, _ := ParseFEN(fen)
+		if err := board.Validate(); err != nil {
+			t.Errorf("unexpected error validating %s: %s", fen, err)
+		}
+	}
+
+	for fen, reason := range map[string]string{
+		"8/8/8/8/8/8/8/4K3 w - - 0 1":                                 "black has 0 kings",
+		"4k3/8/8/8/8/8/8/3KK3 w - - 0 1":                              "white has 2 kings",
+		"4k3/8/8/8/8/PPPP4/PPPPPPPP/4K3 w - - 0 1":                    "white has 12 pawns",
+		"4k3/8/8/8/8/8/PPPPPPPP/QQ2K3 w - - 0 1":                      "white has more promoted pieces than missing pawns",
+		"4k3/8/8/8/8/8/8/4K2r w - - 0 1":                              "",
+		"4k3/4R3/8/8/8/8/8/4K3 w - - 0 1":                             "black is in check but it's white's move",
+		"4k3/8/8/8/8/8/8/4K3 w K - 0 1":                               "castling right K without a king and rook on their squares",
+		"4k3/8/8/8/8/8/8/R3K3 w K - 0 1":                              "castling right K without a king and rook on their squares",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq e3 0 1":   "en passant square e3 doesn't follow a double push",
+		"rnbqkbnr/ppp1pppp/8/3p4/8/8/PPPPPPPP/RNBQKBNR w KQkq d6 0 1": "",
+		"rnbqkbnr/pppp1ppp/8/8/4p3/8/PPPPPPPP/RNBQKBNR w KQkq e6 0 1": "en passant square e6 doesn't follow a double push",
+	} {
+		board, err := ParseFEN(fen)
+		if err != nil {
+			t.Errorf("unexpected error parsing %s: %s", fen, err)
+			continue
+		}
+		err = board.Validate()
+		if reason == "" && err != nil {
+			t.Errorf("unexpected error validating %s: %s", fen, err)
+		} else if reason != "" && (err == nil || err.Error() != reason) {
+			t.Errorf("expected %q validating %s, actual: %v", reason, fen, err)
+		}
+	}
+
+	// ParseFEN rejects pawns on the back ranks, so place one by hand
+	board, _ := ParseFEN("4k3/8/8/8/8/8/8/4K3 w - - 0 1")
+	board.PlacePieceAlgebraic(PieceIndex(WHITE, PAWN), "a8")
+	if err := board.Validate(); err == nil || err.Error() != "pawns on the first or last rank" {
+		t.Errorf("expected a pawn on a8 to be rejected, actual: %v", err)
+	}
+}
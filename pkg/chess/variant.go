@@ -0,0 +1,175 @@
+package chess
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// Variant customises the rules of chess. A board with no variant plays standard chess;
+// the hooks are called by the board as it generates, checks and makes moves.
+type Variant interface {
+	// Name returns the name of the variant as recorded in the PGN Variant tag
+	Name() string
+	// StartFEN returns the starting position of the variant
+	StartFEN() string
+	// GenerateMoves adjusts the moves generated by the standard rules for the side to
+	// move, returning none once the game has been decided by the variant's rules
+	GenerateMoves(b *Board, moves []Move) []Move
+	// IsLegal checks whether a move returned by GenerateMoves may be played
+	IsLegal(b *Board, m Move) bool
+	// InCheck checks whether the side to move is in check
+	InCheck(b *Board) bool
+	// AfterMove applies any side effects of a move once the pieces have moved, before
+	// the turn passes to the opponent. Pieces must be removed with Board.Destroy so the
+	// move can be taken back.
+	AfterMove(b *Board, m Move)
+	// Outcome returns the result of the game in PGN notation, reporting false if the
+	// game isn't over yet
+	Outcome(b *Board) (string, bool)
+}
+
+// Standard is the variant for the standard rules of chess, which the other variants
+// build on. Boards with no variant follow the same rules.
+var Standard Variant = standard{}
+
+// Variants lists every variant supported by the library
+var Variants = []Variant{Standard, Crazyhouse, ThreeCheck, KingOfTheHill, Atomic, Antichess, Horde, RacingKings}
+
+// VariantByName returns the variant with the given name, ignoring case, spaces and
+// hyphens so both "King of the Hill" and "kingofthehill" are recognised
+func VariantByName(name string) (Variant, error) {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(s))
+	}
+	key := normalize(name)
+	for _, v := range Variants {
+		if normalize(v.Name()) == key {
+			return v, nil
+		}
+	}
+	if alias, ok := variantAliases[key]; ok {
+		return alias, nil
+	}
+	return nil, fmt.Errorf("unknown variant %q", name)
+}
+
+// variantAliases are other names variants are commonly known by
+var variantAliases = map[string]Variant{
+	"":           Standard,
+	"chess":      Standard,
+	"normal":     Standard,
+	"3check":     ThreeCheck,
+	"koth":       KingOfTheHill,
+	"giveaway":   Antichess,
+	"suicide":    Antichess,
+	"zh":         Crazyhouse,
+	"racingking": RacingKings,
+}
+
+// VariantName returns the name of the variant the board is playing
+func (b *Board) VariantName() string {
+	if b.Variant == nil {
+		return Standard.Name()
+	}
+	return b.Variant.Name()
+}
+
+// Result returns the result of the game in PGN notation under the board's rules, i.e.
+// "1-0", "0-1", "1/2-1/2" or "*" if the game isn't over yet. Draws that have to be
+// claimed, such as by threefold repetition, aren't included.
+func (b *Board) Result() string {
+	v := b.Variant
+	if v == nil {
+		v = Standard
+	}
+	result, _ := v.Outcome(b)
+	return result
+}
+
+// destroyed records a piece removed by a variant's side effect so it can be put back
+type destroyed struct {
+	piece, square int
+}
+
+// Destroy removes the piece on a square as a side effect of the move being made, e.g. in
+// an atomic explosion, giving up any castling rights it had. Unlike RemovePiece, the
+// piece is put back when the move is taken back.
+func (b *Board) Destroy(sq int) {
+	piece := b.pieceAt(sq)
+	if piece == NoPiece {
+		return
+	}
+	b.destroyed = append(b.destroyed, destroyed{piece, sq})
+	b.RemovePiece(piece, sq)
+	b.promoted.ClearBit(sq)
+	b.Castling &^= b.castlingLost(Move{From: sq, To: sq, Piece: piece})
+}
+
+// kingSquare returns the square of the color's king, or NoSquare if it has none
+func (b *Board) kingSquare(c Color) int {
+	king := b.pieces(c, KING)
+	if king == 0 {
+		return NoSquare
+	}
+	return bits.TrailingZeros64(uint64(king))
+}
+
+// winner returns the PGN result for a win by the given color
+func winner(c Color) string {
+	if c == WHITE {
+		return WhiteWins
+	}
+	return BlackWins
+}
+
+//-----------------------------------------------------------------------------
+// Standard rules
+//-----------------------------------------------------------------------------
+
+type standard struct{}
+
+func (standard) Name() string {
+	return "Standard"
+}
+
+func (standard) StartFEN() string {
+	return StartFEN
+}
+
+func (standard) GenerateMoves(b *Board, moves []Move) []Move {
+	return moves
+}
+
+func (standard) IsLegal(b *Board, m Move) bool {
+	return b.leavesKingSafe(m)
+}
+
+func (standard) InCheck(b *Board) bool {
+	return b.kingAttacked()
+}
+
+func (standard) AfterMove(b *Board, m Move) {}
+
+// Outcome ends the game by checkmate, stalemate or insufficient material
+func (standard) Outcome(b *Board) (string, bool) {
+	if result, over := mateOutcome(b); over {
+		return result, true
+	}
+	if b.IsInsufficientMaterial() {
+		return Draw, true
+	}
+	return InProgress, false
+}
+
+// mateOutcome ends the game when the side to move has no legal moves: a loss if it's in
+// check and a draw otherwise
+func mateOutcome(b *Board) (string, bool) {
+	if b.HasLegalMoves() {
+		return InProgress, false
+	}
+	if b.InCheck() {
+		return winner(b.Turn.Opponent()), true
+	}
+	return Draw, true
+}
@@ -0,0 +1,412 @@
+package chess
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Crazyhouse is standard chess where captured pieces go to the capturer's pocket and
+// may be dropped back on the board instead of moving
+var Crazyhouse Variant = crazyhouse{}
+
+// ThreeCheck is standard chess that is also won by giving check three times
+var ThreeCheck Variant = threeCheck{}
+
+// KingOfTheHill is standard chess that is also won by bringing the king to one of the
+// four central squares
+var KingOfTheHill Variant = kingOfTheHill{}
+
+// Atomic is chess where every capture explodes, removing the capturing piece and every
+// piece other than a pawn next to the square. Exploding the opponent's king wins.
+var Atomic Variant = atomic{}
+
+// Antichess is chess where the aim is to lose every piece. Captures are compulsory, the
+// king is an ordinary piece that pawns may promote to and there is no check.
+var Antichess Variant = antichess{}
+
+// Horde is chess where white has 36 pawns and no king, and wins by checkmating black.
+// Black wins by capturing every white piece.
+var Horde Variant = horde{}
+
+// RacingKings is chess without pawns where both kings race to the eighth rank and no
+// move may give check. If white gets there first, black draws by arriving next move.
+var RacingKings Variant = racingKings{}
+
+//-----------------------------------------------------------------------------
+// Crazyhouse
+//-----------------------------------------------------------------------------
+
+type crazyhouse struct{ standard }
+
+func (crazyhouse) Name() string {
+	return "Crazyhouse"
+}
+
+func (crazyhouse) StartFEN() string {
+	return "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR[] w KQkq - 0 1"
+}
+
+// Outcome ignores insufficient material since pieces can always be dropped
+func (crazyhouse) Outcome(b *Board) (string, bool) {
+	return mateOutcome(b)
+}
+
+//-----------------------------------------------------------------------------
+// Three-check
+//-----------------------------------------------------------------------------
+
+type threeCheck struct{ standard }
+
+func (threeCheck) Name() string {
+	return "Three-check"
+}
+
+func (threeCheck) StartFEN() string {
+	return "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3+3 0 1"
+}
+
+func (threeCheck) GenerateMoves(b *Board, moves []Move) []Move {
+	if b.Checks[WHITE] >= 3 || b.Checks[BLACK] >= 3 {
+		return moves[:0]
+	}
+	return moves
+}
+
+func (threeCheck) AfterMove(b *Board, m Move) {
+	if king := b.kingSquare(b.Turn.Opponent()); king != NoSquare && b.IsAttacked(king, b.Turn) {
+		b.hash ^= checksKey(b.Turn, b.Checks[b.Turn])
+		b.Checks[b.Turn]++
+		b.hash ^= checksKey(b.Turn, b.Checks[b.Turn])
+	}
+}
+
+// Outcome only counts bare kings as insufficient material since any piece can check
+func (threeCheck) Outcome(b *Board) (string, bool) {
+	for _, c := range []Color{WHITE, BLACK} {
+		if b.Checks[c] >= 3 {
+			return winner(c), true
+		}
+	}
+	if result, over := mateOutcome(b); over {
+		return result, true
+	}
+	if b.Occupied.Population() == 2 {
+		return Draw, true
+	}
+	return InProgress, false
+}
+
+//-----------------------------------------------------------------------------
+// King of the Hill
+//-----------------------------------------------------------------------------
+
+type kingOfTheHill struct{ standard }
+
+// hill is the four central squares, d4, e4, d5 and e5
+const hill = Bitboard(0x0000001818000000)
+
+func (kingOfTheHill) Name() string {
+	return "King of the Hill"
+}
+
+func (kingOfTheHill) GenerateMoves(b *Board, moves []Move) []Move {
+	if (b.pieces(WHITE, KING)|b.pieces(BLACK, KING))&hill != 0 {
+		return moves[:0]
+	}
+	return moves
+}
+
+// Outcome ignores insufficient material since a bare king can still reach the hill
+func (kingOfTheHill) Outcome(b *Board) (string, bool) {
+	for _, c := range []Color{WHITE, BLACK} {
+		if b.pieces(c, KING)&hill != 0 {
+			return winner(c), true
+		}
+	}
+	return mateOutcome(b)
+}
+
+//-----------------------------------------------------------------------------
+// Atomic
+//-----------------------------------------------------------------------------
+
+type atomic struct{ standard }
+
+func (atomic) Name() string {
+	return "Atomic"
+}
+
+// GenerateMoves removes king captures, since the king would explode with its victim
+func (atomic) GenerateMoves(b *Board, moves []Move) []Move {
+	if b.pieces(WHITE, KING) == 0 || b.pieces(BLACK, KING) == 0 {
+		return moves[:0]
+	}
+	filtered := moves[:0]
+	for _, m := range moves {
+		if !m.IsCapture() || b.Pieces[m.Piece].Symbol != KING {
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered
+}
+
+// IsLegal allows any move that keeps the mover's king on the board and out of check,
+// including moves that explode the opponent's king while in check
+func (atomic) IsLegal(b *Board, m Move) bool {
+	us := b.Turn
+	b.MakeMove(m)
+	defer b.UnmakeMove()
+	king := b.kingSquare(us)
+	if king == NoSquare {
+		return false
+	}
+	b.Turn = us
+	defer func() { b.Turn = us.Opponent() }()
+	return !atomicCheck(b)
+}
+
+// InCheck ignores attacks when the kings are touching, as capturing the king would
+// explode the capturer's own king
+func (atomic) InCheck(b *Board) bool {
+	return atomicCheck(b)
+}
+
+// AfterMove explodes captures
+func (atomic) AfterMove(b *Board, m Move) {
+	if !m.IsCapture() {
+		return
+	}
+	for around := kingAttacks[m.To]; around != 0; {
+		sq := around.popLowestBit()
+		if piece := b.pieceAt(sq); piece != NoPiece && b.Pieces[piece].Symbol != PAWN {
+			b.Destroy(sq)
+		}
+	}
+	b.Destroy(m.To)
+}
+
+// Outcome ends the game when a king has exploded. Bare kings can't capture each other,
+// so are a draw.
+func (atomic) Outcome(b *Board) (string, bool) {
+	for _, c := range []Color{WHITE, BLACK} {
+		if b.pieces(c, KING) == 0 {
+			return winner(c.Opponent()), true
+		}
+	}
+	if result, over := mateOutcome(b); over {
+		return result, true
+	}
+	if b.Occupied.Population() == 2 {
+		return Draw, true
+	}
+	return InProgress, false
+}
+
+// atomicCheck checks whether the king of the side to move is attacked while both kings
+// are on the board and not next to each other
+func atomicCheck(b *Board) bool {
+	king, theirs := b.kingSquare(b.Turn), b.kingSquare(b.Turn.Opponent())
+	if king == NoSquare || theirs == NoSquare || kingAttacks[king].IsBitSet(theirs) {
+		return false
+	}
+	return b.IsAttacked(king, b.Turn.Opponent())
+}
+
+//-----------------------------------------------------------------------------
+// Antichess
+//-----------------------------------------------------------------------------
+
+type antichess struct{ standard }
+
+func (antichess) Name() string {
+	return "Antichess"
+}
+
+func (antichess) StartFEN() string {
+	return "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w - - 0 1"
+}
+
+// GenerateMoves removes castling, adds promotions to a king and, when there is a capture,
+// keeps only the captures
+func (antichess) GenerateMoves(b *Board, moves []Move) []Move {
+	filtered, captures := moves[:0], false
+	for _, m := range moves {
+		if m.IsCastle() {
+			continue
+		}
+		filtered = append(filtered, m)
+		captures = captures || m.IsCapture()
+	}
+	for _, m := range filtered {
+		if m.Promotion == QUEEN {
+			m.Promotion = KING
+			filtered = append(filtered, m)
+		}
+	}
+	if !captures {
+		return filtered
+	}
+	moves = filtered[:0]
+	for _, m := range filtered {
+		if m.IsCapture() {
+			moves = append(moves, m)
+		}
+	}
+	return moves
+}
+
+func (antichess) IsLegal(b *Board, m Move) bool {
+	return true
+}
+
+func (antichess) InCheck(b *Board) bool {
+	return false
+}
+
+// Outcome gives the win to a player who has run out of pieces or moves
+func (antichess) Outcome(b *Board) (string, bool) {
+	if b.HasLegalMoves() {
+		return InProgress, false
+	}
+	return winner(b.Turn), true
+}
+
+//-----------------------------------------------------------------------------
+// Horde
+//-----------------------------------------------------------------------------
+
+type horde struct{ standard }
+
+func (horde) Name() string {
+	return "Horde"
+}
+
+func (horde) StartFEN() string {
+	return "rnbqkbnr/pppppppp/8/1PP2PP1/PPPPPPPP/PPPPPPPP/PPPPPPPP/PPPPPPPP w kq - 0 1"
+}
+
+// GenerateMoves lets white pawns on the first rank move two squares. Unlike a double
+// push from the second rank the pawn can't be captured en passant.
+func (horde) GenerateMoves(b *Board, moves []Move) []Move {
+	if b.Turn != WHITE {
+		return moves
+	}
+	pawn := PieceIndex(WHITE, PAWN)
+	for pawns := b.Positions[pawn] & 0xff; pawns != 0; {
+		from := pawns.popLowestBit()
+		if !b.Occupied.IsBitSet(from+8) && !b.Occupied.IsBitSet(from+16) {
+			moves = append(moves, Move{from, from + 16, pawn, NoPiece, PAWN, 0})
+		}
+	}
+	return moves
+}
+
+// Outcome gives black the win once white has no pieces left
+func (horde) Outcome(b *Board) (string, bool) {
+	if b.colorOccupied(WHITE) == 0 {
+		return BlackWins, true
+	}
+	return mateOutcome(b)
+}
+
+//-----------------------------------------------------------------------------
+// Racing Kings
+//-----------------------------------------------------------------------------
+
+type racingKings struct{ standard }
+
+// goal is the eighth rank the kings race to
+const goal = Bitboard(0xff00000000000000)
+
+func (racingKings) Name() string {
+	return "Racing Kings"
+}
+
+func (racingKings) StartFEN() string {
+	return "8/8/8/8/8/8/krbnNBRK/qrbnNBRQ w - - 0 1"
+}
+
+func (racingKings) GenerateMoves(b *Board, moves []Move) []Move {
+	if _, over := racingOutcome(b); over {
+		return moves[:0]
+	}
+	return moves
+}
+
+// IsLegal forbids moves that give check as well as those leaving the king in check
+func (racingKings) IsLegal(b *Board, m Move) bool {
+	if !b.leavesKingSafe(m) {
+		return false
+	}
+	b.MakeMove(m)
+	check := b.kingAttacked()
+	b.UnmakeMove()
+	return !check
+}
+
+func (racingKings) InCheck(b *Board) bool {
+	return false
+}
+
+func (racingKings) Outcome(b *Board) (string, bool) {
+	if result, over := racingOutcome(b); over {
+		return result, true
+	}
+	if !b.HasLegalMoves() {
+		return Draw, true
+	}
+	return InProgress, false
+}
+
+// racingOutcome decides the race once a king has reached the goal. Black gets one last
+// move to draw after white arrives.
+func racingOutcome(b *Board) (string, bool) {
+	white, black := b.pieces(WHITE, KING)&goal != 0, b.pieces(BLACK, KING)&goal != 0
+	switch {
+	case white && black:
+		return Draw, true
+	case black:
+		return BlackWins, true
+	case white && (b.Turn == WHITE || !canReachGoal(b)):
+		return WhiteWins, true
+	}
+	return InProgress, false
+}
+
+// canReachGoal checks whether the side to move can legally move its king to the goal
+func canReachGoal(b *Board) bool {
+	king := b.kingSquare(b.Turn)
+	if king == NoSquare {
+		return false
+	}
+	for targets := kingAttacks[king] & goal &^ b.colorOccupied(b.Turn); targets != 0; {
+		to := targets.popLowestBit()
+		if b.IsLegal(Move{king, to, PieceIndex(b.Turn, KING), b.pieceAt(to), PAWN, 0}) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseChecks sets the board up for Three-check from the checks each side has left to
+// give, e.g. "3+2", or has given so far, e.g. "+0+1"
+func (b *Board) parseChecks(field string) error {
+	given := strings.HasPrefix(field, "+")
+	counts := strings.Split(strings.TrimPrefix(field, "+"), "+")
+	if len(counts) != 2 {
+		return fmt.Errorf("bad check count %q", field)
+	}
+	for i, count := range counts {
+		n, err := strconv.Atoi(count)
+		if err != nil || n < 0 || n > 3 {
+			return fmt.Errorf("bad check count %q", field)
+		}
+		if !given {
+			n = 3 - n
+		}
+		b.Checks[i] = n
+	}
+	b.Variant = ThreeCheck
+	return nil
+}
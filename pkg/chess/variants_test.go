@@ -0,0 +1,256 @@
+package chess
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVariantPerft(t *testing.T) {
+	cases := map[Variant][]int64{
+		Standard:      {20, 400, 8902, 197281},
+		ThreeCheck:    {20, 400, 8902, 197281},
+		KingOfTheHill: {20, 400, 8902, 197281},
+		Atomic:        {20, 400, 8902, 197326},
+		Antichess:     {20, 400, 8067, 153299},
+		Horde:         {8, 128, 1274, 23310},
+		RacingKings:   {21, 421, 11264, 296242},
+	}
+	for v, counts := range cases {
+		board, err := NewBoardWith(WithVariant(v))
+		if err != nil {
+			t.Fatalf("unexpected error creating %s board: %s", v.Name(), err)
+		}
+		for i, expected := range counts {
+			if actual := board.Perft(i + 1); actual != expected {
+				t.Errorf("%s perft(%d) expected %d, actual: %d", v.Name(), i+1, expected, actual)
+			}
+		}
+	}
+}
+
+func TestVariantByName(t *testing.T) {
+	for name, expected := range map[string]Variant{
+		"Standard":         Standard,
+		"":                 Standard,
+		"king of the hill": KingOfTheHill,
+		"KingOfTheHill":    KingOfTheHill,
+		"three-check":      ThreeCheck,
+		"3check":           ThreeCheck,
+		"Racing Kings":     RacingKings,
+		"giveaway":         Antichess,
+		"ATOMIC":           Atomic,
+	} {
+		if v, err := VariantByName(name); err != nil || v != expected {
+			t.Errorf("expected %q to be %s, actual: %v (%v)", name, expected.Name(), v, err)
+		}
+	}
+	// Losing chess is a different game from Antichess, with check and a king that matters
+	for _, name := range []string{"shogi", "losers"} {
+		if _, err := VariantByName(name); err == nil {
+			t.Errorf("expected an error for unknown variant %q", name)
+		}
+	}
+}
+
+func TestNewBoardOptions(t *testing.T) {
+	standard, err := NewBoardWith(WithVariant(Standard), WithFEN(""))
+	if err != nil || standard.Variant != nil || standard.FEN() != StartFEN {
+		t.Errorf("expected a standard board with no variant, actual: %v (%v)", standard, err)
+	}
+	atomic, err := NewBoardWith(WithVariant(Atomic), WithFEN("4k3/8/8/8/8/8/8/4K3 w - - 0 1"))
+	if err != nil || atomic.Variant != Atomic || atomic.FEN() != "4k3/8/8/8/8/8/8/4K3 w - - 0 1" {
+		t.Errorf("expected an Atomic board from the FEN, actual: %v (%v)", atomic, err)
+	}
+	if crazyhouse, _ := NewBoardWith(WithVariant(Crazyhouse)); crazyhouse.VariantName() != "Crazyhouse" || !strings.Contains(crazyhouse.FEN(), "[]") {
+		t.Errorf("expected a Crazyhouse board")
+	}
+	if crazyhouse, _ := ParseFEN(Crazyhouse.StartFEN()); crazyhouse.Variant != Crazyhouse {
+		t.Errorf("expected pockets to make a Crazyhouse board, actual: %s", crazyhouse.VariantName())
+	}
+	if _, err := NewBoardWith(WithVariant(Atomic), WithFEN(Crazyhouse.StartFEN())); err == nil {
+		t.Errorf("expected an error for pockets in Atomic")
+	}
+	if _, err := NewBoardWith(WithFEN(StartFEN), WithPositions(make([]Bitboard, len(Pieces))...)); err == nil {
+		t.Errorf("expected an error setting a board up from both a FEN and bitboards")
+	}
+
+	game, err := NewGame(WithVariant(Standard), WithFEN(""))
+	if err != nil || len(game.Tags) != 0 {
+		t.Errorf("expected an untagged standard game, actual: %v (%v)", game, err)
+	}
+}
+
+func TestVariantOutcomes(t *testing.T) {
+	cases := []struct {
+		Variant Variant
+		FEN     string
+		Move    string
+		Result  string
+	}{
+		// Three-check is won by the third check
+		{ThreeCheck, "4k3/8/8/8/8/8/8/R3K3 w - - 1+3 0 1", "Ra8+", WhiteWins},
+		{ThreeCheck, "4k3/8/8/8/8/8/8/R3K3 w - - 2+3 0 1", "Ra8+", InProgress},
+		// The king reaching the centre wins King of the Hill
+		{KingOfTheHill, "8/8/8/8/8/4K3/8/k7 w - - 0 1", "Ke4", WhiteWins},
+		{KingOfTheHill, "8/8/8/8/8/4K3/8/k7 w - - 0 1", "Kf4", InProgress},
+		// Exploding the king wins Atomic
+		{Atomic, "4k3/3p4/8/1B6/8/8/8/4K3 w - - 0 1", "Bxd7", WhiteWins},
+		{Atomic, "4k3/8/8/8/8/8/4p3/4K3 w - - 0 1", "Kxe2", ""},
+		// Losing every piece wins Antichess
+		{Antichess, "8/8/8/8/8/8/p7/R6r w - - 0 1", "Rb1", ""},
+		{Antichess, "8/8/8/8/8/8/8/1n5R w - - 0 1", "Rxb1", BlackWins},
+		// Black wins Horde by capturing every white piece
+		{Horde, "4k3/8/8/8/8/8/3q4/4P3 b - - 0 1", "Qxe1", BlackWins},
+		// Black can draw Racing Kings by reaching the eighth rank right after white
+		{RacingKings, "8/6K1/k7/8/8/8/8/8 w - - 0 1", "Kg8", WhiteWins},
+		{RacingKings, "8/k5K1/8/8/8/8/8/8 w - - 0 1", "Kg8", InProgress},
+		{RacingKings, "8/8/8/8/8/8/k7/7K w - - 0 1", "Kh2", InProgress},
+		{RacingKings, "8/8/8/8/8/8/k7/6RK w - - 0 1", "Rg2", ""},
+	}
+	for _, c := range cases {
+		board, err := NewBoardWith(WithVariant(c.Variant), WithFEN(c.FEN))
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", c.FEN, err)
+		}
+		m, err := board.ParseMove(c.Move)
+		if c.Result == "" {
+			if err == nil {
+				t.Errorf("%s should be illegal in %s %s", c.Move, c.Variant.Name(), c.FEN)
+			}
+			continue
+		} else if err != nil {
+			t.Errorf("unexpected error parsing %s in %s %s: %s", c.Move, c.Variant.Name(), c.FEN, err)
+			continue
+		}
+		board.MakeMove(m)
+		if result := board.Result(); result != c.Result {
+			t.Errorf("expected %s after %s in %s %s, actual: %s", c.Result, c.Move, c.Variant.Name(), c.FEN, result)
+		}
+		if c.Result != InProgress && board.HasLegalMoves() {
+			t.Errorf("expected no legal moves once %s %s is decided", c.Variant.Name(), c.FEN)
+		}
+		board.UnmakeMove()
+		if board.FEN() != c.FEN || board.Hash() != board.computeHash() {
+			t.Errorf("expected %s after taking back %s, actual: %s", c.FEN, c.Move, board.FEN())
+		}
+	}
+}
+
+func TestAtomicExplosion(t *testing.T) {
+	board, _ := NewBoardWith(WithVariant(Atomic), WithFEN("r3k3/1p6/2n5/3p4/4B3/8/8/4K3 w - - 0 1"))
+	m, err := board.ParseMove("Bxd5")
+	if err != nil {
+		t.Fatalf("unexpected error parsing Bxd5: %s", err)
+	}
+	// The bishop and knight explode but the pawn next to d5 survives
+	board.MakeMove(m)
+	if expected := "r3k3/1p6/8/8/8/8/8/4K3 b - - 0 1"; board.FEN() != expected {
+		t.Errorf("expected %s, actual: %s", expected, board.FEN())
+	}
+	if board.Hash() != board.computeHash() {
+		t.Errorf("incremental hash doesn't match after an explosion")
+	}
+
+	// Kings next to each other can't be in check
+	board, _ = NewBoardWith(WithVariant(Atomic), WithFEN("8/8/8/8/8/3k4/3K4/3R4 b - - 0 1"))
+	if board.InCheck() {
+		t.Errorf("kings next to each other shouldn't be in check")
+	}
+}
+
+func TestAntichessPromotion(t *testing.T) {
+	board, _ := NewBoardWith(WithVariant(Antichess), WithFEN("8/P7/8/8/8/8/8/7k w - - 0 1"))
+	if moves := board.LegalMoves(); len(moves) != 5 {
+		t.Errorf("expected 5 promotions including to a king, actual: %v", moves)
+	}
+	for _, move := range []string{"a8=K", "a7a8k"} {
+		if m, err := board.ParseMove(move); err != nil || m.Promotion != KING {
+			t.Errorf("expected %s to promote to a king, actual: %s (%v)", move, m, err)
+		}
+	}
+}
+
+func TestThreeCheckFEN(t *testing.T) {
+	for fen, expected := range map[string]string{
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3+2 0 1":  "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3+2 0 1",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1 +2+0": "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 1+3 0 1",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 1+1":      "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 1+1 0 1",
+	} {
+		board, err := ParseFEN(fen)
+		if err != nil {
+			t.Errorf("unexpected error parsing %s: %s", fen, err)
+		} else if board.Variant != ThreeCheck || board.FEN() != expected {
+			t.Errorf("expected %s, actual: %s", expected, board.FEN())
+		}
+	}
+	if _, err := ParseFEN("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 4+3 0 1"); err == nil {
+		t.Errorf("expected an error for more than three checks")
+	}
+	// The checks given are part of the position's key, and kept up to date as checks
+	// are given and taken back
+	fresh, _ := ParseFEN("4k3/8/8/8/8/8/8/R3K3 w - - 3+3 0 1")
+	checked, _ := ParseFEN("4k3/8/8/8/8/8/8/R3K3 w - - 2+3 0 1")
+	if fresh.Hash() == checked.Hash() {
+		t.Errorf("expected different keys for different numbers of checks")
+	}
+	hash := fresh.Hash()
+	if err := fresh.Play("Ra8+"); err != nil {
+		t.Fatalf("unexpected error playing Ra8+: %s", err)
+	}
+	if fresh.Hash() != fresh.computeHash() {
+		t.Errorf("incremental hash doesn't match after a check")
+	}
+	fresh.UnmakeMove()
+	if fresh.Hash() != hash {
+		t.Errorf("expected the key to be restored after taking back a check")
+	}
+}
+
+func TestVariantPGN(t *testing.T) {
+	game, err := NewGame(WithVariant(Atomic))
+	if err != nil {
+		t.Fatalf("unexpected error creating game: %s", err)
+	}
+	for _, move := range []string{"e4", "d5", "exd5"} {
+		if _, err := game.Play(move); err != nil {
+			t.Fatalf("unexpected error playing %s: %s", move, err)
+		}
+	}
+	pgn := game.String()
+	if !strings.Contains(pgn, `[Variant "Atomic"]`) || strings.Contains(pgn, "[FEN") {
+		t.Errorf("expected an Atomic game from the start position, actual: %s", pgn)
+	}
+
+	games, err := ParsePGN(pgn)
+	if err != nil || len(games) != 1 {
+		t.Fatalf("unexpected error parsing %s: %v", pgn, err)
+	}
+	board, err := games[0].Board()
+	if err != nil {
+		t.Fatalf("unexpected error replaying game: %s", err)
+	}
+	if expected := "rnbqkbnr/ppp1pppp/8/8/8/8/PPPP1PPP/RNBQKBNR b KQkq - 0 2"; board.VariantName() != "Atomic" || board.FEN() != expected {
+		t.Errorf("expected %s in an Atomic game, actual: %s %s", expected, board.VariantName(), board.FEN())
+	}
+
+	// A variant's own starting position is given by the Variant tag alone
+	game, _ = NewGame(WithVariant(RacingKings))
+	if _, ok := game.Tags["FEN"]; ok || game.Tags["Variant"] != "Racing Kings" {
+		t.Errorf("expected only the Racing Kings variant to be tagged, actual: %v", game.Tags)
+	}
+	if board, err := game.StartingPosition(); err != nil || board.FEN() != RacingKings.StartFEN() {
+		t.Errorf("expected the Racing Kings starting position, actual: %v (%v)", board, err)
+	}
+	game, _ = NewGame(WithFEN(Crazyhouse.StartFEN()))
+	if _, ok := game.Tags["FEN"]; ok || game.Tags["Variant"] != "Crazyhouse" {
+		t.Errorf("expected only the Crazyhouse variant to be tagged, actual: %v", game.Tags)
+	}
+	game, _ = NewGame(WithVariant(RacingKings), WithFEN("8/8/8/8/8/8/krbn1BRK/qrbnNBRQ w - - 0 1"))
+	if game.Tags["FEN"] != "8/8/8/8/8/8/krbn1BRK/qrbnNBRQ w - - 0 1" || game.Tags["SetUp"] != "1" {
+		t.Errorf("expected a Racing Kings position to be tagged, actual: %v", game.Tags)
+	}
+	game, _ = NewGame(WithFEN("rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3+3 0 1"))
+	if game.Tags["Variant"] != "Three-check" {
+		t.Errorf("expected a three-check FEN to be tagged, actual: %v", game.Tags)
+	}
+}
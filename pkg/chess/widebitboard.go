@@ -0,0 +1,266 @@
+package chess
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// MaxSquares is the number of squares a WideBitboard can hold, enough for a 16x16 board
+const MaxSquares = 256
+
+const words = MaxSquares / 64
+
+// WideBitboard is a multi-word bitboard for boards larger than 8x8. Like a Bitboard, bit
+// 0 is a1 and the squares are numbered along each rank, so the bit for a square depends
+// on the Dimensions of the board: on a 10x8 board b2 is bit 11.
+//
+// WideBoard plays on WideBitboards, while Board and the packages built on it stay 8x8.
+type WideBitboard [words]uint64
+
+// SetBit sets to 1 the bit at the requested index
+func (w *WideBitboard) SetBit(index int) {
+	w[index/64] |= 1 << uint(index%64)
+}
+
+// ClearBit set to 0 the bit at the requested index
+func (w *WideBitboard) ClearBit(index int) {
+	w[index/64] &^= 1 << uint(index%64)
+}
+
+// ToggleBit switches the bit at the requested index (1 -> 0 -> 1, etc)
+func (w *WideBitboard) ToggleBit(index int) {
+	w[index/64] ^= 1 << uint(index%64)
+}
+
+// GetBit returns the value of the bit at the requested index
+func (w WideBitboard) GetBit(index int) int {
+	return int(w[index/64] >> uint(index%64) & 1)
+}
+
+// IsBitSet checks if a bit is set at the requested index
+func (w WideBitboard) IsBitSet(index int) bool {
+	return w.GetBit(index) == 1
+}
+
+// IsEmpty checks if no bits are set
+func (w WideBitboard) IsEmpty() bool {
+	return w == WideBitboard{}
+}
+
+// Population returns the number of bits set
+func (w WideBitboard) Population() int {
+	count := 0
+	for _, word := range w {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// And returns the intersection of two bitboards
+func (w WideBitboard) And(other WideBitboard) WideBitboard {
+	for i := range w {
+		w[i] &= other[i]
+	}
+	return w
+}
+
+// Or returns the union of two bitboards
+func (w WideBitboard) Or(other WideBitboard) WideBitboard {
+	for i := range w {
+		w[i] |= other[i]
+	}
+	return w
+}
+
+// Xor returns the bits set in exactly one of two bitboards
+func (w WideBitboard) Xor(other WideBitboard) WideBitboard {
+	for i := range w {
+		w[i] ^= other[i]
+	}
+	return w
+}
+
+// AndNot returns the bits that are not set in the other bitboard
+func (w WideBitboard) AndNot(other WideBitboard) WideBitboard {
+	for i := range w {
+		w[i] &^= other[i]
+	}
+	return w
+}
+
+// ShiftLeft returns the bitboard with every bit moved n places towards the last square
+func (w WideBitboard) ShiftLeft(n int) WideBitboard {
+	var shifted WideBitboard
+	word, bit := n/64, uint(n%64)
+	for i := words - 1; i >= word; i-- {
+		shifted[i] = w[i-word] << bit
+		if bit > 0 && i-word > 0 {
+			shifted[i] |= w[i-word-1] >> (64 - bit)
+		}
+	}
+	return shifted
+}
+
+// ShiftRight returns the bitboard with every bit moved n places towards a1
+func (w WideBitboard) ShiftRight(n int) WideBitboard {
+	var shifted WideBitboard
+	word, bit := n/64, uint(n%64)
+	for i := 0; i+word < words; i++ {
+		shifted[i] = w[i+word] >> bit
+		if bit > 0 && i+word+1 < words {
+			shifted[i] |= w[i+word+1] << (64 - bit)
+		}
+	}
+	return shifted
+}
+
+// LowestBit returns the index of the least significant set bit, or NoSquare if no bits
+// are set
+func (w WideBitboard) LowestBit() int {
+	for i, word := range w {
+		if word != 0 {
+			return i*64 + bits.TrailingZeros64(word)
+		}
+	}
+	return NoSquare
+}
+
+// PopLowestBit clears the least significant set bit and returns its index, or NoSquare
+// if no bits are set
+func (w *WideBitboard) PopLowestBit() int {
+	index := w.LowestBit()
+	if index != NoSquare {
+		w.ClearBit(index)
+	}
+	return index
+}
+
+// Indices returns the indices of every bit set in ascending order
+func (w WideBitboard) Indices() []int {
+	indices := make([]int, 0, w.Population())
+	for index := w.PopLowestBit(); index != NoSquare; index = w.PopLowestBit() {
+		indices = append(indices, index)
+	}
+	return indices
+}
+
+//-----------------------------------------------------------------------------
+// Board geometry
+//-----------------------------------------------------------------------------
+
+// Full returns a bitboard with every square of the board set
+func (d Dimensions) Full() WideBitboard {
+	var w WideBitboard
+	for sq := 0; sq < d.Squares(); sq++ {
+		w.SetBit(sq)
+	}
+	return w
+}
+
+// FileMask returns a bitboard with every square on a file set, counting from 0 for "a"
+func (d Dimensions) FileMask(x int) WideBitboard {
+	var w WideBitboard
+	for y := 0; y < d.Ranks; y++ {
+		w.SetBit(d.CartesianToBit(x, y))
+	}
+	return w
+}
+
+// RankMask returns a bitboard with every square on a rank set, counting from 0 for the
+// first rank
+func (d Dimensions) RankMask(y int) WideBitboard {
+	var w WideBitboard
+	for x := 0; x < d.Files; x++ {
+		w.SetBit(d.CartesianToBit(x, y))
+	}
+	return w
+}
+
+// Offset returns the square dx files and dy ranks away from a square, or NoSquare if
+// that's off the board
+func (d Dimensions) Offset(sq, dx, dy int) int {
+	x, y := d.BitToCartesian(sq)
+	if !d.Contains(x+dx, y+dy) {
+		return NoSquare
+	}
+	return d.CartesianToBit(x+dx, y+dy)
+}
+
+// Shift returns the bitboard with every square moved dx files and dy ranks, dropping
+// those that move off the board rather than wrapping them onto the next rank
+func (d Dimensions) Shift(w WideBitboard, dx, dy int) WideBitboard {
+	if dx <= -d.Files || dx >= d.Files || dy <= -d.Ranks || dy >= d.Ranks {
+		return WideBitboard{}
+	}
+	// Clear the files that would wrap around before shifting
+	for x := 0; x < dx; x++ {
+		w = w.AndNot(d.FileMask(d.Files - 1 - x))
+	}
+	for x := 0; x < -dx; x++ {
+		w = w.AndNot(d.FileMask(x))
+	}
+	if n := dy*d.Files + dx; n > 0 {
+		w = w.ShiftLeft(n)
+	} else {
+		w = w.ShiftRight(-n)
+	}
+	return w.And(d.Full())
+}
+
+// Ray returns the squares a slider on a square attacks moving repeatedly dx files and dy
+// ranks, up to and including the first occupied square
+func (d Dimensions) Ray(sq, dx, dy int, occupied WideBitboard) WideBitboard {
+	var ray WideBitboard
+	for to := d.Offset(sq, dx, dy); to != NoSquare; to = d.Offset(to, dx, dy) {
+		ray.SetBit(to)
+		if occupied.IsBitSet(to) {
+			break
+		}
+	}
+	return ray
+}
+
+// Widen converts a Bitboard of the standard board to a bitboard of the board, keeping
+// each square's coordinates and dropping squares that aren't on the board
+func (d Dimensions) Widen(b Bitboard) WideBitboard {
+	var w WideBitboard
+	for b != 0 {
+		x, y := BitToCartesian(b.popLowestBit())
+		if d.Contains(x, y) {
+			w.SetBit(d.CartesianToBit(x, y))
+		}
+	}
+	return w
+}
+
+// Narrow converts a bitboard of the board to a Bitboard of the standard board, keeping
+// each square's coordinates and dropping squares outside the a1-h8 corner
+func (d Dimensions) Narrow(w WideBitboard) Bitboard {
+	var b Bitboard
+	for _, sq := range w.Indices() {
+		if x, y := d.BitToCartesian(sq); Standard8x8.Contains(x, y) {
+			b.SetBit(CartesianToBit(x, y))
+		}
+	}
+	return b
+}
+
+// Format displays the bitboard as a grid for the board, with "1" for set squares and
+// "." for the rest
+func (d Dimensions) Format(w WideBitboard) string {
+	var s strings.Builder
+	for y := d.Ranks - 1; y >= 0; y-- {
+		for x := 0; x < d.Files; x++ {
+			if x > 0 {
+				s.WriteByte(' ')
+			}
+			if w.IsBitSet(d.CartesianToBit(x, y)) {
+				s.WriteByte('1')
+			} else {
+				s.WriteByte('.')
+			}
+		}
+		s.WriteByte('\n')
+	}
+	return s.String()
+}
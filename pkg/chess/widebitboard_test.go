@@ -0,0 +1,69 @@
+package chess
+
+import "testing"
+
+func TestWideBitboard(t *testing.T) {
+	var w WideBitboard
+	for _, index := range []int{0, 63, 64, 130, 255} {
+		w.SetBit(index)
+	}
+	if w.Population() != 5 || !w.IsBitSet(64) || w.IsBitSet(65) {
+		t.Errorf("unexpected bits set: %v", w.Indices())
+	}
+	w.ToggleBit(64)
+	w.ClearBit(255)
+	if indices := w.Indices(); len(indices) != 3 || indices[0] != 0 || indices[1] != 63 || indices[2] != 130 {
+		t.Errorf("expected bits 0, 63 and 130, actual: %v", indices)
+	}
+
+	shifted := w.ShiftLeft(70)
+	if indices := shifted.Indices(); len(indices) != 3 || indices[0] != 70 || indices[1] != 133 || indices[2] != 200 {
+		t.Errorf("expected bits 70, 133 and 200, actual: %v", indices)
+	}
+	if shifted.ShiftRight(70) != w {
+		t.Errorf("shifting back should restore the bitboard, actual: %v", shifted.ShiftRight(70).Indices())
+	}
+	if !w.ShiftLeft(MaxSquares).IsEmpty() {
+		t.Errorf("shifting every bit off the board should leave it empty")
+	}
+	if w.PopLowestBit() != 0 || w.LowestBit() != 63 {
+		t.Errorf("expected bit 0 to be popped, leaving 63 as the lowest bit")
+	}
+}
+
+func TestWideBitboardGeometry(t *testing.T) {
+	d := Grand10x10
+	if full := d.Full(); full.Population() != 100 || full.IsBitSet(100) {
+		t.Errorf("expected 100 squares on a 10x10 board, actual: %d", full.Population())
+	}
+
+	var w WideBitboard
+	w.SetBit(d.CartesianToBit(9, 4)) // j5
+	w.SetBit(d.CartesianToBit(0, 9)) // a10
+	// Shifting right drops j5 rather than wrapping it onto the a-file
+	if shifted := d.Shift(w, 1, -1); shifted.Population() != 1 || !shifted.IsBitSet(d.CartesianToBit(1, 8)) {
+		t.Errorf("expected only b9 after shifting, actual:\n%s", d.Format(shifted))
+	}
+	if shifted := d.Shift(w, -1, 0); shifted.Population() != 1 || !shifted.IsBitSet(d.CartesianToBit(8, 4)) {
+		t.Errorf("expected only i5 after shifting, actual:\n%s", d.Format(shifted))
+	}
+	if shifted := d.Shift(w, -2, 3); shifted.Population() != 1 || !shifted.IsBitSet(d.CartesianToBit(7, 7)) {
+		t.Errorf("expected only h8 after shifting, actual:\n%s", d.Format(shifted))
+	}
+
+	// A rook on a1 of a 10x8 board blocked on the e-file
+	d = Capablanca10x8
+	var occupied WideBitboard
+	occupied.SetBit(d.CartesianToBit(4, 0))
+	east, north := d.Ray(0, 1, 0, occupied), d.Ray(0, 0, 1, occupied)
+	if east.Population() != 4 || !east.IsBitSet(4) || north.Population() != 7 || d.Offset(0, -1, 0) != NoSquare {
+		t.Errorf("unexpected rook attacks:\n%s", d.Format(east.Or(north)))
+	}
+	if d.Offset(0, 9, 7) != 79 || d.FileMask(9).Population() != 8 || d.RankMask(7).Population() != 10 {
+		t.Errorf("unexpected geometry for a 10x8 board")
+	}
+
+	if b := Bitboard(0x8100000000000081); d.Narrow(d.Widen(b)) != b || !d.Widen(b).IsBitSet(d.CartesianToBit(7, 7)) {
+		t.Errorf("expected the corners to survive widening, actual:\n%s", d.Format(d.Widen(b)))
+	}
+}
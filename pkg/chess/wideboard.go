@@ -0,0 +1,651 @@
+package chess
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//-----------------------------------------------------------------------------
+// Boards beyond 8x8
+//-----------------------------------------------------------------------------
+
+// CapablancaFEN is the starting position of Capablanca chess, which needs the
+// Archbishop and Chancellor to be registered with RegisterPiece
+const CapablancaFEN = "rnabqkbcnr/pppppppppp/10/10/10/10/PPPPPPPPPP/RNABQKBCNR w KQkq - 0 1"
+
+// WideBoard is a position on a board of any Dimensions up to MaxSquares, such as the
+// 10x8 board of Capablanca chess. Positions holds a WideBitboard for each piece in
+// Pieces, including the fairy pieces registered when the board was created.
+//
+// The rules are those of standard chess stretched to the board's size:
+//   - Pawns advance two squares from the second rank (the second last for black) and
+//     promote on the last rank to a queen, rook, bishop, knight or any fairy piece
+//   - Castling moves the king to the second file from the corner and the rook in the
+//     corner to the square on its inner side, e.g. O-O is f1-i1 with the rook to h1 on a
+//     10x8 board
+//
+// Variant rules such as Grand chess's promotion zone, SAN, Zobrist hashing and draws
+// by repetition or the fifty-move rule are only supported on the 8x8 Board.
+type WideBoard struct {
+	Dimensions
+	Positions      []WideBitboard
+	Occupied       WideBitboard
+	Turn           Color
+	Castling       CastlingRights
+	EnPassant      int
+	HalfMoveClock  int
+	FullMoveNumber int
+
+	history []wideUndo
+}
+
+// wideUndo stores the state of a WideBoard needed to take back a move
+type wideUndo struct {
+	move          Move
+	castling      CastlingRights
+	enPassant     int
+	halfMoveClock int
+}
+
+// NewWideBoard returns an empty board of the given dimensions with white to move
+func NewWideBoard(d Dimensions) (*WideBoard, error) {
+	if _, err := NewDimensions(d.Files, d.Ranks); err != nil {
+		return nil, err
+	}
+	return &WideBoard{
+		Dimensions:     d,
+		Positions:      make([]WideBitboard, len(Pieces)),
+		EnPassant:      NoSquare,
+		FullMoveNumber: 1,
+	}, nil
+}
+
+// ParseWideFEN returns a new board set up from a position in Forsyth-Edwards Notation,
+// taking its dimensions from the number of ranks and the squares in each, e.g. "10" for
+// ten empty squares
+func ParseWideFEN(fen string) (*WideBoard, error) {
+	fields := strings.Fields(fen)
+	if len(fields) != 4 && len(fields) != 6 {
+		return nil, fmt.Errorf("invalid FEN %q: expected 6 fields, found %d", fen, len(fields))
+	}
+
+	type placed struct{ piece, x int }
+	rows := strings.Split(fields[0], "/")
+	ranks := make([][]placed, len(rows))
+	files := 0
+	for i, row := range rows {
+		file := 0
+		for j := 0; j < len(row); j++ {
+			if row[j] >= '0' && row[j] <= '9' {
+				k := j
+				for k < len(row) && row[k] >= '0' && row[k] <= '9' {
+					k++
+				}
+				empty, _ := strconv.Atoi(row[j:k])
+				if empty == 0 {
+					return nil, fmt.Errorf("invalid FEN %q: bad number of empty squares %q", fen, row[j:k])
+				}
+				file, j = file+empty, k-1
+				continue
+			}
+			piece := pieceFromFEN(rune(row[j]))
+			if piece == NoPiece {
+				return nil, fmt.Errorf("invalid FEN %q: unknown piece %q", fen, row[j])
+			}
+			ranks[i] = append(ranks[i], placed{piece, file})
+			file++
+		}
+		if i == 0 {
+			files = file
+		} else if file != files {
+			return nil, fmt.Errorf("invalid FEN %q: rank %d does not have %d files", fen, len(rows)-i, files)
+		}
+	}
+	d, err := NewDimensions(files, len(rows))
+	if err != nil {
+		return nil, fmt.Errorf("invalid FEN %q: %s", fen, err)
+	}
+	board, _ := NewWideBoard(d)
+	for i, rank := range ranks {
+		for _, p := range rank {
+			board.PlacePiece(p.piece, d.CartesianToBit(p.x, d.Ranks-1-i))
+		}
+	}
+	last := d.RankMask(0).Or(d.RankMask(d.Ranks - 1))
+	if !board.Positions[WhitePawn.Index].Or(board.Positions[BlackPawn.Index]).And(last).IsEmpty() {
+		return nil, fmt.Errorf("invalid FEN %q: pawns on the first or last rank", fen)
+	}
+
+	switch fields[1] {
+	case "w":
+		board.Turn = WHITE
+	case "b":
+		board.Turn = BLACK
+	default:
+		return nil, fmt.Errorf("invalid FEN %q: unknown side to move %q", fen, fields[1])
+	}
+
+	if fields[2] != "-" {
+		for _, r := range fields[2] {
+			i := strings.IndexRune("KQkq", r)
+			if i < 0 {
+				return nil, fmt.Errorf("invalid FEN %q: bad castling rights %q", fen, fields[2])
+			}
+			board.Castling |= CastlingRights(1) << uint(i)
+		}
+		for _, right := range []CastlingRights{WhiteKingSide, WhiteQueenSide, BlackKingSide, BlackQueenSide} {
+			if board.Castling&right != 0 && !board.canCastle(right) {
+				return nil, fmt.Errorf("invalid FEN %q: castling right %s without a king and rook on the back rank", fen, right)
+			}
+		}
+	}
+
+	if fields[3] != "-" {
+		sq, err := d.ParseSquare(fields[3])
+		if _, y := d.BitToCartesian(sq); err != nil || (y != 2 && y != d.Ranks-3) {
+			return nil, fmt.Errorf("invalid FEN %q: bad en passant square %q", fen, fields[3])
+		}
+		board.EnPassant = sq
+	}
+
+	if len(fields) == 6 {
+		if board.HalfMoveClock, err = strconv.Atoi(fields[4]); err != nil || board.HalfMoveClock < 0 {
+			return nil, fmt.Errorf("invalid FEN %q: bad halfmove clock %q", fen, fields[4])
+		}
+		if board.FullMoveNumber, err = strconv.Atoi(fields[5]); err != nil || board.FullMoveNumber < 1 {
+			return nil, fmt.Errorf("invalid FEN %q: bad fullmove number %q", fen, fields[5])
+		}
+	}
+	return board, nil
+}
+
+// FEN returns the position in Forsyth-Edwards Notation
+func (b *WideBoard) FEN() string {
+	var s strings.Builder
+	for y := b.Ranks - 1; y >= 0; y-- {
+		empty := 0
+		for x := 0; x < b.Files; x++ {
+			piece := b.pieceAt(b.CartesianToBit(x, y))
+			if piece == NoPiece {
+				empty++
+				continue
+			}
+			if empty > 0 {
+				s.WriteString(strconv.Itoa(empty))
+				empty = 0
+			}
+			s.WriteString(Pieces[piece].FENSymbol())
+		}
+		if empty > 0 {
+			s.WriteString(strconv.Itoa(empty))
+		}
+		if y > 0 {
+			s.WriteByte('/')
+		}
+	}
+	turn := "w"
+	if b.Turn == BLACK {
+		turn = "b"
+	}
+	enPassant := "-"
+	if b.EnPassant != NoSquare {
+		enPassant = b.BitToAlgebraic(b.EnPassant)
+	}
+	fmt.Fprintf(&s, " %s %s %s %d %d", turn, b.Castling, enPassant, b.HalfMoveClock, b.FullMoveNumber)
+	return s.String()
+}
+
+// String displays the board as a grid of FEN letters with "." for empty squares
+func (b *WideBoard) String() string {
+	var s strings.Builder
+	for y := b.Ranks - 1; y >= 0; y-- {
+		for x := 0; x < b.Files; x++ {
+			if x > 0 {
+				s.WriteByte(' ')
+			}
+			if piece := b.pieceAt(b.CartesianToBit(x, y)); piece != NoPiece {
+				s.WriteString(Pieces[piece].FENSymbol())
+			} else {
+				s.WriteByte('.')
+			}
+		}
+		s.WriteByte('\n')
+	}
+	return s.String()
+}
+
+// PlacePiece puts a piece on a square
+func (b *WideBoard) PlacePiece(piece, sq int) {
+	b.Positions[piece].SetBit(sq)
+	b.Occupied.SetBit(sq)
+}
+
+// RemovePiece takes a piece off a square
+func (b *WideBoard) RemovePiece(piece, sq int) {
+	b.Positions[piece].ClearBit(sq)
+	b.Occupied.ClearBit(sq)
+}
+
+// pieceAt returns the index of the piece on a square, or NoPiece if it's empty
+func (b *WideBoard) pieceAt(sq int) int {
+	if !b.Occupied.IsBitSet(sq) {
+		return NoPiece
+	}
+	for piece := range b.Positions {
+		if b.Positions[piece].IsBitSet(sq) {
+			return piece
+		}
+	}
+	return NoPiece
+}
+
+// colorOccupied returns the squares occupied by a color's pieces
+func (b *WideBoard) colorOccupied(c Color) WideBitboard {
+	var occupied WideBitboard
+	for piece := range b.Positions {
+		if Pieces[piece].Color == c {
+			occupied = occupied.Or(b.Positions[piece])
+		}
+	}
+	return occupied
+}
+
+//-----------------------------------------------------------------------------
+// Move generation
+//-----------------------------------------------------------------------------
+
+// pieceMovements are the movements of the standard pieces other than the pawn
+var pieceMovements = map[Symbol][]Movement{}
+
+func init() {
+	for s, notation := range map[Symbol]string{KNIGHT: "N", BISHOP: "B", ROOK: "R", QUEEN: "Q", KING: "K"} {
+		pieceMovements[s], _ = ParseBetza(notation)
+	}
+}
+
+// movements returns how a piece other than a pawn moves
+func movements(s Symbol) []Movement {
+	if m, ok := pieceMovements[s]; ok {
+		return m
+	}
+	for i := range fairies {
+		if fairies[i].Symbol == s {
+			return fairies[i].movements
+		}
+	}
+	return nil
+}
+
+// reach returns the squares a piece other than a pawn on a square can move to, if
+// capture is false, or capture on, if capture is true. Riders stop at the first
+// occupied square, which is included.
+func (b *WideBoard) reach(piece, sq int, capture bool) WideBitboard {
+	var reach WideBitboard
+	x, y := b.BitToCartesian(sq)
+	for _, m := range movements(Pieces[piece].Symbol) {
+		if (capture && !m.Capture) || (!capture && !m.Move) {
+			continue
+		}
+		dx, dy := m.X, m.Y
+		if Pieces[piece].Color == BLACK {
+			dx, dy = -dx, -dy
+		}
+		for i, tx, ty := 1, x+dx, y+dy; b.Contains(tx, ty); i, tx, ty = i+1, tx+dx, ty+dy {
+			to := b.CartesianToBit(tx, ty)
+			reach.SetBit(to)
+			if b.Occupied.IsBitSet(to) || i == m.Range {
+				break
+			}
+		}
+	}
+	return reach
+}
+
+// forward returns the direction a color's pawns advance in
+func forward(c Color) int {
+	if c == WHITE {
+		return 1
+	}
+	return -1
+}
+
+// IsAttacked checks if a square is attacked by any piece of a color
+func (b *WideBoard) IsAttacked(sq int, by Color) bool {
+	pawns := b.Positions[PieceIndex(by, PAWN)]
+	for _, dx := range []int{-1, 1} {
+		if from := b.Offset(sq, dx, -forward(by)); from != NoSquare && pawns.IsBitSet(from) {
+			return true
+		}
+	}
+
+	// Look back along each way the pieces capture for the first piece in the way
+	x, y := b.BitToCartesian(sq)
+	for piece := range b.Positions {
+		if Pieces[piece].Color != by || Pieces[piece].Symbol == PAWN || b.Positions[piece].IsEmpty() {
+			continue
+		}
+		for _, m := range movements(Pieces[piece].Symbol) {
+			if !m.Capture {
+				continue
+			}
+			dx, dy := -m.X, -m.Y
+			if by == BLACK {
+				dx, dy = m.X, m.Y
+			}
+			for i, tx, ty := 1, x+dx, y+dy; b.Contains(tx, ty); i, tx, ty = i+1, tx+dx, ty+dy {
+				from := b.CartesianToBit(tx, ty)
+				if b.Occupied.IsBitSet(from) {
+					if b.Positions[piece].IsBitSet(from) {
+						return true
+					}
+					break
+				}
+				if i == m.Range {
+					break
+				}
+			}
+		}
+	}
+	return false
+}
+
+// InCheck checks if the side to move's king is attacked
+func (b *WideBoard) InCheck() bool {
+	king := b.Positions[PieceIndex(b.Turn, KING)].LowestBit()
+	return king != NoSquare && b.IsAttacked(king, b.Turn.Opponent())
+}
+
+// PseudoLegalMoves returns the moves of the side to move without checking whether they
+// leave its king in check
+func (b *WideBoard) PseudoLegalMoves() []Move {
+	var moves []Move
+	own, enemy := b.colorOccupied(b.Turn), b.colorOccupied(b.Turn.Opponent())
+	for piece := range b.Positions {
+		if Pieces[piece].Color != b.Turn {
+			continue
+		}
+		if Pieces[piece].Symbol == PAWN {
+			moves = b.generatePawnMoves(moves, piece, enemy)
+			continue
+		}
+		for _, from := range b.Positions[piece].Indices() {
+			targets := b.reach(piece, from, true).And(enemy).Or(b.reach(piece, from, false).AndNot(b.Occupied))
+			for _, to := range targets.AndNot(own).Indices() {
+				moves = append(moves, Move{from, to, piece, b.pieceAt(to), PAWN, 0})
+			}
+		}
+	}
+	return b.generateCastling(moves)
+}
+
+// LegalMoves returns the moves of the side to move that don't leave its king in check
+func (b *WideBoard) LegalMoves() []Move {
+	pseudo := b.PseudoLegalMoves()
+	legal := pseudo[:0]
+	for _, m := range pseudo {
+		b.MakeMove(m)
+		king := b.Positions[PieceIndex(b.Turn.Opponent(), KING)].LowestBit()
+		if king == NoSquare || !b.IsAttacked(king, b.Turn) {
+			legal = append(legal, m)
+		}
+		b.UnmakeMove()
+	}
+	return legal
+}
+
+// widePromotions returns the pieces pawns may promote to on a WideBoard
+func widePromotions() []Symbol {
+	symbols := append([]Symbol{}, promotions...)
+	for _, f := range fairies {
+		symbols = append(symbols, f.Symbol)
+	}
+	return symbols
+}
+
+// generatePawnMoves adds the moves of the side to move's pawns
+func (b *WideBoard) generatePawnMoves(moves []Move, pawn int, enemy WideBitboard) []Move {
+	dir, start, last := forward(b.Turn), 1, b.Ranks-1
+	if b.Turn == BLACK {
+		start, last = b.Ranks-2, 0
+	}
+	add := func(m Move) {
+		if _, y := b.BitToCartesian(m.To); y != last {
+			moves = append(moves, m)
+			return
+		}
+		for _, symbol := range widePromotions() {
+			m.Promotion = symbol
+			moves = append(moves, m)
+		}
+	}
+	for _, from := range b.Positions[pawn].Indices() {
+		if to := b.Offset(from, 0, dir); to != NoSquare && !b.Occupied.IsBitSet(to) {
+			add(Move{from, to, pawn, NoPiece, PAWN, 0})
+			if _, y := b.BitToCartesian(from); y == start {
+				if to := b.Offset(to, 0, dir); to != NoSquare && !b.Occupied.IsBitSet(to) {
+					moves = append(moves, Move{from, to, pawn, NoPiece, PAWN, DoublePush})
+				}
+			}
+		}
+		for _, dx := range []int{-1, 1} {
+			to := b.Offset(from, dx, dir)
+			switch {
+			case to == NoSquare:
+			case to == b.EnPassant:
+				moves = append(moves, Move{from, to, pawn, PieceIndex(b.Turn.Opponent(), PAWN), PAWN, EnPassant})
+			case enemy.IsBitSet(to):
+				add(Move{from, to, pawn, b.pieceAt(to), PAWN, 0})
+			}
+		}
+	}
+	return moves
+}
+
+// castlingSquares returns the squares the king and rook start on and move to when
+// castling, without checking that they're there
+func (b *WideBoard) castlingSquares(right CastlingRights) (king, rook, kingTo, rookTo int) {
+	c, y := WHITE, 0
+	if right&(BlackKingSide|BlackQueenSide) != 0 {
+		c, y = BLACK, b.Ranks-1
+	}
+	king = b.Positions[PieceIndex(c, KING)].LowestBit()
+	if right&(WhiteKingSide|BlackKingSide) != 0 {
+		return king, b.CartesianToBit(b.Files-1, y), b.CartesianToBit(b.Files-2, y), b.CartesianToBit(b.Files-3, y)
+	}
+	return king, b.CartesianToBit(0, y), b.CartesianToBit(2, y), b.CartesianToBit(3, y)
+}
+
+// canCastle checks that the king and rook of a castling right are in place, with the
+// king between the rook and the other corner
+func (b *WideBoard) canCastle(right CastlingRights) bool {
+	c := WHITE
+	if right&(BlackKingSide|BlackQueenSide) != 0 {
+		c = BLACK
+	}
+	king, rook, _, _ := b.castlingSquares(right)
+	if king == NoSquare || !b.Positions[PieceIndex(c, ROOK)].IsBitSet(rook) {
+		return false
+	}
+	x, y := b.BitToCartesian(king)
+	_, ry := b.BitToCartesian(rook)
+	return y == ry && x > 0 && x < b.Files-1
+}
+
+// generateCastling adds the castling moves of the side to move. The squares between
+// the king and rook and their destinations must be empty, and the king may not be in
+// check or pass through or land on an attacked square.
+func (b *WideBoard) generateCastling(moves []Move) []Move {
+	for _, right := range []CastlingRights{kingSide(b.Turn), queenSide(b.Turn)} {
+		if b.Castling&right == 0 || !b.canCastle(right) {
+			continue
+		}
+		king, rook, kingTo, rookTo := b.castlingSquares(right)
+		lo, hi := king, king
+		for _, sq := range []int{rook, kingTo, rookTo} {
+			if sq < lo {
+				lo = sq
+			}
+			if sq > hi {
+				hi = sq
+			}
+		}
+		clear := true
+		for sq := lo; sq <= hi && clear; sq++ {
+			clear = sq == king || sq == rook || !b.Occupied.IsBitSet(sq)
+		}
+		step := 1
+		if kingTo < king {
+			step = -1
+		}
+		for sq := king; clear; sq += step {
+			clear = !b.IsAttacked(sq, b.Turn.Opponent())
+			if sq == kingTo {
+				break
+			}
+		}
+		if clear {
+			flag := KingSideCastle
+			if right == queenSide(b.Turn) {
+				flag = QueenSideCastle
+			}
+			moves = append(moves, Move{king, kingTo, PieceIndex(b.Turn, KING), NoPiece, PAWN, flag})
+		}
+	}
+	return moves
+}
+
+//-----------------------------------------------------------------------------
+// Making moves
+//-----------------------------------------------------------------------------
+
+// MakeMove plays a move, which is assumed to be legal
+func (b *WideBoard) MakeMove(m Move) {
+	b.history = append(b.history, wideUndo{m, b.Castling, b.EnPassant, b.HalfMoveClock})
+
+	switch {
+	case m.Flags&EnPassant != 0:
+		b.RemovePiece(m.Captured, b.Offset(m.To, 0, -forward(b.Turn)))
+	case m.IsCapture():
+		b.RemovePiece(m.Captured, m.To)
+	}
+	b.RemovePiece(m.Piece, m.From)
+	if m.IsCastle() {
+		_, rook, _, rookTo := b.castlingSquares(b.castlingRight(m))
+		rookPiece := PieceIndex(b.Turn, ROOK)
+		b.RemovePiece(rookPiece, rook)
+		b.PlacePiece(rookPiece, rookTo)
+	}
+	if m.IsPromotion() {
+		b.PlacePiece(PieceIndex(b.Turn, m.Promotion), m.To)
+	} else {
+		b.PlacePiece(m.Piece, m.To)
+	}
+
+	// Moving the king or a rook from its corner, or capturing a rook there, loses the right
+	for _, right := range []CastlingRights{WhiteKingSide, WhiteQueenSide, BlackKingSide, BlackQueenSide} {
+		_, rook, _, _ := b.castlingSquares(right)
+		if b.Castling&right != 0 && (m.From == rook || m.To == rook) {
+			b.Castling &^= right
+		}
+	}
+	if Pieces[m.Piece].Symbol == KING {
+		b.Castling &^= kingSide(b.Turn) | queenSide(b.Turn)
+	}
+
+	b.EnPassant = NoSquare
+	if m.Flags&DoublePush != 0 {
+		b.EnPassant = b.Offset(m.From, 0, forward(b.Turn))
+	}
+	b.HalfMoveClock++
+	if Pieces[m.Piece].Symbol == PAWN || m.IsCapture() {
+		b.HalfMoveClock = 0
+	}
+	if b.Turn == BLACK {
+		b.FullMoveNumber++
+	}
+	b.Turn = b.Turn.Opponent()
+}
+
+// UnmakeMove takes back the last move played, returning an error if there is none
+func (b *WideBoard) UnmakeMove() (Move, error) {
+	if len(b.history) == 0 {
+		return Move{}, fmt.Errorf("no moves to take back")
+	}
+	u := b.history[len(b.history)-1]
+	b.history = b.history[:len(b.history)-1]
+	m := u.move
+
+	b.Turn = b.Turn.Opponent()
+	if b.Turn == BLACK {
+		b.FullMoveNumber--
+	}
+	b.Castling, b.EnPassant, b.HalfMoveClock = u.castling, u.enPassant, u.halfMoveClock
+
+	if m.IsPromotion() {
+		b.RemovePiece(PieceIndex(b.Turn, m.Promotion), m.To)
+	} else {
+		b.RemovePiece(m.Piece, m.To)
+	}
+	if m.IsCastle() {
+		_, rook, _, rookTo := b.castlingSquares(b.castlingRight(m))
+		rookPiece := PieceIndex(b.Turn, ROOK)
+		b.RemovePiece(rookPiece, rookTo)
+		b.PlacePiece(rookPiece, rook)
+	}
+	b.PlacePiece(m.Piece, m.From)
+	switch {
+	case m.Flags&EnPassant != 0:
+		b.PlacePiece(m.Captured, b.Offset(m.To, 0, -forward(b.Turn)))
+	case m.IsCapture():
+		b.PlacePiece(m.Captured, m.To)
+	}
+	return m, nil
+}
+
+// castlingRight returns the castling right used by a castling move of the side to move
+func (b *WideBoard) castlingRight(m Move) CastlingRights {
+	if m.Flags&KingSideCastle != 0 {
+		return kingSide(b.Turn)
+	}
+	return queenSide(b.Turn)
+}
+
+// UCI formats a move in the long algebraic notation of the Universal Chess Interface
+// with the board's coordinates, e.g. "j2j4" or "f1i1" for castling on a 10x8 board
+func (b *WideBoard) UCI(m Move) string {
+	s := b.BitToAlgebraic(m.From) + b.BitToAlgebraic(m.To)
+	if m.IsPromotion() {
+		s += strings.ToLower(string(rune(m.Promotion)))
+	}
+	return s
+}
+
+// ParseUCI returns the legal move given in UCI notation, returning an error if there
+// is no such move
+func (b *WideBoard) ParseUCI(s string) (Move, error) {
+	for _, m := range b.LegalMoves() {
+		if b.UCI(m) == s {
+			return m, nil
+		}
+	}
+	return Move{}, fmt.Errorf("illegal move %q", s)
+}
+
+// Perft counts the leaf nodes of the tree of legal moves to the given depth
+func (b *WideBoard) Perft(depth int) int64 {
+	if depth == 0 {
+		return 1
+	}
+	moves := b.LegalMoves()
+	if depth == 1 {
+		return int64(len(moves))
+	}
+	var nodes int64
+	for _, m := range moves {
+		b.MakeMove(m)
+		nodes += b.Perft(depth - 1)
+		b.UnmakeMove()
+	}
+	return nodes
+}
@@ -0,0 +1,106 @@
+package chess
+
+import "testing"
+
+func TestWideBoardPerft(t *testing.T) {
+	registerFairyPieces(t)
+
+	// The standard positions without promotions, which may be to fairy pieces on a
+	// WideBoard, must count the same as on a Board
+	positions := append(perftPositions[:3:3], struct {
+		FEN    string
+		Counts []int64
+	}{CapablancaFEN, []int64{28, 784, 25228, 805128}})
+	for _, position := range positions {
+		board, err := ParseWideFEN(position.FEN)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", position.FEN, err)
+		}
+		for i, expected := range position.Counts {
+			if testing.Short() && expected > 100000 {
+				break
+			}
+			if actual := board.Perft(i + 1); actual != expected {
+				t.Errorf("perft(%d) of %s expected %d, actual: %d", i+1, position.FEN, expected, actual)
+			}
+		}
+		if board.FEN() != position.FEN {
+			t.Errorf("perft should leave the board unchanged, expected %s, actual: %s", position.FEN, board.FEN())
+		}
+	}
+}
+
+func TestWideBoardMoves(t *testing.T) {
+	registerFairyPieces(t)
+
+	cases := []struct {
+		FEN   string
+		UCI   string
+		After string
+	}{
+		// The king castles to the second file from the corner on a 10x8 board
+		{"r4k3r/10/10/10/10/10/10/R4K3R w KQkq - 0 1", "f1i1", "r4k3r/10/10/10/10/10/10/R6RK1 b kq - 1 1"},
+		{"r4k3r/10/10/10/10/10/10/R4K3R b KQkq - 0 1", "f8c8", "2kr5r/10/10/10/10/10/10/R4K3R w KQ - 1 2"},
+		{"4k5/10/10/10/10/10/PPPPPPPPPP/4K5 w - - 0 1", "j2j4", "4k5/10/10/10/9P/10/PPPPPPPPP1/4K5 b - j3 0 1"},
+		{"4k5/10/10/10/8Pp/10/10/4K5 b - i3 0 1", "j4i3", "4k5/10/10/10/10/8p1/10/4K5 w - - 0 2"},
+		{"4k5/P9/10/10/10/10/10/4K5 w - - 0 1", "a7a8c", "C3k5/10/10/10/10/10/10/4K5 b - - 0 1"},
+		// A 10x10 board in the Grand chess setup
+		{"r8r/1nbqkcabn1/pppppppppp/10/10/10/10/PPPPPPPPPP/1NBQKCABN1/R8R w - - 0 1", "g2h4", "r8r/1nbqkcabn1/pppppppppp/10/10/10/7A2/PPPPPPPPPP/1NBQKC1BN1/R8R b - - 1 1"},
+	}
+	for _, c := range cases {
+		board, err := ParseWideFEN(c.FEN)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", c.FEN, err)
+		}
+		if board.FEN() != c.FEN {
+			t.Errorf("expected FEN %s, actual: %s", c.FEN, board.FEN())
+		}
+		m, err := board.ParseUCI(c.UCI)
+		if err != nil {
+			t.Errorf("unexpected error parsing %s in %s: %s", c.UCI, c.FEN, err)
+			continue
+		}
+		board.MakeMove(m)
+		if board.FEN() != c.After {
+			t.Errorf("expected %s after %s, actual: %s", c.After, c.UCI, board.FEN())
+		}
+		board.UnmakeMove()
+		if board.FEN() != c.FEN {
+			t.Errorf("expected %s after taking back %s, actual: %s", c.FEN, c.UCI, board.FEN())
+		}
+	}
+
+	// Castling through a square attacked by a chancellor, and out of check
+	for _, c := range []struct {
+		FEN  string
+		X, Y int
+	}{
+		{"r4k3r/10/10/10/10/10/10/R4K3R w K - 0 1", 6, 5},
+		{"4k5/10/10/10/10/10/10/R4K3R w K - 0 1", 4, 2},
+	} {
+		board, _ := ParseWideFEN(c.FEN)
+		board.PlacePiece(PieceIndex(BLACK, Chancellor.Symbol), board.CartesianToBit(c.X, c.Y))
+		for _, m := range board.LegalMoves() {
+			if m.IsCastle() {
+				t.Errorf("castling should be illegal in:\n%s", board)
+			}
+		}
+	}
+
+	board, _ := ParseWideFEN("4k5/10/10/10/10/10/10/q3K5 w - - 0 1")
+	if !board.InCheck() || board.Dimensions != Capablanca10x8 {
+		t.Errorf("expected white to be in check on a 10x8 board:\n%s", board)
+	}
+
+	for _, fen := range []string{
+		"4k5/10/10/10/10/10/10/4K4 w - - 0 1",
+		"4k5/10/10/10/10/10/10/4K4P w - - 0 1",
+		"4k5/10/10/10/10/10/10/4K5 w K - 0 1",
+		"4k5/10/10/10/10/10/10/4K5 w - j4 0 1",
+		"4k5/10/10/10/10/10/10/4K0 w - - 0 1",
+	} {
+		if _, err := ParseWideFEN(fen); err == nil {
+			t.Errorf("parsing %q should have failed", fen)
+		}
+	}
+}
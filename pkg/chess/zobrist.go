@@ -0,0 +1,183 @@
+package chess
+
+import "hash/fnv"
+
+//-----------------------------------------------------------------------------
+// Zobrist hashing
+//-----------------------------------------------------------------------------
+
+// Positions are hashed with the Zobrist scheme used by Polyglot opening books so the
+// keys can be used both to look up book moves and as general purpose position keys
+// (transposition tables, repetition detection). See
+// <http://hgm.nubati.net/book_format.html> for the definition.
+
+const (
+	polyglotCastleOffset    = 768
+	polyglotEnPassantOffset = 772
+	polyglotTurnOffset      = 780
+)
+
+// polyglotKind maps each piece to the Polyglot piece kind, i.e. black pawn 0, white
+// pawn 1, black knight 2, ... white king 11
+func polyglotKind(p Piece) int {
+	var kind int
+	switch p.Symbol {
+	case PAWN:
+		kind = 0
+	case KNIGHT:
+		kind = 2
+	case BISHOP:
+		kind = 4
+	case ROOK:
+		kind = 6
+	case QUEEN:
+		kind = 8
+	case KING:
+		kind = 10
+	default:
+		return -1
+	}
+	if p.Color == WHITE {
+		kind++
+	}
+	return kind
+}
+
+// pieceKey returns the Zobrist key for a piece on a square
+func pieceKey(p Piece, sq int) uint64 {
+	kind := polyglotKind(p)
+	if kind < 0 {
+		if i := p.Index - standardPieces; i >= 0 && i < len(fairyKeys) {
+			return fairyKeys[i][sq]
+		}
+		return 0
+	}
+	return polyglotRandom[64*kind+sq]
+}
+
+// castlingKey returns the combined Zobrist key for a set of castling rights
+func castlingKey(rights CastlingRights) uint64 {
+	var key uint64
+	for i, right := range []CastlingRights{WhiteKingSide, WhiteQueenSide, BlackKingSide, BlackQueenSide} {
+		if rights&right != 0 {
+			key ^= polyglotRandom[polyglotCastleOffset+i]
+		}
+	}
+	return key
+}
+
+// enPassantKey returns the Zobrist key for the en passant file. As with Polyglot, the
+// file is only hashed when a pawn of the side to move is able to make the capture.
+func (b *Board) enPassantKey() uint64 {
+	if b.EnPassant == NoSquare {
+		return 0
+	}
+	if pawnAttacks[b.Turn.Opponent()][b.EnPassant]&b.pieces(b.Turn, PAWN) == 0 {
+		return 0
+	}
+	return polyglotRandom[polyglotEnPassantOffset+b.EnPassant%FILES]
+}
+
+// turnKey returns the Zobrist key for the side to move
+func turnKey(c Color) uint64 {
+	if c == WHITE {
+		return polyglotRandom[polyglotTurnOffset]
+	}
+	return 0
+}
+
+// pocketKeys are the Zobrist keys for the number of each type of piece in a pocket.
+// Polyglot has no keys for pockets so they're generated with a fixed xorshift sequence.
+var pocketKeys = func() (keys [2][len(pocketSymbols)][32]uint64) {
+	x := uint64(0x9e3779b97f4a7c15)
+	for c := range keys {
+		for i := range keys[c] {
+			for n := 1; n < len(keys[c][i]); n++ {
+				x ^= x << 13
+				x ^= x >> 7
+				x ^= x << 17
+				keys[c][i][n] = x
+			}
+		}
+	}
+	return keys
+}()
+
+// pocketKey returns the Zobrist key for holding n pieces of a type in a pocket. An empty
+// pocket has no key so standard positions keep their Polyglot keys.
+func pocketKey(c Color, i, n int) uint64 {
+	return pocketKeys[c][i][n%len(pocketKeys[c][i])]
+}
+
+// checkKeys are the Zobrist keys for the number of checks each side has given in
+// Three-check, generated like the pocket keys
+var checkKeys = func() (keys [2][4]uint64) {
+	x := uint64(0x6a09e667f3bcc909)
+	for c := range keys {
+		for n := 1; n < len(keys[c]); n++ {
+			x ^= x << 13
+			x ^= x >> 7
+			x ^= x << 17
+			keys[c][n] = x
+		}
+	}
+	return keys
+}()
+
+// checksKey returns the Zobrist key for a side having given n checks. No checks has no
+// key so other positions keep their Polyglot keys.
+func checksKey(c Color, n int) uint64 {
+	return checkKeys[c][n%len(checkKeys[c])]
+}
+
+// fairyKeys are the Zobrist keys for each registered fairy piece on each square, in the
+// order the pieces were added to Pieces
+var fairyKeys [][64]uint64
+
+// newFairyKeys returns the keys for a fairy piece, generated like the pocket keys from
+// a seed hashed from the piece's color and symbol. Keys don't depend on the order pieces
+// are registered in, so positions hash the same in every program registering the piece.
+func newFairyKeys(c Color, s Symbol) (keys [64]uint64) {
+	h := fnv.New64a()
+	h.Write([]byte{byte(c), byte(s)})
+	seed := h.Sum64() | 1
+	for sq := range keys {
+		seed ^= seed << 13
+		seed ^= seed >> 7
+		seed ^= seed << 17
+		keys[sq] = seed
+	}
+	return keys
+}
+
+// computeHash calculates the Zobrist key of the position from scratch
+func (b *Board) computeHash() uint64 {
+	var hash uint64
+	for i, position := range b.Positions {
+		for position != 0 {
+			sq := position.popLowestBit()
+			hash ^= pieceKey(b.Pieces[i], sq)
+		}
+	}
+	for c, pocket := range b.Pockets {
+		for i, n := range pocket {
+			hash ^= pocketKey(Color(c), i, n)
+		}
+	}
+	for c, n := range b.Checks {
+		hash ^= checksKey(Color(c), n)
+	}
+	return hash ^ castlingKey(b.Castling) ^ b.enPassantKey() ^ turnKey(b.Turn)
+}
+
+// Hash returns the Polyglot-compatible Zobrist key of the current position. The key is
+// updated incrementally as moves are made; after changing the exported board fields
+// directly call Rehash to bring it up to date.
+func (b *Board) Hash() uint64 {
+	return b.hash
+}
+
+// Rehash recalculates the Zobrist key of the position from scratch
+func (b *Board) Rehash() {
+	b.hash = b.computeHash()
+}
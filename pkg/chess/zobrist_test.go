@@ -0,0 +1,36 @@
+package chess
+
+import "testing"
+
+// Test positions and keys from the Polyglot book format specification
+var polyglotKeys = []struct {
+	Moves []string
+	Key   uint64
+}{
+	{[]string{}, 0x463b96181691fc9c},
+	{[]string{"e2e4"}, 0x823c9b50fd114196},
+	{[]string{"e2e4", "d7d5"}, 0x0756b94461c50fb0},
+	{[]string{"e2e4", "d7d5", "e4e5"}, 0x662fafb965db29d4},
+	{[]string{"e2e4", "d7d5", "e4e5", "f7f5"}, 0x22a48b5a8e47ff78},
+	{[]string{"e2e4", "d7d5", "e4e5", "f7f5", "e1e2"}, 0x652a607ca3f242c1},
+	{[]string{"e2e4", "d7d5", "e4e5", "f7f5", "e1e2", "e8f7"}, 0x00fdd303c946bdd9},
+	{[]string{"a2a4", "b7b5", "h2h4", "b5b4", "c2c4"}, 0x3c8123ea7b067637},
+	{[]string{"a2a4", "b7b5", "h2h4", "b5b4", "c2c4", "b4c3", "a1a3"}, 0x5c3f9b829b279560},
+}
+
+func TestHash(t *testing.T) {
+	for _, position := range polyglotKeys {
+		board, _ := NewBoard()
+		if err := board.Play(position.Moves...); err != nil {
+			t.Fatalf("unexpected error playing %v: %s", position.Moves, err)
+		}
+		if board.Hash() != position.Key {
+			t.Errorf("expected key 0x%016x after %v, actual: 0x%016x", position.Key, position.Moves, board.Hash())
+		}
+
+		parsed, _ := ParseFEN(board.FEN())
+		if parsed.Hash() != position.Key {
+			t.Errorf("expected key 0x%016x for %s, actual: 0x%016x", position.Key, board.FEN(), parsed.Hash())
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package clock
+
+import "time"
+
+// Budget is the amount of time an engine should think about a move. Optimum is the
+// target the engine should stop at once it has a stable best move, Maximum is the
+// hard limit it must never exceed.
+type Budget struct {
+	Optimum time.Duration
+	Maximum time.Duration
+}
+
+// Allocator decides how long an engine should think per move. The zero value is ready
+// to use with sensible defaults.
+type Allocator struct {
+	Overhead    time.Duration // reserved per move for communication and move entry lag
+	MovesToGo   int           // assumed number of moves left when the time control doesn't say
+	MaxFraction float64       // largest fraction of the remaining time spent on one move
+	Stretch     float64       // how many times the optimum the maximum may extend to
+}
+
+// DefaultAllocator is the allocator used when no tuning is required
+var DefaultAllocator = Allocator{
+	Overhead:    50 * time.Millisecond,
+	MovesToGo:   30,
+	MaxFraction: 0.5,
+	Stretch:     3,
+}
+
+// lastMoveReserve is the fraction of the usable time kept back on the last move before a
+// time control
+const lastMoveReserve = 0.1
+
+// Allocate budgets the time for the next move given the time remaining, the increment
+// (or delay) earned per move and the moves left until the next time control (0 if the
+// current period lasts for the rest of the game)
+func (a Allocator) Allocate(remaining, increment time.Duration, movesToGo int) Budget {
+	if a.MovesToGo <= 0 {
+		a.MovesToGo = DefaultAllocator.MovesToGo
+	}
+	if a.MaxFraction <= 0 || a.MaxFraction > 1 {
+		a.MaxFraction = DefaultAllocator.MaxFraction
+	}
+	if a.Stretch < 1 {
+		a.Stretch = DefaultAllocator.Stretch
+	}
+
+	usable := remaining - a.Overhead
+	if usable <= 0 {
+		return Budget{}
+	}
+
+	mtg := a.MovesToGo
+	if movesToGo > 0 && movesToGo < mtg {
+		mtg = movesToGo
+	}
+
+	// The last move before a time control may use most of what's left since the clock
+	// is topped up straight afterwards, keeping back a little in case the move takes
+	// longer to reach the clock than the overhead allows for
+	limit := usable - time.Duration(float64(usable)*lastMoveReserve)
+	if mtg > 1 {
+		limit = time.Duration(float64(usable) * a.MaxFraction)
+	}
+
+	optimum := usable/time.Duration(mtg) + increment*3/4
+	if optimum > limit {
+		optimum = limit
+	}
+	maximum := time.Duration(float64(optimum) * a.Stretch)
+	if maximum > limit {
+		maximum = limit
+	}
+
+	return Budget{optimum, maximum}
+}
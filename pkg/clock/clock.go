@@ -0,0 +1,321 @@
+package clock
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// Source provides the current time to a clock so tests can control time explicitly
+type Source interface {
+	Now() time.Time
+}
+
+type systemSource struct{}
+
+func (systemSource) Now() time.Time {
+	return time.Now()
+}
+
+// SystemSource reads the current time from the operating system
+var SystemSource Source = systemSource{}
+
+// FakeSource is a manually advanced source of time for tests
+type FakeSource struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeSource returns a fake source of time starting at the given instant
+func NewFakeSource(start time.Time) *FakeSource {
+	return &FakeSource{now: start}
+}
+
+// Now returns the current fake time
+func (f *FakeSource) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Advance moves the fake time forward by the given duration
+func (f *FakeSource) Advance(d time.Duration) {
+	f.mu.Lock()
+	f.now = f.now.Add(d)
+	f.mu.Unlock()
+}
+
+// Clock is a two player chess clock. White's clock runs first once the clock is
+// started, unless Switch hands the first move to black, and every call to Press ends the
+// move of the player whose clock is running.
+type Clock struct {
+	mu          sync.Mutex
+	control     TimeControl
+	source      Source
+	remaining   [2]time.Duration
+	moves       [2]int
+	period      [2]int
+	periodMoves [2]int
+	turn        chess.Color
+	running     bool
+	started     time.Time     // when the running clock was last started or resumed
+	spent       time.Duration // time spent on the current move before the last pause
+	flagged     bool
+}
+
+// NewClock returns a stopped clock for the given time control. If no source is
+// given, the clock reads the system time.
+func NewClock(control TimeControl, source ...Source) (*Clock, error) {
+	if err := control.Validate(); err != nil {
+		return nil, err
+	}
+
+	c := &Clock{control: control, source: SystemSource, turn: chess.WHITE}
+	if len(source) > 0 && source[0] != nil {
+		c.source = source[0]
+	}
+	c.remaining[chess.WHITE] = control.Periods[0].Time
+	c.remaining[chess.BLACK] = control.Periods[0].Time
+
+	return c, nil
+}
+
+// Control returns the time control the clock was created with
+func (c *Clock) Control() TimeControl {
+	return c.control
+}
+
+// Start starts the clock of the player to move (white for a new clock)
+func (c *Clock) Start() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.flagged {
+		return fmt.Errorf("%s has run out of time", c.turn)
+	}
+	if c.running {
+		return fmt.Errorf("clock is already running")
+	}
+	c.running = true
+	c.started = c.source.Now()
+	return nil
+}
+
+// Pause stops the running clock without ending the current move
+func (c *Clock) Pause() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.running {
+		c.spent = c.elapsed()
+		c.running = false
+		c.checkFlag()
+	}
+}
+
+// Running reports whether a player's clock is currently counting down
+func (c *Clock) Running() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.running
+}
+
+// Turn returns the color of the player whose move is being timed
+func (c *Clock) Turn() chess.Color {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.turn
+}
+
+// Press ends the move of the player whose clock is running, charging them for the time
+// spent according to the clock mode, and starts the opponent's clock
+func (c *Clock) Press() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.flagged {
+		return fmt.Errorf("%s has run out of time", c.turn)
+	}
+	if !c.running {
+		return fmt.Errorf("clock is not running")
+	}
+	if c.checkFlag() {
+		return fmt.Errorf("%s has run out of time", c.turn)
+	}
+
+	now := c.source.Now()
+	elapsed := c.spent + now.Sub(c.started)
+	mover, opponent := c.turn, c.turn.Opponent()
+	bonus := c.control.Periods[c.period[mover]].Bonus
+
+	switch c.control.Mode {
+	case SuddenDeath:
+		c.remaining[mover] -= elapsed
+	case Fischer:
+		c.remaining[mover] += bonus - elapsed
+	case Bronstein:
+		c.remaining[mover] -= elapsed - minDuration(elapsed, bonus)
+	case SimpleDelay:
+		c.remaining[mover] -= elapsed - minDuration(elapsed, bonus)
+	case Hourglass:
+		c.remaining[mover] -= elapsed
+		c.remaining[opponent] += elapsed
+	}
+
+	c.moves[mover]++
+	c.periodMoves[mover]++
+	if p := c.control.Periods[c.period[mover]]; p.Moves > 0 && c.periodMoves[mover] == p.Moves {
+		// The final period repeats when it has a move limit, e.g. "40/7200"
+		if c.period[mover] < len(c.control.Periods)-1 {
+			c.period[mover]++
+		}
+		c.periodMoves[mover] = 0
+		c.remaining[mover] += c.control.Periods[c.period[mover]].Time
+	}
+
+	c.turn = opponent
+	c.spent = 0
+	c.started = now
+	return nil
+}
+
+// Switch hands the move to the opponent without ending it: the time spent on the current
+// move isn't charged, no increment is added and no move is counted. It sets up a game with
+// black to move and realigns the clock after moves are taken back.
+func (c *Clock) Switch() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.checkFlag() {
+		return fmt.Errorf("%s has run out of time", c.turn)
+	}
+	c.turn = c.turn.Opponent()
+	c.spent = 0
+	c.started = c.source.Now()
+	return nil
+}
+
+// Remaining returns the time left on the given player's clock, including the time
+// being spent on the current move
+func (c *Clock) Remaining(color chess.Color) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checkFlag()
+	return c.remainingNow(color)
+}
+
+// Moves returns the number of moves completed by the given player
+func (c *Clock) Moves(color chess.Color) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.moves[color]
+}
+
+// MovesToGo returns the number of moves the given player must make before the next time
+// control, or 0 if the current period lasts for the rest of the game
+func (c *Clock) MovesToGo(color chess.Color) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if p := c.control.Periods[c.period[color]]; p.Moves > 0 {
+		return p.Moves - c.periodMoves[color]
+	}
+	return 0
+}
+
+// Bonus returns the increment or delay that applies to the given player's next move
+func (c *Clock) Bonus(color chess.Color) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.control.Periods[c.period[color]].Bonus
+}
+
+// Flagged reports whether a player has run out of time and, if so, which one
+func (c *Clock) Flagged() (chess.Color, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.turn, c.checkFlag()
+}
+
+// Allocate uses the allocator to budget thinking time for the given player's next move
+func (c *Clock) Allocate(a Allocator, color chess.Color) Budget {
+	remaining, bonus, movesToGo := c.Remaining(color), c.Bonus(color), c.MovesToGo(color)
+	if c.control.Mode == SuddenDeath || c.control.Mode == Hourglass {
+		bonus = 0
+	}
+	return a.Allocate(remaining, bonus, movesToGo)
+}
+
+// String displays both clocks, marking the one that is running
+func (c *Clock) String() string {
+	var s []string
+	for _, color := range []chess.Color{chess.WHITE, chess.BLACK} {
+		marker := " "
+		if c.Running() && c.Turn() == color {
+			marker = "*"
+		}
+		s = append(s, fmt.Sprintf("%s%s %s", marker, color, Format(c.Remaining(color))))
+	}
+	return strings.Join(s, "  ")
+}
+
+// Format displays a duration the way a digital chess clock does, i.e. h:mm:ss, with
+// tenths of a second shown when less than 10 seconds remain
+func Format(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	if d < 10*time.Second {
+		return fmt.Sprintf("%s0:%02d.%d", sign, d/time.Second, (d%time.Second)/(100*time.Millisecond))
+	}
+	d = d.Truncate(time.Second)
+	h, m, s := d/time.Hour, (d%time.Hour)/time.Minute, (d%time.Minute)/time.Second
+	if h > 0 {
+		return fmt.Sprintf("%s%d:%02d:%02d", sign, h, m, s)
+	}
+	return fmt.Sprintf("%s%d:%02d", sign, m, s)
+}
+
+// elapsed returns the time spent on the current move, must be called with the lock held
+func (c *Clock) elapsed() time.Duration {
+	if !c.running {
+		return c.spent
+	}
+	return c.spent + c.source.Now().Sub(c.started)
+}
+
+// remainingNow must be called with the lock held
+func (c *Clock) remainingNow(color chess.Color) time.Duration {
+	if c.flagged && color == c.turn {
+		return 0
+	}
+	remaining := c.remaining[color]
+	elapsed := c.elapsed()
+	bonus := c.control.Periods[c.period[c.turn]].Bonus
+
+	switch {
+	case color == c.turn && c.control.Mode == SimpleDelay:
+		remaining -= elapsed - minDuration(elapsed, bonus)
+	case color == c.turn:
+		remaining -= elapsed
+	case c.control.Mode == Hourglass:
+		remaining += elapsed
+	}
+	return remaining
+}
+
+// checkFlag must be called with the lock held, it stops the clock when the player to move
+// has run out of time
+func (c *Clock) checkFlag() bool {
+	if !c.flagged && c.remainingNow(c.turn) <= 0 {
+		c.flagged = true
+		c.running = false
+	}
+	return c.flagged
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+	return b
+}
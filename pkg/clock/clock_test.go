@@ -0,0 +1,255 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+var epoch = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// play simulates a sequence of moves, each taking the given duration, alternating sides
+func play(t *testing.T, c *Clock, src *FakeSource, durations ...time.Duration) {
+	t.Helper()
+	for _, d := range durations {
+		src.Advance(d)
+		if err := c.Press(); err != nil {
+			t.Fatalf("unexpected error pressing clock: %s", err)
+		}
+	}
+}
+
+func newTestClock(t *testing.T, tc TimeControl) (*Clock, *FakeSource) {
+	src := NewFakeSource(epoch)
+	c, err := NewClock(tc, src)
+	if err != nil {
+		t.Fatalf("unexpected error creating clock: %s", err)
+	}
+	if err := c.Start(); err != nil {
+		t.Fatalf("unexpected error starting clock: %s", err)
+	}
+	return c, src
+}
+
+func expectRemaining(t *testing.T, c *Clock, color chess.Color, expected time.Duration) {
+	t.Helper()
+	if actual := c.Remaining(color); actual != expected {
+		t.Errorf("expected %s to have %s remaining, actual: %s", color, expected, actual)
+	}
+}
+
+func TestSuddenDeath(t *testing.T) {
+	c, src := newTestClock(t, SuddenDeathControl(time.Minute))
+	play(t, c, src, 10*time.Second, 5*time.Second)
+	expectRemaining(t, c, chess.WHITE, 50*time.Second)
+	expectRemaining(t, c, chess.BLACK, 55*time.Second)
+
+	src.Advance(20 * time.Second)
+	expectRemaining(t, c, chess.WHITE, 30*time.Second)
+
+	src.Advance(31 * time.Second)
+	if color, flagged := c.Flagged(); !flagged || color != chess.WHITE {
+		t.Errorf("expected white to have flagged, actual: %s %t", color, flagged)
+	}
+	if err := c.Press(); err == nil {
+		t.Errorf("pressing a flagged clock should error")
+	}
+	expectRemaining(t, c, chess.WHITE, 0)
+}
+
+func TestFischer(t *testing.T) {
+	c, src := newTestClock(t, FischerControl(time.Minute, 2*time.Second))
+	play(t, c, src, 10*time.Second, time.Second)
+	expectRemaining(t, c, chess.WHITE, 52*time.Second)
+	expectRemaining(t, c, chess.BLACK, 61*time.Second)
+}
+
+func TestBronstein(t *testing.T) {
+	c, src := newTestClock(t, BronsteinControl(time.Minute, 3*time.Second))
+	play(t, c, src, 10*time.Second, 2*time.Second)
+	expectRemaining(t, c, chess.WHITE, 53*time.Second)
+	expectRemaining(t, c, chess.BLACK, time.Minute)
+
+	// Bronstein counts down immediately and adds the delay back afterwards
+	src.Advance(2 * time.Second)
+	expectRemaining(t, c, chess.WHITE, 51*time.Second)
+}
+
+func TestSimpleDelay(t *testing.T) {
+	c, src := newTestClock(t, SimpleDelayControl(time.Minute, 3*time.Second))
+	play(t, c, src, 10*time.Second, 2*time.Second)
+	expectRemaining(t, c, chess.WHITE, 53*time.Second)
+	expectRemaining(t, c, chess.BLACK, time.Minute)
+
+	// Simple delay doesn't count down until the delay has expired
+	src.Advance(2 * time.Second)
+	expectRemaining(t, c, chess.WHITE, 53*time.Second)
+	src.Advance(2 * time.Second)
+	expectRemaining(t, c, chess.WHITE, 52*time.Second)
+}
+
+func TestHourglass(t *testing.T) {
+	c, src := newTestClock(t, HourglassControl(time.Minute))
+	play(t, c, src, 10*time.Second)
+	expectRemaining(t, c, chess.WHITE, 50*time.Second)
+	expectRemaining(t, c, chess.BLACK, 70*time.Second)
+
+	src.Advance(5 * time.Second)
+	expectRemaining(t, c, chess.WHITE, 55*time.Second)
+	expectRemaining(t, c, chess.BLACK, 65*time.Second)
+}
+
+func TestMultiplePeriods(t *testing.T) {
+	tc, err := ParseTimeControl("40/5400+30:1800+30")
+	if err != nil {
+		t.Fatalf("unexpected error parsing time control: %s", err)
+	}
+	c, src := newTestClock(t, tc)
+
+	var moves []time.Duration
+	for i := 0; i < 80; i++ {
+		moves = append(moves, time.Minute)
+	}
+	play(t, c, src, moves...)
+
+	// 90 minutes - 40 moves * (60s - 30s increment) + 30 minutes
+	expected := 90*time.Minute - 40*30*time.Second + 30*time.Minute
+	expectRemaining(t, c, chess.WHITE, expected)
+	expectRemaining(t, c, chess.BLACK, expected)
+	if mtg := c.MovesToGo(chess.WHITE); mtg != 0 {
+		t.Errorf("expected no moves to go in the final period, actual: %d", mtg)
+	}
+}
+
+func TestRepeatingPeriod(t *testing.T) {
+	tc, err := ParseTimeControl("2/60")
+	if err != nil {
+		t.Fatalf("unexpected error parsing time control: %s", err)
+	}
+	c, src := newTestClock(t, tc)
+	play(t, c, src, 10*time.Second, 10*time.Second)
+	if mtg := c.MovesToGo(chess.WHITE); mtg != 1 {
+		t.Errorf("expected 1 move to go, actual: %d", mtg)
+	}
+	play(t, c, src, 10*time.Second, 10*time.Second)
+	expectRemaining(t, c, chess.WHITE, 100*time.Second)
+	if mtg := c.MovesToGo(chess.WHITE); mtg != 2 {
+		t.Errorf("expected 2 moves to go, actual: %d", mtg)
+	}
+}
+
+func TestPause(t *testing.T) {
+	c, src := newTestClock(t, SuddenDeathControl(time.Minute))
+	src.Advance(10 * time.Second)
+	c.Pause()
+	src.Advance(time.Hour)
+	expectRemaining(t, c, chess.WHITE, 50*time.Second)
+	if err := c.Press(); err == nil {
+		t.Errorf("pressing a paused clock should error")
+	}
+	if err := c.Start(); err != nil {
+		t.Errorf("unexpected error resuming clock: %s", err)
+	}
+	play(t, c, src, 5*time.Second)
+	expectRemaining(t, c, chess.WHITE, 45*time.Second)
+}
+
+func TestSwitch(t *testing.T) {
+	src := NewFakeSource(epoch)
+	c, _ := NewClock(FischerControl(time.Minute, 2*time.Second), src)
+	c.Switch()
+	c.Start()
+	if c.Turn() != chess.BLACK {
+		t.Fatalf("expected black's clock to run first after switching")
+	}
+	play(t, c, src, 10*time.Second)
+	expectRemaining(t, c, chess.BLACK, 52*time.Second)
+
+	// Taking black's move back gives white's move time back and black no increment
+	src.Advance(5 * time.Second)
+	if err := c.Switch(); err != nil {
+		t.Fatalf("unexpected error switching: %s", err)
+	}
+	expectRemaining(t, c, chess.WHITE, time.Minute)
+	expectRemaining(t, c, chess.BLACK, 52*time.Second)
+	if c.Turn() != chess.BLACK || c.Moves(chess.BLACK) != 1 || c.Moves(chess.WHITE) != 0 {
+		t.Errorf("switching shouldn't count a move")
+	}
+	src.Advance(2 * time.Second)
+	expectRemaining(t, c, chess.BLACK, 50*time.Second)
+}
+
+func TestParseTimeControl(t *testing.T) {
+	for _, s := range []string{"300", "180+2", "40/5400+30:1800+30", "*60", "40/7200:3600"} {
+		tc, err := ParseTimeControl(s)
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %s", s, err)
+		} else if tc.String() != s {
+			t.Errorf("expected %q, actual: %q", s, tc.String())
+		}
+	}
+
+	for _, s := range []string{"", "-", "abc", "0", "40/", "x/300", "300:60+1", "*60:60"} {
+		if _, err := ParseTimeControl(s); err == nil {
+			t.Errorf("parsing %q should have failed", s)
+		}
+	}
+
+	tc, _ := ParseTimeControl("900+5", Bronstein)
+	if tc.Mode != Bronstein || tc.Periods[0].Bonus != 5*time.Second {
+		t.Errorf("expected bronstein 5 second delay, actual: %s %s", tc.Mode, tc.Periods[0].Bonus)
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	a := Allocator{MovesToGo: 30, MaxFraction: 0.5, Stretch: 3}
+
+	b := a.Allocate(30*time.Second, 0, 0)
+	if b.Optimum != time.Second || b.Maximum != 3*time.Second {
+		t.Errorf("unexpected budget for sudden death: %+v", b)
+	}
+
+	b = a.Allocate(30*time.Second, 2*time.Second, 0)
+	if b.Optimum != 2500*time.Millisecond {
+		t.Errorf("increment should extend the optimum, actual: %+v", b)
+	}
+
+	b = a.Allocate(10*time.Second, 0, 2)
+	if b.Optimum != 5*time.Second || b.Maximum != 5*time.Second {
+		t.Errorf("budget should be capped by max fraction, actual: %+v", b)
+	}
+
+	b = a.Allocate(10*time.Second, 0, 1)
+	if b.Optimum != 9*time.Second || b.Maximum != 9*time.Second {
+		t.Errorf("last move before time control may use all but a tenth of the time, actual: %+v", b)
+	}
+	b = DefaultAllocator.Allocate(time.Second, 0, 1)
+	if b.Maximum != 855*time.Millisecond {
+		t.Errorf("last move should keep back the overhead and a tenth of the time, actual: %+v", b)
+	}
+
+	if b = DefaultAllocator.Allocate(0, time.Second, 0); b.Optimum != 0 || b.Maximum != 0 {
+		t.Errorf("no time left should give an empty budget, actual: %+v", b)
+	}
+
+	c, _ := newTestClock(t, ClassicalControl())
+	b = c.Allocate(a, chess.WHITE)
+	expected := 90*time.Minute/30 + 30*time.Second*3/4
+	if b.Optimum != expected {
+		t.Errorf("expected clock allocation %s, actual: %s", expected, b.Optimum)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	for d, expected := range map[time.Duration]string{
+		90 * time.Minute:         "1:30:00",
+		5*time.Minute + 3e9:      "5:03",
+		9*time.Second + 450e6:    "0:09.4",
+		-1500 * time.Millisecond: "-0:01.5",
+	} {
+		if actual := Format(d); actual != expected {
+			t.Errorf("expected %q, actual: %q", expected, actual)
+		}
+	}
+}
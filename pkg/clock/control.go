@@ -0,0 +1,198 @@
+package clock
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Mode is an enum for the way a clock charges a player for the time spent on a move
+type Mode uint8
+
+// SuddenDeath charges the full time spent on every move
+// Fischer adds the period increment after every completed move
+// Bronstein adds back the time spent on a move up to the period delay
+// SimpleDelay (US delay) waits for the period delay before starting to count down
+// Hourglass charges the time spent on a move and gives it to the opponent
+const (
+	SuddenDeath Mode = iota
+	Fischer
+	Bronstein
+	SimpleDelay
+	Hourglass
+)
+
+// ModeNames maps the mode to the descriptive name
+var ModeNames = map[Mode]string{
+	SuddenDeath: "sudden death",
+	Fischer:     "fischer",
+	Bronstein:   "bronstein",
+	SimpleDelay: "simple delay",
+	Hourglass:   "hourglass",
+}
+
+func (m Mode) String() string {
+	if name, ok := ModeNames[m]; ok {
+		return name
+	}
+	return ""
+}
+
+// Period is a single stage of a time control. Moves is the number of moves that must
+// be completed in the period before the next one starts (0 means the rest of the game),
+// Time is added to the clock when the period starts and Bonus is the increment or delay
+// applied to every move made during the period, depending on the Mode.
+type Period struct {
+	Moves int
+	Time  time.Duration
+	Bonus time.Duration
+}
+
+// TimeControl groups the periods of a game and the mode used to charge each move
+type TimeControl struct {
+	Mode    Mode
+	Periods []Period
+}
+
+// SuddenDeathControl is a single period time control with no increment or delay
+func SuddenDeathControl(base time.Duration) TimeControl {
+	return TimeControl{SuddenDeath, []Period{{0, base, 0}}}
+}
+
+// FischerControl is a single period time control with an increment after every move
+func FischerControl(base, increment time.Duration) TimeControl {
+	return TimeControl{Fischer, []Period{{0, base, increment}}}
+}
+
+// BronsteinControl is a single period time control with a Bronstein delay
+func BronsteinControl(base, delay time.Duration) TimeControl {
+	return TimeControl{Bronstein, []Period{{0, base, delay}}}
+}
+
+// SimpleDelayControl is a single period time control with a simple (US) delay
+func SimpleDelayControl(base, delay time.Duration) TimeControl {
+	return TimeControl{SimpleDelay, []Period{{0, base, delay}}}
+}
+
+// HourglassControl is a sandclock time control where time spent by one player is given to the other
+func HourglassControl(base time.Duration) TimeControl {
+	return TimeControl{Hourglass, []Period{{0, base, 0}}}
+}
+
+// ClassicalControl is the FIDE classical time control: 90 minutes for 40 moves followed by
+// 30 minutes for the rest of the game with a 30 second increment from move one
+func ClassicalControl() TimeControl {
+	return TimeControl{Fischer, []Period{
+		{40, 90 * time.Minute, 30 * time.Second},
+		{0, 30 * time.Minute, 30 * time.Second},
+	}}
+}
+
+// Validate checks that the time control can be used to run a clock
+func (tc TimeControl) Validate() error {
+	if _, ok := ModeNames[tc.Mode]; !ok {
+		return fmt.Errorf("unknown clock mode: %d", tc.Mode)
+	}
+	if len(tc.Periods) == 0 {
+		return fmt.Errorf("time control must have at least one period")
+	}
+	for i, p := range tc.Periods {
+		if i == 0 && p.Time <= 0 {
+			return fmt.Errorf("first period must have a positive amount of time")
+		}
+		if p.Moves < 0 || p.Time < 0 || p.Bonus < 0 {
+			return fmt.Errorf("period %d must not have negative moves, time or bonus", i+1)
+		}
+		if p.Moves == 0 && i != len(tc.Periods)-1 {
+			return fmt.Errorf("period %d covers the rest of the game but is followed by more periods", i+1)
+		}
+	}
+	if tc.Mode == Hourglass && len(tc.Periods) > 1 {
+		return fmt.Errorf("hourglass time control must have exactly one period")
+	}
+	return nil
+}
+
+// String formats the time control using the PGN TimeControl tag syntax (in seconds),
+// e.g. "40/5400+30:1800+30", "300+3" or "*60" for hourglass
+func (tc TimeControl) String() string {
+	seconds := func(d time.Duration) string {
+		return strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+	}
+	var fields []string
+	for _, p := range tc.Periods {
+		field := ""
+		if tc.Mode == Hourglass {
+			field = "*"
+		} else if p.Moves > 0 {
+			field = fmt.Sprintf("%d/", p.Moves)
+		}
+		field += seconds(p.Time)
+		if p.Bonus > 0 {
+			field += "+" + seconds(p.Bonus)
+		}
+		fields = append(fields, field)
+	}
+	return strings.Join(fields, ":")
+}
+
+// ParseTimeControl parses the PGN TimeControl tag syntax where all times are given in
+// seconds: "300" (sudden death), "180+2" (increment), "40/5400+30:1800+30" (multiple
+// periods) and "*60" (hourglass). Any bonus is treated as an increment unless a
+// different mode is requested.
+func ParseTimeControl(s string, mode ...Mode) (TimeControl, error) {
+	tc := TimeControl{Mode: SuddenDeath}
+	s = strings.TrimSpace(s)
+	if s == "" || s == "-" || s == "?" {
+		return tc, fmt.Errorf("time control %q has no time limit", s)
+	}
+
+	parseSeconds := func(v string) (time.Duration, error) {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f < 0 {
+			return 0, fmt.Errorf("invalid number of seconds %q in time control %q", v, s)
+		}
+		return time.Duration(f * float64(time.Second)), nil
+	}
+
+	hasBonus := false
+	for _, field := range strings.Split(s, ":") {
+		var p Period
+		if strings.HasPrefix(field, "*") {
+			tc.Mode = Hourglass
+			field = field[1:]
+		}
+		if i := strings.Index(field, "/"); i >= 0 {
+			moves, err := strconv.Atoi(field[:i])
+			if err != nil || moves <= 0 {
+				return tc, fmt.Errorf("invalid move count %q in time control %q", field[:i], s)
+			}
+			p.Moves = moves
+			field = field[i+1:]
+		}
+		if i := strings.Index(field, "+"); i >= 0 {
+			bonus, err := parseSeconds(field[i+1:])
+			if err != nil {
+				return tc, err
+			}
+			p.Bonus = bonus
+			hasBonus = hasBonus || bonus > 0
+			field = field[:i]
+		}
+		t, err := parseSeconds(field)
+		if err != nil {
+			return tc, err
+		}
+		p.Time = t
+		tc.Periods = append(tc.Periods, p)
+	}
+
+	if len(mode) > 0 {
+		tc.Mode = mode[0]
+	} else if hasBonus && tc.Mode != Hourglass {
+		tc.Mode = Fischer
+	}
+
+	return tc, tc.Validate()
+}
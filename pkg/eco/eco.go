@@ -0,0 +1,139 @@
+// Package eco classifies chess openings by their Encyclopaedia of Chess Openings code and
+// name, matching positions against an embedded database.
+//
+// Openings are found by the Zobrist hash of the positions they reach rather than by their
+// moves, so a game reaching an opening by a different move order is still classified.
+package eco
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// Opening is an opening in the database
+type Opening struct {
+	ECO       string // e.g. "B90"
+	Name      string // e.g. "Sicilian Defense: Najdorf Variation"
+	Moves     string // Moves reaching the opening from the starting position, in SAN
+	Ply       int    // Number of moves
+	hash      uint64
+	separator int // Index of the colon between the opening and variation, or -1
+}
+
+// Family returns the opening's name without the variation, e.g. "Sicilian Defense"
+func (o Opening) Family() string {
+	if o.separator < 0 {
+		return o.Name
+	}
+	return o.Name[:o.separator]
+}
+
+// Variation returns the opening's variation, e.g. "Najdorf Variation", or "" if it's
+// the main line
+func (o Opening) Variation() string {
+	if o.separator < 0 {
+		return ""
+	}
+	return strings.TrimSpace(o.Name[o.separator+1:])
+}
+
+var (
+	loadOnce  sync.Once
+	all       []Opening
+	positions map[uint64]int // Index of the opening reaching each position
+)
+
+// load parses the embedded database
+func load() {
+	positions = map[uint64]int{}
+	for n, line := range strings.Split(strings.TrimSpace(openings), "\n") {
+		o, err := parseOpening(line)
+		if err != nil {
+			panic(fmt.Sprintf("eco: line %d of the database: %s", n+1, err))
+		}
+		// A position named twice keeps its first name
+		if _, ok := positions[o.hash]; !ok {
+			positions[o.hash] = len(all)
+		}
+		all = append(all, o)
+	}
+}
+
+// parseOpening parses a line of the database
+func parseOpening(line string) (Opening, error) {
+	fields := strings.Split(line, "\t")
+	if len(fields) != 3 {
+		return Opening{}, fmt.Errorf("expected the code, name and moves, got %q", line)
+	}
+	o := Opening{ECO: fields[0], Name: fields[1], Moves: fields[2], separator: strings.Index(fields[1], ":")}
+	board, _ := chess.NewBoard()
+	for _, san := range strings.Fields(o.Moves) {
+		m, err := board.ParseMove(san)
+		if err != nil {
+			return Opening{}, fmt.Errorf("%s: %s", o.Name, err)
+		}
+		board.MakeMove(m)
+		o.Ply++
+	}
+	o.hash = board.Hash()
+	return o, nil
+}
+
+// Openings returns all of the openings in the database in ECO order
+func Openings() []Opening {
+	loadOnce.Do(load)
+	return append([]Opening(nil), all...)
+}
+
+// Lookup returns the opening reaching a position
+func Lookup(board *chess.Board) (Opening, bool) {
+	loadOnce.Do(load)
+	if board.Chess960 || board.Variant != nil {
+		return Opening{}, false
+	}
+	i, ok := positions[board.Hash()]
+	if !ok {
+		return Opening{}, false
+	}
+	return all[i], true
+}
+
+// Classify returns the opening of a game: the last position of the database it reached.
+// Games of other variants aren't classified.
+func Classify(game *chess.Game) (Opening, bool) {
+	board, err := game.StartingPosition()
+	if err != nil {
+		return Opening{}, false
+	}
+	opening, found := Lookup(board)
+	for _, m := range game.Moves {
+		board.MakeMove(m)
+		if o, ok := Lookup(board); ok {
+			opening, found = o, true
+		}
+	}
+	return opening, found
+}
+
+// Tag sets a game's ECO, Opening and Variation tags from its classification, removing
+// the Variation tag for a main line. Games that can't be classified are left alone.
+func Tag(game *chess.Game) bool {
+	o, ok := Classify(game)
+	if !ok {
+		return false
+	}
+	if game.Tags == nil {
+		game.Tags = map[string]string{}
+	}
+	game.Tags["ECO"] = o.ECO
+	game.Tags["Opening"] = o.Family()
+	if v := o.Variation(); v != "" {
+		game.Tags["Variation"] = v
+	} else {
+		delete(game.Tags, "Variation")
+	}
+	return true
+}
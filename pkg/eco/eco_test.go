@@ -0,0 +1,123 @@
+package eco
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+func TestOpenings(t *testing.T) {
+	openings := Openings()
+	if len(openings) < 3000 {
+		t.Errorf("expected at least 3000 openings, got %d", len(openings))
+	}
+	seen := map[uint64]string{}
+	for i, o := range openings {
+		if len(o.ECO) != 3 || o.ECO[0] < 'A' || o.ECO[0] > 'E' {
+			t.Errorf("%s: invalid code %s", o.Name, o.ECO)
+		}
+		if i > 0 && o.ECO < openings[i-1].ECO {
+			t.Errorf("%s: %s is out of order", o.Name, o.ECO)
+		}
+		if name, ok := seen[o.hash]; ok {
+			t.Errorf("%s reaches the same position as %s", o.Name, name)
+		}
+		seen[o.hash] = o.Name
+	}
+
+	if _, err := parseOpening("C20\tKing's Pawn Game\te4 e4"); err == nil {
+		t.Error("expected an error parsing an illegal line")
+	}
+}
+
+func TestLookup(t *testing.T) {
+	board, _ := chess.ParseFEN("rnbqkb1r/1p2pppp/p2p1n2/8/3NP3/2N5/PPP2PPP/R1BQKB1R w KQkq - 0 6")
+	o, ok := Lookup(board)
+	if !ok || o.ECO != "B90" || o.Family() != "Sicilian Defense" || o.Variation() != "Najdorf Variation" || o.Ply != 10 {
+		t.Errorf("expected the Najdorf, got %+v", o)
+	}
+	board, _ = chess.ParseFEN(chess.StartFEN)
+	if o, ok := Lookup(board); ok {
+		t.Errorf("expected the starting position not to be an opening, got %+v", o)
+	}
+
+	board, _ = chess.ParseFEN("rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq - 0 2")
+	if o, ok := Lookup(board); !ok || o.Name != "King's Pawn Game" || o.Variation() != "" {
+		t.Errorf("expected the King's Pawn Game, got %+v", o)
+	}
+	board.Chess960 = true
+	if _, ok := Lookup(board); ok {
+		t.Error("expected Chess960 positions not to be classified")
+	}
+}
+
+func TestDeepLines(t *testing.T) {
+	tests := []struct {
+		moves, eco, name string
+	}{
+		{"e4 e5 Nf3 Nc6 Bb5 a6 Ba4 Nf6 O-O Be7 Re1 b5 Bb3 O-O c3 d5 exd5 Nxd5 Nxe5 Nxe5 Rxe5 c6 d4 Bd6 Re1 Qh4 g3 Qh3 Be3 Bg4 Qd3 Rae8 Nd2 Re6 a4 Qh5",
+			"C89", "Ruy Lopez: Marshall Attack, Main Line, Spassky Variation"},
+		{"d4 d5 c4 c6 Nc3 Nf6 Nf3 e6 e3 Nbd7 Bd3 dxc4 Bxc4 b5 Bd3 a6 e4 c5 e5 cxd4 Nxb5 Nxe5 Nxe5 axb5 O-O Qd5 Qe2 Ba6 Bg5",
+			"D49", "Semi-Slav Defense: Meran Variation, Rellstab Attack"},
+		{"d4 Nf6 c4 g6 Nc3 Bg7 e4 d6 Nf3 O-O Be2 e5 O-O Nc6 d5 Ne7 Ne1 Nd7 Be3 f5 f3 f4 Bf2 g5 Rc1 Ng6 c5",
+			"E98", "King's Indian Defense: Orthodox Variation, Classical System, Kozul Gambit"},
+		// Transposed from 1. d4 d5 2. c4 e6, and followed past the end of the line
+		{"d4 Nf6 c4 e6 Nc3 d5 Bg5 Be7 e3 O-O Nf3 Nbd7 Rc1 c6 Bd3 dxc4 Bxc4 Nd5 Bxe7 Qxe7 O-O Nxc3 Rxc3 e5 dxe5 Nxe5 Nxe5 Qxe5 f4",
+			"D69", "Queen's Gambit Declined: Orthodox Defense, Classical Variation"},
+		{"e4 c6 d4 d5 Nc3 dxe4 Nxe4 Bf5 Ng3 Bg6 h4 h6 Nf3 Nd7 h5 Bh7 Bd3 Bxd3 Qxd3",
+			"B19", "Caro-Kann Defense: Classical Variation, Spassky Variation"},
+	}
+	for _, test := range tests {
+		game, _ := chess.NewGame()
+		for _, san := range strings.Fields(test.moves) {
+			if _, err := game.Play(san); err != nil {
+				t.Fatalf("%s: %s", test.name, err)
+			}
+		}
+		if o, ok := Classify(game); !ok || o.ECO != test.eco || o.Name != test.name {
+			t.Errorf("expected %s %s, got %+v", test.eco, test.name, o)
+		}
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		pgn, eco, opening, variation string
+	}{
+		{"1. e4 e5 2. Nf3 Nc6 3. Bb5 a6 4. Ba4 Nf6 5. O-O Be7 6. Re1 b5 7. Bb3 O-O 8. c3 d5 9. exd5 Nxd5 *", "C89", "Ruy Lopez", "Marshall Attack"},
+		// The Queen's Gambit Declined reached from the English
+		{"1. c4 e6 2. Nc3 d5 3. d4 Nf6 4. Bg5 Be7 *", "D53", "Queen's Gambit Declined", ""},
+		// The last opening reached before leaving the database
+		{"1. d4 Nf6 2. Nf3 e6 3. c4 b6 4. Nc3 Bb7 5. Bg5 h6 6. Bh4 Be7 *", "E12", "Queen's Indian Defense", "Kasparov Variation"},
+		{"1. e4 c5 2. Nf3 d6 3. d4 cxd4 4. Nxd4 Nf6 5. Nc3 g6 6. Be3 Bg7 7. f3 O-O 8. Qd2 Nc6 *", "B76", "Sicilian Defense", "Dragon Variation, Yugoslav Attack"},
+		{"*", "", "", ""},
+	}
+	for _, test := range tests {
+		games, err := chess.ParsePGN(test.pgn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		game := games[0]
+		game.Tags["Variation"] = "Unknown"
+		tagged := Tag(game)
+		if tagged != (test.eco != "") {
+			t.Errorf("%s: expected to be classified %t", test.pgn, !tagged)
+			continue
+		}
+		if !tagged {
+			if game.Tags["Variation"] != "Unknown" {
+				t.Errorf("%s: expected the tags to be left alone", test.pgn)
+			}
+			continue
+		}
+		if eco, opening, variation := game.Tags["ECO"], game.Tags["Opening"], game.Tags["Variation"]; eco != test.eco || opening != test.opening || variation != test.variation {
+			t.Errorf("%s: expected %s %s: %s, got %s %s: %s", test.pgn, test.eco, test.opening, test.variation, eco, opening, variation)
+		}
+	}
+
+	games, _ := chess.ParsePGN("[Variant \"Chess960\"]\n[FEN \"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1\"]\n\n1. e4 e5 *")
+	if o, ok := Classify(games[0]); ok {
+		t.Errorf("expected a Chess960 game not to be classified, got %+v", o)
+	}
+}
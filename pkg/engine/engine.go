@@ -0,0 +1,334 @@
+// Package engine searches chess positions for the best move to play.
+//
+// The search is a fail-soft alpha-beta negamax with iterative deepening, a quiescence
+// search of captures and a transposition table for move ordering. Positions are
+// evaluated by material and piece-square tables. It plays any variant supported by
+// chess.Board, though it has no knowledge of their strategy.
+package engine
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// MateScore is the score of checkmating on the next move. Mates further away score one
+// less for each ply.
+const MateScore = 100000
+
+// maxPly is the deepest the search goes, including the quiescence search
+const maxPly = 64
+
+// MaxLevel is the strongest level an engine can play at
+const MaxLevel = 8
+
+// ErrNoMoves is returned when searching a position where the game is over
+var ErrNoMoves = errors.New("no legal moves")
+
+// Limits bound a search. The search stops at whichever limit is reached first and, if
+// none are given, when the context is done.
+type Limits struct {
+	Depth    int           // Maximum depth in plies
+	Nodes    int64         // Maximum number of positions searched
+	MoveTime time.Duration // Maximum time to search for
+}
+
+// Info reports the progress of a search after each iteration
+type Info struct {
+	Depth int           // Depth searched in plies
+	Score int           // Centipawns from the point of view of the side to move
+	Mate  int           // Moves until mate if one has been found, negative if being mated
+	Nodes int64         // Positions searched so far
+	Time  time.Duration // Time searched so far
+	PV    []chess.Move  // Principal variation, the best line found
+}
+
+// Result is the outcome of a search: the move to play and the last completed iteration
+type Result struct {
+	Move chess.Move
+	Info
+}
+
+// Engine searches positions. An engine isn't safe for concurrent use, but separate
+// engines may search at the same time.
+type Engine struct {
+	// Noise is the most centipawns added at random to the score of each move at the root
+	// of the search, so weaker levels play varied and imperfect moves
+	Noise int
+	// OnInfo is called after each iteration of the search, if not nil
+	OnInfo func(Info)
+
+	rand      *rand.Rand
+	table     map[uint64]chess.Move
+	pv        [maxPly][]chess.Move
+	nodes     int64
+	start     time.Time
+	limits    Limits
+	ctx       context.Context
+	stopped   bool
+	rootNoise map[chess.Move]int
+}
+
+// New returns an engine searching at full strength
+func New() *Engine {
+	return &Engine{rand: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+// Level returns an engine and limits playing at a strength from 1, the weakest, to
+// MaxLevel. Weaker levels search less deeply and make more mistakes.
+func Level(level int) (*Engine, Limits) {
+	if level < 1 {
+		level = 1
+	} else if level > MaxLevel {
+		level = MaxLevel
+	}
+	e := New()
+	e.Noise = []int{300, 200, 120, 80, 40, 20, 0, 0}[level-1]
+	return e, Limits{Depth: []int{1, 1, 2, 2, 3, 3, 4, 5}[level-1], MoveTime: 5 * time.Second}
+}
+
+// Seed makes the engine's random choices repeatable
+func (e *Engine) Seed(seed int64) {
+	e.rand = rand.New(rand.NewSource(seed))
+}
+
+// Search returns the best move found in the position within the limits. The board is
+// left as it was. ErrNoMoves is returned if the game is over.
+func (e *Engine) Search(ctx context.Context, board *chess.Board, limits Limits) (Result, error) {
+	board = board.Copy()
+	moves := board.LegalMoves()
+	if len(moves) == 0 || (board.Variant != nil && board.Result() != chess.InProgress) {
+		return Result{}, ErrNoMoves
+	}
+
+	if e.rand == nil {
+		e.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	e.start, e.limits, e.ctx = time.Now(), limits, ctx
+	e.nodes, e.stopped = 0, false
+	e.table = map[uint64]chess.Move{}
+	e.rootNoise = map[chess.Move]int{}
+	for _, m := range moves {
+		if e.Noise > 0 {
+			e.rootNoise[m] = e.rand.Intn(2*e.Noise+1) - e.Noise
+		}
+	}
+
+	result := Result{Move: moves[0]}
+	maxDepth := limits.Depth
+	if maxDepth <= 0 || maxDepth > maxPly/2 {
+		maxDepth = maxPly / 2
+	}
+	for depth := 1; depth <= maxDepth; depth++ {
+		score := e.negamax(board, depth, 0, -MateScore-1, MateScore+1)
+		if e.stopped && depth > 1 {
+			break
+		}
+		pv := append([]chess.Move(nil), e.pv[0]...)
+		if len(pv) == 0 {
+			break
+		}
+		result = Result{Move: pv[0], Info: Info{
+			Depth: depth,
+			Score: score,
+			Mate:  mateIn(score),
+			Nodes: e.nodes,
+			Time:  time.Since(e.start),
+			PV:    pv,
+		}}
+		if e.OnInfo != nil {
+			e.OnInfo(result.Info)
+		}
+		if e.stopped || result.Mate != 0 && depth >= 2*abs(result.Mate) {
+			break
+		}
+	}
+	return result, nil
+}
+
+// mateIn returns the moves until mate for a mate score, or 0 for other scores
+func mateIn(score int) int {
+	switch {
+	case score > MateScore-maxPly:
+		return (MateScore - score + 1) / 2
+	case score < -MateScore+maxPly:
+		return -(MateScore + score + 1) / 2
+	}
+	return 0
+}
+
+// stop checks whether the search has reached its limits, checking the clock and the
+// context every few thousand positions
+func (e *Engine) stop() bool {
+	if e.stopped {
+		return true
+	}
+	if e.limits.Nodes > 0 && e.nodes >= e.limits.Nodes {
+		e.stopped = true
+	} else if e.nodes%2048 == 0 {
+		if e.limits.MoveTime > 0 && time.Since(e.start) >= e.limits.MoveTime {
+			e.stopped = true
+		} else if e.ctx.Err() != nil {
+			e.stopped = true
+		}
+	}
+	return e.stopped
+}
+
+// negamax searches a position to a depth, returning its score from the point of view of
+// the side to move and recording the best line found in e.pv[ply]
+func (e *Engine) negamax(board *chess.Board, depth, ply int, alpha, beta int) int {
+	e.pv[ply] = e.pv[ply][:0]
+	e.nodes++
+	if ply > 0 {
+		if e.stop() {
+			return 0
+		}
+		if score, over := e.outcome(board, ply); over {
+			return score
+		}
+		if board.HalfMoveClock >= 100 || board.Repetitions() > 1 || board.IsInsufficientMaterial() {
+			return 0
+		}
+	}
+	if depth <= 0 || ply >= maxPly-1 {
+		return e.quiesce(board, ply, alpha, beta)
+	}
+
+	moves := board.LegalMoves()
+	if len(moves) == 0 {
+		return e.noMoves(board, ply)
+	}
+	e.order(board, moves)
+
+	best, bestMove := -MateScore-1, moves[0]
+	for _, m := range moves {
+		board.MakeMove(m)
+		score := -e.negamax(board, depth-1, ply+1, -beta, -alpha)
+		board.UnmakeMove()
+		if ply == 0 {
+			score += e.rootNoise[m]
+		}
+		if e.stopped && ply > 0 {
+			return 0
+		}
+		if score > best {
+			best, bestMove = score, m
+			e.pv[ply] = append(append(e.pv[ply][:0], m), e.pv[ply+1]...)
+			if score > alpha {
+				alpha = score
+			}
+		}
+		if alpha >= beta || e.stopped {
+			break
+		}
+	}
+	e.table[board.Hash()] = bestMove
+	return best
+}
+
+// quiesce searches the captures in a position until it's quiet, so positions aren't
+// evaluated in the middle of an exchange
+func (e *Engine) quiesce(board *chess.Board, ply, alpha, beta int) int {
+	e.pv[ply] = e.pv[ply][:0]
+	standPat := Evaluate(board)
+	if standPat >= beta || ply >= maxPly-1 {
+		return standPat
+	}
+	if standPat > alpha {
+		alpha = standPat
+	}
+
+	captures := board.PseudoLegalCaptures()
+	e.order(board, captures)
+	for _, m := range captures {
+		if !board.IsLegal(m) {
+			continue
+		}
+		e.nodes++
+		if e.stop() {
+			return 0
+		}
+		board.MakeMove(m)
+		score := -e.quiesce(board, ply+1, -beta, -alpha)
+		board.UnmakeMove()
+		if score > alpha {
+			alpha = score
+			e.pv[ply] = append(append(e.pv[ply][:0], m), e.pv[ply+1]...)
+			if alpha >= beta {
+				break
+			}
+		}
+	}
+	return alpha
+}
+
+// outcome returns the score of a position where a variant's game has ended, e.g. by a
+// king reaching the hill, and whether it has
+func (e *Engine) outcome(board *chess.Board, ply int) (int, bool) {
+	if board.Variant == nil {
+		return 0, false
+	}
+	switch board.Result() {
+	case chess.InProgress:
+		return 0, false
+	case chess.Draw:
+		return 0, true
+	case chess.WhiteWins:
+		if board.Turn == chess.WHITE {
+			return MateScore - ply, true
+		}
+	case chess.BlackWins:
+		if board.Turn == chess.BLACK {
+			return MateScore - ply, true
+		}
+	}
+	return -MateScore + ply, true
+}
+
+// noMoves returns the score of a position without legal moves: checkmate or stalemate
+// in standard chess, or the variant's outcome
+func (e *Engine) noMoves(board *chess.Board, ply int) int {
+	if score, over := e.outcome(board, ply); over {
+		return score
+	}
+	if board.InCheck() {
+		return -MateScore + ply
+	}
+	return 0
+}
+
+// order sorts moves so the best are searched first: the move found best in an earlier
+// search of the position, then captures of the most valuable pieces by the least
+// valuable attackers, then promotions
+func (e *Engine) order(board *chess.Board, moves []chess.Move) {
+	best, hasBest := e.table[board.Hash()]
+	priority := func(m chess.Move) int {
+		if hasBest && m == best {
+			return 1 << 20
+		}
+		p := 0
+		if m.IsCapture() {
+			p += 10*value(board.Pieces[m.Captured]) - value(board.Pieces[m.Piece]) + 10000
+		}
+		if m.IsPromotion() {
+			p += value(board.Pieces[chess.PieceIndex(board.Turn, m.Promotion)])
+		}
+		return p
+	}
+	sort.SliceStable(moves, func(i, j int) bool {
+		return priority(moves[i]) > priority(moves[j])
+	})
+}
+
+// abs returns the absolute value of an integer
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,131 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+func TestEvaluate(t *testing.T) {
+	board, _ := chess.NewBoard()
+	if score := Evaluate(board); score != 0 {
+		t.Errorf("expected the starting position to be level, actual: %d", score)
+	}
+	board, _ = chess.ParseFEN("rnb1kbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1")
+	white := Evaluate(board)
+	board.Turn = chess.BLACK
+	if white < 800 || Evaluate(board) != -white {
+		t.Errorf("expected white to be a queen up, actual: %d and %d", white, Evaluate(board))
+	}
+}
+
+func TestSearch(t *testing.T) {
+	cases := []struct {
+		FEN   string
+		Depth int
+		Move  string
+		Mate  int
+	}{
+		{"6k1/5ppp/8/8/8/8/8/R5K1 w - - 0 1", 2, "a1a8", 1},
+		{"rnbqkbnr/pppp1ppp/8/4p3/6P1/5P2/PPPPP2P/RNBQKBNR b KQkq - 0 2", 2, "d8h4", 1},
+		// Win the queen rather than take the pawn defended by it
+		{"4k3/8/8/3q4/8/4p3/3N4/4K3 w - - 0 1", 4, "d2f3", 0},
+		{"r1b1k2r/ppppnppp/2n2q2/2b5/3NP3/2P1B3/PP3PPP/RN1QKB1R w KQkq - 0 1", 3, "", 0},
+		// Mate in two by deflecting the defender of the back rank
+		{"r5k1/5ppp/8/8/8/8/4RPPP/4R1K1 w - - 0 1", 4, "e2e8", 2},
+	}
+	for _, c := range cases {
+		board, _ := chess.ParseFEN(c.FEN)
+		result, err := New().Search(context.Background(), board, Limits{Depth: c.Depth})
+		if err != nil {
+			t.Errorf("unexpected error searching %s: %s", c.FEN, err)
+			continue
+		}
+		if c.Move != "" && result.Move.UCI() != c.Move {
+			t.Errorf("expected %s in %s, actual: %s %+v", c.Move, c.FEN, result.Move.UCI(), result.Info)
+		}
+		if result.Mate != c.Mate {
+			t.Errorf("expected mate in %d in %s, actual: %d", c.Mate, c.FEN, result.Mate)
+		}
+		if board.FEN() != c.FEN {
+			t.Errorf("searching should leave the board unchanged, actual: %s", board.FEN())
+		}
+		if !board.IsLegal(result.Move) || len(result.PV) == 0 || result.PV[0] != result.Move {
+			t.Errorf("expected a legal move starting the principal variation, actual: %v %v", result.Move, result.PV)
+		}
+	}
+}
+
+func TestSearchLimits(t *testing.T) {
+	board, _ := chess.NewBoard()
+	e := New()
+	var depths []int
+	e.OnInfo = func(info Info) { depths = append(depths, info.Depth) }
+	result, err := e.Search(context.Background(), board, Limits{Depth: 3})
+	if err != nil || result.Depth != 3 || len(depths) != 3 || depths[2] != 3 {
+		t.Errorf("expected an info for each depth up to 3, actual: %v %v", depths, err)
+	}
+
+	result, _ = New().Search(context.Background(), board, Limits{Nodes: 1000})
+	if result.Nodes > 1000 || result.Depth == 0 {
+		t.Errorf("expected a search of at most 1000 nodes, actual: %+v", result.Info)
+	}
+
+	start := time.Now()
+	result, _ = New().Search(context.Background(), board, Limits{MoveTime: 100 * time.Millisecond})
+	if elapsed := time.Since(start); elapsed > time.Second || !board.IsLegal(result.Move) {
+		t.Errorf("expected a move within the time limit, actual: %s %v", elapsed, result.Move)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if result, err := New().Search(ctx, board, Limits{}); err != nil || !board.IsLegal(result.Move) {
+		t.Errorf("expected a move from a cancelled search, actual: %v %v", result.Move, err)
+	}
+
+	board, _ = chess.ParseFEN("rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3")
+	if _, err := New().Search(context.Background(), board, Limits{Depth: 1}); err != ErrNoMoves {
+		t.Errorf("expected no moves after checkmate, actual: %v", err)
+	}
+}
+
+func TestLevel(t *testing.T) {
+	board, _ := chess.NewBoard()
+	weak, limits := Level(1)
+	if weak.Noise == 0 || limits.Depth != 1 {
+		t.Errorf("expected the weakest level to search shallowly with noise, actual: %d %+v", weak.Noise, limits)
+	}
+	strong, limits := Level(MaxLevel + 1)
+	if strong.Noise != 0 || limits.Depth != 5 {
+		t.Errorf("expected levels above the strongest to be the strongest, actual: %d %+v", strong.Noise, limits)
+	}
+
+	// The weakest level varies its moves, but repeatably with a seed
+	moves := map[string]bool{}
+	for seed := int64(0); seed < 10; seed++ {
+		weak.Seed(seed)
+		result, _ := weak.Search(context.Background(), board, Limits{Depth: 1})
+		weak.Seed(seed)
+		again, _ := weak.Search(context.Background(), board, Limits{Depth: 1})
+		if result.Move != again.Move {
+			t.Errorf("expected the same move with the same seed, actual: %v and %v", result.Move, again.Move)
+		}
+		moves[result.Move.UCI()] = true
+	}
+	if len(moves) < 2 {
+		t.Errorf("expected the weakest level to vary its moves, actual: %v", moves)
+	}
+}
+
+func TestSearchVariant(t *testing.T) {
+	board, _ := chess.NewBoardWith(chess.WithVariant(chess.KingOfTheHill), chess.WithFEN("4k3/8/8/8/8/4K3/8/8 w - - 0 1"))
+	result, err := New().Search(context.Background(), board, Limits{Depth: 2})
+	if err != nil || result.Mate != 1 {
+		t.Errorf("expected to win by reaching the hill, actual: %v %+v", err, result.Info)
+	}
+	if board.MakeMove(result.Move); board.Result() != chess.WhiteWins {
+		t.Errorf("expected the move to win, actual: %v", result.Move)
+	}
+}
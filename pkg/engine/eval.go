@@ -0,0 +1,139 @@
+package engine
+
+import (
+	"math/bits"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// pieceValues are the values of the standard pieces in centipawns. Fairy pieces are
+// valued at their Piece.Value in pawns.
+var pieceValues = map[chess.Symbol]int{
+	chess.PAWN:   100,
+	chess.KNIGHT: 320,
+	chess.BISHOP: 330,
+	chess.ROOK:   500,
+	chess.QUEEN:  900,
+	chess.KING:   0,
+}
+
+// pieceSquares are bonuses for each piece standing on a square, from white's point of
+// view with a1 first, from Tomasz Michniewski's simplified evaluation function. See
+// <https://www.chessprogramming.org/Simplified_Evaluation_Function>.
+var pieceSquares = map[chess.Symbol][64]int{
+	chess.PAWN: {
+		0, 0, 0, 0, 0, 0, 0, 0,
+		5, 10, 10, -20, -20, 10, 10, 5,
+		5, -5, -10, 0, 0, -10, -5, 5,
+		0, 0, 0, 20, 20, 0, 0, 0,
+		5, 5, 10, 25, 25, 10, 5, 5,
+		10, 10, 20, 30, 30, 20, 10, 10,
+		50, 50, 50, 50, 50, 50, 50, 50,
+		0, 0, 0, 0, 0, 0, 0, 0,
+	},
+	chess.KNIGHT: {
+		-50, -40, -30, -30, -30, -30, -40, -50,
+		-40, -20, 0, 5, 5, 0, -20, -40,
+		-30, 5, 10, 15, 15, 10, 5, -30,
+		-30, 0, 15, 20, 20, 15, 0, -30,
+		-30, 5, 15, 20, 20, 15, 5, -30,
+		-30, 0, 10, 15, 15, 10, 0, -30,
+		-40, -20, 0, 0, 0, 0, -20, -40,
+		-50, -40, -30, -30, -30, -30, -40, -50,
+	},
+	chess.BISHOP: {
+		-20, -10, -10, -10, -10, -10, -10, -20,
+		-10, 5, 0, 0, 0, 0, 5, -10,
+		-10, 10, 10, 10, 10, 10, 10, -10,
+		-10, 0, 10, 10, 10, 10, 0, -10,
+		-10, 5, 5, 10, 10, 5, 5, -10,
+		-10, 0, 5, 10, 10, 5, 0, -10,
+		-10, 0, 0, 0, 0, 0, 0, -10,
+		-20, -10, -10, -10, -10, -10, -10, -20,
+	},
+	chess.ROOK: {
+		0, 0, 0, 5, 5, 0, 0, 0,
+		-5, 0, 0, 0, 0, 0, 0, -5,
+		-5, 0, 0, 0, 0, 0, 0, -5,
+		-5, 0, 0, 0, 0, 0, 0, -5,
+		-5, 0, 0, 0, 0, 0, 0, -5,
+		-5, 0, 0, 0, 0, 0, 0, -5,
+		5, 10, 10, 10, 10, 10, 10, 5,
+		0, 0, 0, 0, 0, 0, 0, 0,
+	},
+	chess.QUEEN: {
+		-20, -10, -10, -5, -5, -10, -10, -20,
+		-10, 0, 5, 0, 0, 0, 0, -10,
+		-10, 5, 5, 5, 5, 5, 0, -10,
+		0, 0, 5, 5, 5, 5, 0, -5,
+		-5, 0, 5, 5, 5, 5, 0, -5,
+		-10, 0, 5, 5, 5, 5, 0, -10,
+		-10, 0, 0, 0, 0, 0, 0, -10,
+		-20, -10, -10, -5, -5, -10, -10, -20,
+	},
+	chess.KING: {
+		20, 30, 10, 0, 0, 10, 30, 20,
+		20, 20, 0, 0, 0, 0, 20, 20,
+		-10, -20, -20, -20, -20, -20, -20, -10,
+		-20, -30, -30, -40, -40, -30, -30, -20,
+		-30, -40, -40, -50, -50, -40, -40, -30,
+		-30, -40, -40, -50, -50, -40, -40, -30,
+		-30, -40, -40, -50, -50, -40, -40, -30,
+		-30, -40, -40, -50, -50, -40, -40, -30,
+	},
+}
+
+// endgameKing replaces the king's squares once the queens are off, drawing it to the
+// center
+var endgameKing = [64]int{
+	-50, -30, -30, -30, -30, -30, -30, -50,
+	-30, -30, 0, 0, 0, 0, -30, -30,
+	-30, -10, 20, 30, 30, 20, -10, -30,
+	-30, -10, 30, 40, 40, 30, -10, -30,
+	-30, -10, 30, 40, 40, 30, -10, -30,
+	-30, -10, 20, 30, 30, 20, -10, -30,
+	-30, -20, -10, 0, 0, -10, -20, -30,
+	-50, -40, -30, -20, -20, -30, -40, -50,
+}
+
+// value returns the value of a piece in centipawns
+func value(p chess.Piece) int {
+	if v, ok := pieceValues[p.Symbol]; ok {
+		return v
+	}
+	return int(p.Value) * 100
+}
+
+// Evaluate returns a static evaluation of the position in centipawns from the point of
+// view of the side to move, counting material and where the pieces stand
+func Evaluate(board *chess.Board) int {
+	endgame := board.Positions[chess.WhiteQueen.Index]|board.Positions[chess.BlackQueen.Index] == 0
+	score := 0
+	for i, positions := range board.Positions {
+		piece := board.Pieces[i]
+		squares, ok := pieceSquares[piece.Symbol]
+		if piece.Symbol == chess.KING && endgame {
+			squares = endgameKing
+		}
+		for bb := uint64(positions); bb != 0; bb &= bb - 1 {
+			sq := bits.TrailingZeros64(bb)
+			s := value(piece)
+			if ok {
+				if piece.Color == chess.WHITE {
+					s += squares[sq]
+				} else {
+					s += squares[sq^56]
+				}
+			}
+			if piece.Color == chess.WHITE {
+				score += s
+			} else {
+				score -= s
+			}
+		}
+	}
+	if board.Turn == chess.BLACK {
+		return -score
+	}
+	return score
+}
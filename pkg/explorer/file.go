@@ -0,0 +1,188 @@
+package explorer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// A tree file starts with a magic string and the tree's settings and counts, followed by
+// each position sorted by hash. Numbers are varints unless noted.
+//
+//	header:   "GCTREE1\n", max ply, games, games updated from a database, positions
+//	position: hash (8 bytes, big-endian), moves
+//	move:     move (2 bytes, big-endian key: the Polyglot encoding or a drop), games,
+//	          white wins, draws, black wins, rating sum, rated games, length and bytes of
+//	          the last date
+
+const magic = "GCTREE1\n"
+
+// WriteTo writes the tree in its file format
+func (t *Tree) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	cw := &countingWriter{w: bw}
+	cw.write([]byte(magic))
+	cw.uvarint(uint64(t.MaxPly))
+	cw.uvarint(uint64(t.games))
+	cw.uvarint(uint64(t.updated))
+	cw.uvarint(uint64(len(t.positions)))
+
+	hashes := make([]uint64, 0, len(t.positions))
+	for hash := range t.positions {
+		hashes = append(hashes, hash)
+	}
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+	var buf [8]byte
+	for _, hash := range hashes {
+		binary.BigEndian.PutUint64(buf[:], hash)
+		cw.write(buf[:])
+
+		moves := t.positions[hash]
+		keys := make([]int, 0, len(moves))
+		for key := range moves {
+			keys = append(keys, int(key))
+		}
+		sort.Ints(keys)
+		cw.uvarint(uint64(len(keys)))
+		for _, key := range keys {
+			s := moves[uint16(key)]
+			binary.BigEndian.PutUint16(buf[:], uint16(key))
+			cw.write(buf[:2])
+			for _, n := range []int64{int64(s.Games), int64(s.WhiteWins), int64(s.Draws), int64(s.BlackWins), s.RatingSum, int64(s.Rated)} {
+				cw.uvarint(uint64(n))
+			}
+			cw.uvarint(uint64(len(s.LastPlayed)))
+			cw.write([]byte(s.LastPlayed))
+		}
+	}
+	if cw.err == nil {
+		cw.err = bw.Flush()
+	}
+	return cw.n, cw.err
+}
+
+// ReadTree reads a tree written by WriteTo
+func ReadTree(r io.Reader) (*Tree, error) {
+	br := &byteReader{r: bufio.NewReader(r)}
+	header := make([]byte, len(magic))
+	if _, err := io.ReadFull(br.r, header); err != nil || string(header) != magic {
+		return nil, fmt.Errorf("explorer: not an opening tree")
+	}
+	t := New()
+	t.MaxPly = int(br.uvarint())
+	t.games = int(br.uvarint())
+	t.updated = int(br.uvarint())
+	positions := br.uvarint()
+	var buf [8]byte
+	for i := uint64(0); i < positions && br.err == nil; i++ {
+		br.read(buf[:])
+		hash := binary.BigEndian.Uint64(buf[:])
+		n := br.uvarint()
+		moves := make(map[uint16]*Stats, int(n%1024))
+		for j := uint64(0); j < n && br.err == nil; j++ {
+			br.read(buf[:2])
+			key := binary.BigEndian.Uint16(buf[:2])
+			s := &Stats{
+				Games:     int(br.uvarint()),
+				WhiteWins: int(br.uvarint()),
+				Draws:     int(br.uvarint()),
+				BlackWins: int(br.uvarint()),
+				RatingSum: int64(br.uvarint()),
+				Rated:     int(br.uvarint()),
+			}
+			length := br.uvarint()
+			if length > 64 {
+				return nil, fmt.Errorf("explorer: invalid date in opening tree")
+			}
+			date := make([]byte, length)
+			br.read(date)
+			s.LastPlayed = string(date)
+			moves[key] = s
+		}
+		t.positions[hash] = moves
+	}
+	if br.err != nil {
+		return nil, fmt.Errorf("explorer: invalid opening tree: %s", br.err)
+	}
+	return t, nil
+}
+
+// Load reads a tree from a file
+func Load(path string) (*Tree, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadTree(f)
+}
+
+// Save writes the tree to a file, replacing it atomically so an interrupted save leaves
+// the previous tree intact
+func (t *Tree) Save(path string) error {
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = t.WriteTo(f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(path+".tmp", path)
+	}
+	if err != nil {
+		os.Remove(path + ".tmp")
+	}
+	return err
+}
+
+// countingWriter writes varints and bytes, counting them and keeping the first error
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (w *countingWriter) write(b []byte) {
+	if w.err == nil {
+		var n int
+		n, w.err = w.w.Write(b)
+		w.n += int64(n)
+	}
+}
+
+func (w *countingWriter) uvarint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	w.write(buf[:binary.PutUvarint(buf[:], v)])
+}
+
+// byteReader reads varints and bytes, keeping the first error
+type byteReader struct {
+	r   *bufio.Reader
+	err error
+}
+
+func (r *byteReader) read(b []byte) {
+	if r.err == nil {
+		_, r.err = io.ReadFull(r.r, b)
+	}
+}
+
+func (r *byteReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	var v uint64
+	v, r.err = binary.ReadUvarint(r.r)
+	if r.err == io.EOF {
+		r.err = io.ErrUnexpectedEOF
+	}
+	return v
+}
@@ -0,0 +1,228 @@
+// Package explorer builds opening trees: for each position reached in a collection of
+// games, the moves played from it with how often they were played, how they scored,
+// the average rating of the players and when they were last played.
+//
+// Positions are keyed by their Zobrist hash, so transpositions share statistics, and
+// moves by their Polyglot encoding, extended for Crazyhouse drops. A tree is kept in
+// memory, saved to a file and can be updated with the games added to a gamedb.DB since
+// it was last updated.
+package explorer
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/gamedb"
+	"github.com/aaronireland/go-chess/pkg/polyglot"
+)
+
+// DefaultMaxPly is the number of plies of each game added to a tree by default
+const DefaultMaxPly = 40
+
+// Stats are the games in which a move was played
+type Stats struct {
+	Games      int
+	WhiteWins  int
+	Draws      int
+	BlackWins  int
+	RatingSum  int64  // Sum of the average rating of the players of each rated game
+	Rated      int    // Games with a rating for either player
+	LastPlayed string // Latest date of the games in PGN format, e.g. "2023.05.21", or ""
+}
+
+// add counts a game
+func (s *Stats) add(result string, rating int, date string) {
+	s.Games++
+	switch result {
+	case chess.WhiteWins:
+		s.WhiteWins++
+	case chess.BlackWins:
+		s.BlackWins++
+	case chess.Draw:
+		s.Draws++
+	}
+	if rating > 0 {
+		s.RatingSum += int64(rating)
+		s.Rated++
+	}
+	if date > s.LastPlayed {
+		s.LastPlayed = date
+	}
+}
+
+// Continuation is a move played from a position and the games it was played in
+type Continuation struct {
+	Move chess.Move
+	SAN  string
+	Stats
+	White         float64 // Percentage of the games white won
+	Draw          float64
+	Black         float64
+	AverageRating int // 0 if no game was rated
+}
+
+// Tree is an opening tree
+type Tree struct {
+	MaxPly int // Plies of each game added, DefaultMaxPly if 0
+
+	positions map[uint64]map[uint16]*Stats
+	games     int // Games added
+	updated   int // Games of a database added by Update
+}
+
+// New returns an empty tree
+func New() *Tree {
+	return &Tree{positions: map[uint64]map[uint16]*Stats{}}
+}
+
+// Games returns the number of games in the tree
+func (t *Tree) Games() int {
+	return t.games
+}
+
+// Add adds the opening of a finished game to the tree. Games in progress are skipped.
+func (t *Tree) Add(game *chess.Game) error {
+	if game.Result != chess.WhiteWins && game.Result != chess.BlackWins && game.Result != chess.Draw {
+		return nil
+	}
+	board, err := game.StartingPosition()
+	if err != nil {
+		return err
+	}
+	if t.positions == nil {
+		t.positions = map[uint64]map[uint16]*Stats{}
+	}
+	maxPly := t.MaxPly
+	if maxPly <= 0 {
+		maxPly = DefaultMaxPly
+	}
+
+	rating := averageRating(game)
+	date := game.Tags["Date"]
+	if strings.HasPrefix(date, "?") {
+		date = ""
+	}
+	for ply, m := range game.Moves {
+		if ply >= maxPly {
+			break
+		}
+		moves := t.positions[board.Hash()]
+		if moves == nil {
+			moves = map[uint16]*Stats{}
+			t.positions[board.Hash()] = moves
+		}
+		key := moveKey(m)
+		s := moves[key]
+		if s == nil {
+			s = &Stats{}
+			moves[key] = s
+		}
+		s.add(game.Result, rating, date)
+		board.MakeMove(m)
+	}
+	t.games++
+	return nil
+}
+
+// dropped are the pieces that can be dropped in Crazyhouse, in the order of their codes
+var dropped = []chess.Symbol{chess.PAWN, chess.KNIGHT, chess.BISHOP, chess.ROOK, chess.QUEEN}
+
+// moveKey identifies a move from a position. Moves are keyed by their Polyglot encoding,
+// which has no room for Crazyhouse drops, so a drop sets the top bit with the dropped
+// piece's code in place of the origin square.
+func moveKey(m chess.Move) uint16 {
+	if !m.IsDrop() {
+		return polyglot.EncodeMove(m)
+	}
+	code := 0
+	for i, symbol := range dropped {
+		if symbol == chess.Pieces[m.Piece].Symbol {
+			code = i
+		}
+	}
+	return 1<<15 | uint16(code)<<6 | uint16(m.To)
+}
+
+// averageRating returns the mean of the players' ratings, or of the one that's known
+func averageRating(game *chess.Game) int {
+	sum, n := 0, 0
+	for _, tag := range []string{"WhiteElo", "BlackElo"} {
+		if rating, err := strconv.Atoi(game.Tags[tag]); err == nil && rating > 0 {
+			sum += rating
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return sum / n
+}
+
+// Update adds the games added to a database since the tree was last updated from it,
+// returning the number of games read. Games in progress are read but skipped.
+func (t *Tree) Update(db *gamedb.DB) (int, error) {
+	added := 0
+	for n := db.Len(); t.updated < n; t.updated++ {
+		game, err := db.Game(t.updated)
+		if err != nil {
+			return added, err
+		}
+		if err := t.Add(game); err != nil {
+			return added, err
+		}
+		added++
+	}
+	return added, nil
+}
+
+// Moves returns the moves played from a position, most played first. Moves that aren't
+// legal in the position, from a hash collision, are left out.
+func (t *Tree) Moves(board *chess.Board) []Continuation {
+	var moves []Continuation
+	legal := map[uint16]chess.Move{}
+	for _, m := range board.LegalMoves() {
+		legal[moveKey(m)] = m
+	}
+	for key, s := range t.positions[board.Hash()] {
+		m, ok := legal[key]
+		if !ok {
+			continue
+		}
+		c := Continuation{Move: m, SAN: board.SAN(m), Stats: *s}
+		if s.Games > 0 {
+			c.White = 100 * float64(s.WhiteWins) / float64(s.Games)
+			c.Draw = 100 * float64(s.Draws) / float64(s.Games)
+			c.Black = 100 * float64(s.BlackWins) / float64(s.Games)
+		}
+		if s.Rated > 0 {
+			c.AverageRating = int(s.RatingSum / int64(s.Rated))
+		}
+		moves = append(moves, c)
+	}
+	sort.Slice(moves, func(i, j int) bool {
+		if moves[i].Games != moves[j].Games {
+			return moves[i].Games > moves[j].Games
+		}
+		return moves[i].SAN < moves[j].SAN
+	})
+	return moves
+}
+
+// Position returns the totals of the moves played from a position
+func (t *Tree) Position(board *chess.Board) Stats {
+	var total Stats
+	for _, c := range t.Moves(board) {
+		total.Games += c.Games
+		total.WhiteWins += c.WhiteWins
+		total.Draws += c.Draws
+		total.BlackWins += c.BlackWins
+		total.RatingSum += c.RatingSum
+		total.Rated += c.Rated
+		if c.LastPlayed > total.LastPlayed {
+			total.LastPlayed = c.LastPlayed
+		}
+	}
+	return total
+}
@@ -0,0 +1,215 @@
+package explorer
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/gamedb"
+)
+
+const games = `[Date "2021.03.04"]
+[WhiteElo "2000"]
+[BlackElo "1800"]
+[Result "1-0"]
+
+1. e4 e5 2. Nf3 Nc6 3. Bb5 1-0
+
+[Date "2023.01.15"]
+[WhiteElo "2200"]
+[Result "1/2-1/2"]
+
+1. e4 c5 2. Nf3 d6 1/2-1/2
+
+[Date "????.??.??"]
+[Result "0-1"]
+
+1. Nf3 Nc6 2. e4 e5 3. Bc4 0-1
+
+[Date "2022.07.01"]
+[WhiteElo "1500"]
+[BlackElo "1500"]
+[Result "0-1"]
+
+1. d4 d5 0-1
+
+[Date "2024.01.01"]
+[Result "*"]
+
+1. d4 Nf6 *
+`
+
+func newTestTree(t *testing.T) *Tree {
+	tree := New()
+	parsed, err := chess.ParsePGN(games)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, game := range parsed {
+		if err := tree.Add(game); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return tree
+}
+
+// checkTree checks the moves from the starting position and the transposition to the
+// position after 1. e4 e5 2. Nf3 Nc6
+func checkTree(t *testing.T, tree *Tree) {
+	t.Helper()
+	if tree.Games() != 4 {
+		t.Errorf("expected 4 games, got %d", tree.Games())
+	}
+	board, _ := chess.ParseFEN(chess.StartFEN)
+	moves := tree.Moves(board)
+	if len(moves) != 3 {
+		t.Fatalf("expected 3 moves from the start, got %d", len(moves))
+	}
+	e4, nf3, d4 := moves[0], moves[1], moves[2]
+	if e4.SAN != "e4" || d4.SAN != "d4" || nf3.SAN != "Nf3" {
+		t.Fatalf("expected e4, Nf3 and d4, got %s, %s and %s", e4.SAN, nf3.SAN, d4.SAN)
+	}
+	if e4.Games != 2 || e4.White != 50 || e4.Draw != 50 || e4.Black != 0 {
+		t.Errorf("unexpected e4 %+v", e4)
+	}
+	// Ratings average (2000+1800)/2 and 2200 for the player whose rating is known
+	if e4.AverageRating != 2050 || e4.LastPlayed != "2023.01.15" {
+		t.Errorf("expected e4 rated 2050 and last played 2023.01.15, got %d and %s", e4.AverageRating, e4.LastPlayed)
+	}
+	if d4.Games != 1 || d4.Black != 100 || d4.AverageRating != 1500 || d4.LastPlayed != "2022.07.01" {
+		t.Errorf("unexpected d4 %+v", d4)
+	}
+	if nf3.AverageRating != 0 || nf3.LastPlayed != "" {
+		t.Errorf("expected Nf3 to be unrated and undated, got %+v", nf3)
+	}
+
+	if total := tree.Position(board); total.Games != 4 || total.WhiteWins != 1 || total.Draws != 1 || total.BlackWins != 2 {
+		t.Errorf("unexpected totals %+v", total)
+	}
+
+	for _, san := range []string{"e4", "e5", "Nf3", "Nc6"} {
+		m, _ := board.ParseMove(san)
+		board.MakeMove(m)
+	}
+	moves = tree.Moves(board)
+	if len(moves) != 2 || moves[0].Games != 1 || moves[1].Games != 1 {
+		t.Fatalf("expected Bb5 and Bc4 from the transposed game, got %+v", moves)
+	}
+	if moves[0].SAN != "Bb5" && moves[1].SAN != "Bb5" {
+		t.Errorf("expected Bb5 after 1. e4 e5 2. Nf3 Nc6, got %s and %s", moves[0].SAN, moves[1].SAN)
+	}
+}
+
+func TestTree(t *testing.T) {
+	tree := newTestTree(t)
+	checkTree(t, tree)
+
+	tree = New()
+	tree.MaxPly = 1
+	game, _ := chess.ParsePGN("1. e4 e5 2. Nf3 1-0")
+	tree.Add(game[0])
+	board, _ := chess.ParseFEN(chess.StartFEN)
+	m, _ := board.ParseMove("e4")
+	board.MakeMove(m)
+	if moves := tree.Moves(board); len(moves) != 0 {
+		t.Errorf("expected no moves beyond the maximum ply, got %+v", moves)
+	}
+
+	// Drops of different pieces on the same square are different moves
+	const crazyhouse = "4k3/8/8/8/8/8/8/4K3[NPnp] w - - 0 1"
+	tree = New()
+	for _, drop := range []string{"N@e5", "P@e5", "N@e5"} {
+		game, _ := chess.NewGame(chess.WithFEN(crazyhouse))
+		game.Play(drop)
+		game.Result = chess.Draw
+		if err := tree.Add(game); err != nil {
+			t.Fatal(err)
+		}
+	}
+	board, _ = chess.ParseFEN(crazyhouse)
+	moves := tree.Moves(board)
+	if len(moves) != 2 || moves[0].SAN != "N@e5" || moves[0].Games != 2 || moves[1].SAN != "P@e5" || moves[1].Games != 1 {
+		t.Errorf("expected N@e5 twice and P@e5 once, got %+v", moves)
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "explorer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tree.bin")
+
+	tree := newTestTree(t)
+	if err := tree.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkTree(t, loaded)
+
+	var a, b bytes.Buffer
+	tree.WriteTo(&a)
+	loaded.WriteTo(&b)
+	if !bytes.Equal(a.Bytes(), b.Bytes()) {
+		t.Error("tree changed by saving and loading")
+	}
+	if _, err := ReadTree(bytes.NewReader(a.Bytes()[:a.Len()-3])); err == nil {
+		t.Error("expected an error reading a truncated tree")
+	}
+	if _, err := ReadTree(strings.NewReader("not a tree")); err == nil {
+		t.Error("expected an error reading something else")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "explorer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	db, err := gamedb.Open(filepath.Join(dir, "games"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	parsed, _ := chess.ParsePGN(games)
+	for _, game := range parsed[:2] {
+		if _, err := db.Add(game); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tree := New()
+	if n, err := tree.Update(db); err != nil || n != 2 {
+		t.Fatalf("expected 2 games added, got %d, %v", n, err)
+	}
+
+	// Saved and reloaded, the tree only takes the games added since
+	path := filepath.Join(dir, "tree.bin")
+	if err := tree.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	for _, game := range parsed[2:] {
+		if _, err := db.Add(game); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if tree, err = Load(path); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := tree.Update(db); err != nil || n != 3 {
+		t.Fatalf("expected 3 games added, got %d, %v", n, err)
+	}
+	checkTree(t, tree)
+	if n, _ := tree.Update(db); n != 0 {
+		t.Errorf("expected no games added, got %d", n)
+	}
+}
@@ -0,0 +1,398 @@
+// Package gamedb is an embedded, file-based database of chess games. Games are ingested
+// from PGN and stored compactly, and every position they reach is indexed by its
+// Zobrist hash and material signature so games can be found by position, by material
+// or by player and result without loading them.
+//
+// A database is a directory holding:
+//
+//	games.dat            the games, one record after another
+//	games.idx            16 bytes per game: the offset and length of its record and its result
+//	positions-*-*.idx    index segments keyed by the Zobrist hash of each position
+//	material-*-*.idx     index segments keyed by each material signature a game reaches
+//	players-*-*.idx      index segments keyed by the hash of each player's name and color
+//
+// Index entries for new games are kept in memory and written as a new segment by Flush
+// or Close; Compact merges the segments. Games added since the last flush are indexed
+// again when a database is reopened after a crash.
+package gamedb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// gameEntrySize is the size in bytes of a game's entry in games.idx
+const gameEntrySize = 16
+
+// DefaultFlushEntries is the number of index entries kept in memory before they're
+// written as a segment
+const DefaultFlushEntries = 1 << 22
+
+// DB is a game database. It's safe for concurrent use.
+type DB struct {
+	FlushEntries int // Index entries kept in memory before flushing, DefaultFlushEntries if 0
+
+	mu       sync.Mutex
+	dir      string
+	data     *os.File
+	index    *os.File
+	size     int64
+	count    int
+	indexed  int // Games covered by the segments
+	segments [indexes][]*segment
+	pending  [indexes][]entry
+}
+
+// Open opens the database in a directory, creating it if needed
+func Open(dir string) (*DB, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	db := &DB{dir: dir}
+	var err error
+	if db.data, err = os.OpenFile(filepath.Join(dir, "games.dat"), os.O_RDWR|os.O_CREATE, 0644); err != nil {
+		return nil, err
+	}
+	if db.index, err = os.OpenFile(filepath.Join(dir, "games.idx"), os.O_RDWR|os.O_CREATE, 0644); err == nil {
+		err = db.load()
+	}
+	if err != nil {
+		db.close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// load finds the games stored and the segments indexing them, cleaning up after a crash
+func (db *DB) load() error {
+	info, err := db.index.Stat()
+	if err != nil {
+		return err
+	}
+	db.count = int(info.Size() / gameEntrySize)
+	if db.count > 0 {
+		offset, length, _, err := db.gameEntry(db.count - 1)
+		if err != nil {
+			return err
+		}
+		db.size = offset + int64(length)
+	}
+	// Drop a partly written game
+	if err := db.index.Truncate(int64(db.count) * gameEntrySize); err != nil {
+		return err
+	}
+	if err := db.data.Truncate(db.size); err != nil {
+		return err
+	}
+
+	// Every index covers the same games, unless a crash interrupted a flush
+	db.indexed = db.count
+	for i := range db.segments {
+		if db.segments[i], err = openSegments(db.dir, i); err != nil {
+			return err
+		}
+		end := 0
+		for _, s := range db.segments[i] {
+			if s.first == end {
+				end = s.end
+			}
+		}
+		if end < db.indexed {
+			db.indexed = end
+		}
+	}
+	for i, segments := range db.segments {
+		kept := segments[:0]
+		for _, s := range segments {
+			if s.end <= db.indexed {
+				kept = append(kept, s)
+			} else {
+				s.f.Close()
+				os.Remove(s.path)
+			}
+		}
+		db.segments[i] = kept
+	}
+
+	for id := db.indexed; id < db.count; id++ {
+		game, err := db.game(id)
+		if err != nil {
+			return err
+		}
+		_, entries, err := encode(id, game)
+		if err != nil {
+			return err
+		}
+		db.addPending(entries)
+	}
+	return nil
+}
+
+// Close flushes the index and closes the database
+func (db *DB) Close() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	err := db.flush()
+	if closeErr := db.close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (db *DB) close() error {
+	var err error
+	for _, f := range []*os.File{db.data, db.index} {
+		if f != nil {
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+		}
+	}
+	for _, segments := range db.segments {
+		closeSegments(segments)
+	}
+	return err
+}
+
+// Len returns the number of games in the database
+func (db *DB) Len() int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.count
+}
+
+// Add stores a game and indexes its positions, returning its ID. IDs count up from 0.
+func (db *DB) Add(game *chess.Game) (int, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	id := db.count
+	record, entries, err := encode(id, game)
+	if err != nil {
+		return 0, err
+	}
+
+	var buf [gameEntrySize]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(db.size))
+	binary.BigEndian.PutUint32(buf[8:], uint32(len(record)))
+	buf[12] = resultCode(game.Result)
+	if _, err := db.data.WriteAt(record, db.size); err != nil {
+		return 0, err
+	}
+	if _, err := db.index.WriteAt(buf[:], int64(id)*gameEntrySize); err != nil {
+		return 0, err
+	}
+	db.size += int64(len(record))
+	db.count++
+
+	db.addPending(entries)
+
+	limit := db.FlushEntries
+	if limit <= 0 {
+		limit = DefaultFlushEntries
+	}
+	if len(db.pending[positionIndex]) >= limit {
+		return id, db.flush()
+	}
+	return id, nil
+}
+
+// encode returns a game's record and its index entries
+func encode(id int, game *chess.Game) ([]byte, [indexes][]entry, error) {
+	var entries [indexes][]entry
+	material := map[uint64]bool{} // Signatures already indexed
+	record, err := encodeGame(nil, game, func(board *chess.Board) {
+		entries[positionIndex] = append(entries[positionIndex], entry{board.Hash(), uint32(id)})
+		if key := MaterialOf(board).key(); !material[key] {
+			material[key] = true
+			entries[materialIndex] = append(entries[materialIndex], entry{key, uint32(id)})
+		}
+	})
+	for c, tag := range []string{"White", "Black"} {
+		if name := game.Tags[tag]; name != "" && name != "?" {
+			entries[playerIndex] = append(entries[playerIndex], entry{playerKey(name, chess.Color(c)), uint32(id)})
+		}
+	}
+	return record, entries, err
+}
+
+// addPending adds index entries to be written by the next flush
+func (db *DB) addPending(entries [indexes][]entry) {
+	for i := range entries {
+		db.pending[i] = append(db.pending[i], entries[i]...)
+	}
+}
+
+// playerKey hashes a player's name, ignoring case, and color
+func playerKey(name string, c chess.Color) uint64 {
+	h := fnv.New64a()
+	io.WriteString(h, c.String()+":"+strings.ToLower(strings.TrimSpace(name)))
+	return h.Sum64()
+}
+
+// AddPGN stores every game in a PGN stream, returning the number of games added.
+// Games that fail to parse stop the import with an error.
+func (db *DB) AddPGN(r io.Reader) (int, error) {
+	reader := chess.NewPGNReader(r)
+	count := 0
+	for {
+		game, err := reader.Next()
+		if err == io.EOF {
+			return count, nil
+		} else if err != nil {
+			return count, err
+		}
+		if _, err := db.Add(game); err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+// Flush writes the index entries of the games added since the last flush as new
+// segments
+func (db *DB) Flush() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.flush()
+}
+
+func (db *DB) flush() error {
+	if db.indexed == db.count {
+		return nil
+	}
+	if err := db.data.Sync(); err != nil {
+		return err
+	}
+	if err := db.index.Sync(); err != nil {
+		return err
+	}
+	var added [indexes]*segment
+	for i := range db.pending {
+		path := segmentPath(db.dir, i, db.indexed, db.count)
+		err := writeSegment(path, db.pending[i])
+		if err == nil {
+			added[i], err = openSegment(path, db.indexed, db.count)
+		}
+		if err != nil {
+			for _, s := range added {
+				if s != nil {
+					s.f.Close()
+					os.Remove(s.path)
+				}
+			}
+			return err
+		}
+	}
+	for i, s := range added {
+		db.segments[i] = append(db.segments[i], s)
+		db.pending[i] = nil
+	}
+	db.indexed = db.count
+	return nil
+}
+
+// Compact flushes the index and merges the segments of each index into one, which
+// makes searches faster after many flushes
+func (db *DB) Compact() error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if err := db.flush(); err != nil {
+		return err
+	}
+	for i, segments := range db.segments {
+		if len(segments) < 2 {
+			continue
+		}
+		path := segmentPath(db.dir, i, 0, db.indexed)
+		if err := mergeSegments(path, segments); err != nil {
+			return err
+		}
+		merged, err := openSegment(path, 0, db.indexed)
+		if err != nil {
+			return err
+		}
+		for _, s := range segments {
+			s.f.Close()
+			if s.path != path {
+				os.Remove(s.path)
+			}
+		}
+		db.segments[i] = []*segment{merged}
+	}
+	return nil
+}
+
+// Game returns a stored game
+func (db *DB) Game(id int) (*chess.Game, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.game(id)
+}
+
+func (db *DB) game(id int) (*chess.Game, error) {
+	offset, length, result, err := db.gameEntry(id)
+	if err != nil {
+		return nil, err
+	}
+	record := make([]byte, length)
+	if _, err := db.data.ReadAt(record, offset); err != nil {
+		return nil, err
+	}
+	game, err := decodeGame(record, results[result%uint8(len(results))])
+	if err != nil {
+		return nil, fmt.Errorf("gamedb: game %d: %s", id, err)
+	}
+	return game, nil
+}
+
+// gameEntry reads where a game is stored and its result
+func (db *DB) gameEntry(id int) (int64, uint32, uint8, error) {
+	if id < 0 || id >= db.count {
+		return 0, 0, 0, fmt.Errorf("gamedb: no game %d", id)
+	}
+	var buf [gameEntrySize]byte
+	if _, err := db.index.ReadAt(buf[:], int64(id)*gameEntrySize); err != nil {
+		return 0, 0, 0, err
+	}
+	return int64(binary.BigEndian.Uint64(buf[:])), binary.BigEndian.Uint32(buf[8:]), buf[12], nil
+}
+
+// lookup returns the sorted IDs of the games a key appears in
+func (db *DB) lookup(index int, key uint64) ([]int, error) {
+	var games []int
+	var err error
+	for _, s := range db.segments[index] {
+		if games, err = s.lookup(key, games); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range db.pending[index] {
+		if e.key == key {
+			games = append(games, int(e.game))
+		}
+	}
+	return unique(games), nil
+}
+
+// unique sorts IDs and removes duplicates
+func unique(games []int) []int {
+	sort.Ints(games)
+	n := 0
+	for i, id := range games {
+		if i == 0 || id != games[n-1] {
+			games[n] = id
+			n++
+		}
+	}
+	return games[:n]
+}
@@ -0,0 +1,235 @@
+package gamedb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+const games = `[Event "Casual"]
+[White "Alice"]
+[Black "Bob"]
+[Result "0-1"]
+
+1. f3 e5 2. g4 Qh4# 0-1
+
+[Event "Endgame study"]
+[White "Bob"]
+[Black "Carol"]
+[Result "1/2-1/2"]
+[SetUp "1"]
+[FEN "8/8/4k3/8/8/4K3/4R3/4r3 w - - 0 1"]
+
+1. Rxe1 Kd5 1/2-1/2
+
+[Event "Casual"]
+[White "alice"]
+[Black "Dave"]
+[Result "1-0"]
+
+1. e4 e5 2. Qh5 Nc6 3. Bc4 Nf6 4. Qxf7# 1-0
+
+[Event "Casual"]
+[White "Carol"]
+[Black "ALICE"]
+[Result "1/2-1/2"]
+
+1. e4 e5 2. Nf3 Nc6 1/2-1/2
+`
+
+// rookEndgame matches positions with only kings, rooks and pawns, and a rook each
+func rookEndgame(m Material) bool {
+	for c := chess.WHITE; c <= chess.BLACK; c++ {
+		if m.Count(c, chess.ROOK) == 0 || m.Count(c, chess.QUEEN)+m.Count(c, chess.BISHOP)+m.Count(c, chess.KNIGHT) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func openTestDB(t *testing.T) (*DB, string) {
+	dir, err := ioutil.TempDir("", "gamedb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, dir
+}
+
+func checkSearches(t *testing.T, db *DB) {
+	t.Helper()
+	afterE4E5, _ := chess.ParseFEN("rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq e6 0 2")
+	start, _ := chess.ParseFEN(chess.StartFEN)
+	tests := []struct {
+		name     string
+		query    Query
+		expected []int
+	}{
+		{"everything", Query{}, []int{0, 1, 2, 3}},
+		{"fen", Query{FEN: "rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq e6 0 2"}, []int{2, 3}},
+		{"position", Query{Position: afterE4E5}, []int{2, 3}},
+		{"start", Query{Position: start}, []int{0, 2, 3}},
+		{"missing", Query{FEN: "8/8/8/8/8/8/8/K6k w - - 0 1"}, nil},
+		{"player", Query{Player: "ALICE"}, []int{0, 2, 3}},
+		{"white", Query{White: "Alice"}, []int{0, 2}},
+		{"black", Query{Black: "alice"}, []int{3}},
+		{"player and result", Query{Player: "Alice", Result: chess.WhiteWins}, []int{2}},
+		{"result", Query{Result: chess.Draw}, []int{1, 3}},
+		{"rook endgame", Query{Material: rookEndgame}, []int{1}},
+		{"rook endgame and player", Query{Material: rookEndgame, Player: "alice"}, nil},
+		{"position and player", Query{Position: afterE4E5, White: "carol"}, []int{3}},
+	}
+	for _, test := range tests {
+		games, err := db.Search(test.query)
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+		} else if len(games) != len(test.expected) || len(games) > 0 && !reflect.DeepEqual(games, test.expected) {
+			t.Errorf("%s: expected games %v, got %v", test.name, test.expected, games)
+		}
+	}
+}
+
+func TestDB(t *testing.T) {
+	db, dir := openTestDB(t)
+	defer os.RemoveAll(dir)
+
+	n, err := db.AddPGN(strings.NewReader(games))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 4 || db.Len() != 4 {
+		t.Fatalf("expected 4 games, added %d and have %d", n, db.Len())
+	}
+	checkSearches(t, db) // From the index entries in memory
+
+	expected, _ := chess.ParsePGN(games)
+	for id, want := range expected {
+		game, err := db.Game(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if game.String() != want.String() {
+			t.Errorf("game %d: expected\n%s\ngot\n%s", id, want, game)
+		}
+	}
+	if _, err := db.Game(4); err == nil {
+		t.Error("expected an error reading a missing game")
+	}
+	if _, err := db.Search(Query{Result: "2-0"}); err == nil {
+		t.Error("expected an error searching for an unknown result")
+	}
+
+	// Reopened, the searches use the segments written on closing
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if db, err = Open(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	checkSearches(t, db)
+	if game, err := db.Game(2); err != nil || len(game.Moves) != 7 || game.Result != chess.WhiteWins {
+		t.Errorf("unexpected game after reopening: %v, %v", game, err)
+	}
+}
+
+func TestDBSegments(t *testing.T) {
+	db, dir := openTestDB(t)
+	defer os.RemoveAll(dir)
+	db.FlushEntries = 1 // A segment for each game
+
+	if _, err := db.AddPGN(strings.NewReader(games)); err != nil {
+		t.Fatal(err)
+	}
+	if paths, _ := filepath.Glob(filepath.Join(dir, "positions-*.idx")); len(paths) != 4 {
+		t.Errorf("expected 4 position segments, got %v", paths)
+	}
+	checkSearches(t, db)
+
+	if err := db.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	paths, _ := filepath.Glob(filepath.Join(dir, "*.idx"))
+	for i := range paths {
+		paths[i] = filepath.Base(paths[i])
+	}
+	expected := []string{"games.idx", "material-0000000000-0000000004.idx", "players-0000000000-0000000004.idx", "positions-0000000000-0000000004.idx"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected files %v after compacting, got %v", expected, paths)
+	}
+	checkSearches(t, db)
+
+	// Material is indexed once for each signature a game reaches, and each signature is
+	// tested once
+	if n := db.segments[materialIndex][0].len(); n != 6 {
+		t.Errorf("expected 6 material entries, got %d", n)
+	}
+	tested := 0
+	db.Search(Query{Material: func(Material) bool { tested++; return true }})
+	if tested != 4 {
+		t.Errorf("expected 4 signatures to be tested, got %d", tested)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDBRecovery(t *testing.T) {
+	db, dir := openTestDB(t)
+	defer os.RemoveAll(dir)
+
+	if _, err := db.AddPGN(strings.NewReader(games)); err != nil {
+		t.Fatal(err)
+	}
+	// Crash before the index is written, with half a game appended
+	db.data.WriteAt([]byte{1, 2, 3}, db.size)
+	db.index.WriteAt([]byte{0, 0, 0}, int64(db.count)*gameEntrySize)
+	db.close()
+
+	db, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if db.Len() != 4 {
+		t.Errorf("expected 4 games, got %d", db.Len())
+	}
+	checkSearches(t, db)
+	if id, err := db.Add(&chess.Game{Tags: map[string]string{"White": "Erin"}, Result: chess.InProgress}); err != nil || id != 4 {
+		t.Errorf("expected to add game 4, got %d, %v", id, err)
+	}
+}
+
+func TestRecordSize(t *testing.T) {
+	games, err := chess.ParsePGN(`1. e4 e5 2. Nf3 Nc6 3. Bb5 a6 4. Ba4 Nf6 5. O-O Be7 6. Re1 b5 7. Bb3 d6 8. c3 O-O 9. h3 Nb8 10. d4 Nbd7 *`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record, err := encodeGame(nil, games[0], func(*chess.Board) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// No tags, the move count and a byte for each of the 20 moves
+	if len(record) != 22 {
+		t.Errorf("expected a 22 byte record, got %d", len(record))
+	}
+	game, err := decodeGame(record, chess.InProgress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(game.Moves, games[0].Moves) {
+		t.Error("moves changed by encoding")
+	}
+
+	if _, err := decodeGame(record[:10], chess.InProgress); err == nil {
+		t.Error("expected an error decoding a truncated record")
+	}
+}
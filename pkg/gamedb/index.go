@@ -0,0 +1,269 @@
+package gamedb
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// An index maps keys to the games they appear in. It's kept as segments, each covering
+// a range of games and written in one go: a file of 12 byte big-endian entries, a key
+// and a game ID, sorted and without duplicates so lookups are binary searches done in
+// place. Compacting merges the segments of an index into one.
+
+// entrySize is the size in bytes of an index entry
+const entrySize = 12
+
+// Kinds of index
+const (
+	positionIndex = iota // Zobrist hashes of the positions reached
+	materialIndex        // Material signatures reached
+	playerIndex          // Hashes of the players' names
+	indexes
+)
+
+var indexNames = [indexes]string{"positions", "material", "players"}
+
+type entry struct {
+	key  uint64
+	game uint32
+}
+
+func entryLess(a, b entry) bool {
+	return a.key < b.key || a.key == b.key && a.game < b.game
+}
+
+// segment is an index file covering the games from first up to end
+type segment struct {
+	path       string
+	first, end int
+	f          *os.File
+	size       int64
+}
+
+// segmentPath returns the path of a segment of an index
+func segmentPath(dir string, index, first, end int) string {
+	return filepath.Join(dir, fmt.Sprintf("%s-%010d-%010d.idx", indexNames[index], first, end))
+}
+
+// openSegments opens the segments of an index in the order of the games they cover
+func openSegments(dir string, index int) ([]*segment, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, indexNames[index]+"-*-*.idx"))
+	if err != nil {
+		return nil, err
+	}
+	var segments []*segment
+	for _, path := range paths {
+		fields := strings.Split(strings.TrimSuffix(filepath.Base(path), ".idx"), "-")
+		if len(fields) != 3 {
+			continue
+		}
+		first, err1 := strconv.Atoi(fields[1])
+		end, err2 := strconv.Atoi(fields[2])
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		s, err := openSegment(path, first, end)
+		if err != nil {
+			closeSegments(segments)
+			return nil, err
+		}
+		segments = append(segments, s)
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i].first < segments[j].first })
+	return segments, nil
+}
+
+func openSegment(path string, first, end int) (*segment, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if info.Size()%entrySize != 0 {
+		f.Close()
+		return nil, fmt.Errorf("gamedb: index %s size %d is not a multiple of %d bytes", path, info.Size(), entrySize)
+	}
+	return &segment{path: path, first: first, end: end, f: f, size: info.Size()}, nil
+}
+
+func closeSegments(segments []*segment) {
+	for _, s := range segments {
+		s.f.Close()
+	}
+}
+
+func (s *segment) len() int {
+	return int(s.size / entrySize)
+}
+
+func (s *segment) entry(i int) (entry, error) {
+	var buf [entrySize]byte
+	if _, err := s.f.ReadAt(buf[:], int64(i)*entrySize); err != nil {
+		return entry{}, err
+	}
+	return decodeEntry(buf[:]), nil
+}
+
+// lookup appends the games a key appears in
+func (s *segment) lookup(key uint64, games []int) ([]int, error) {
+	var err error
+	i := sort.Search(s.len(), func(i int) bool {
+		e, readErr := s.entry(i)
+		if readErr != nil {
+			err = readErr
+			return true
+		}
+		return e.key >= key
+	})
+	for ; err == nil && i < s.len(); i++ {
+		var e entry
+		if e, err = s.entry(i); err == nil {
+			if e.key != key {
+				break
+			}
+			games = append(games, int(e.game))
+		}
+	}
+	return games, err
+}
+
+// match appends the games of every key that matches, testing each key once and skipping
+// the entries of the keys that don't
+func (s *segment) match(matches func(key uint64) bool, games []int) ([]int, error) {
+	var err error
+	for i := 0; err == nil && i < s.len(); {
+		var first entry
+		if first, err = s.entry(i); err != nil {
+			break
+		}
+		end := i + sort.Search(s.len()-i, func(j int) bool {
+			e, readErr := s.entry(i + j)
+			if readErr != nil {
+				err = readErr
+				return true
+			}
+			return e.key > first.key
+		})
+		if err == nil && matches(first.key) {
+			for ; err == nil && i < end; i++ {
+				var e entry
+				if e, err = s.entry(i); err == nil {
+					games = append(games, int(e.game))
+				}
+			}
+		}
+		i = end
+	}
+	return games, err
+}
+
+func decodeEntry(b []byte) entry {
+	return entry{binary.BigEndian.Uint64(b), binary.BigEndian.Uint32(b[8:])}
+}
+
+// writeSegment sorts entries and writes them as a segment, replacing the file
+// atomically so a crash never leaves half a segment behind
+func writeSegment(path string, entries []entry) error {
+	sort.Slice(entries, func(i, j int) bool { return entryLess(entries[i], entries[j]) })
+	return writeFile(path, func(w *bufio.Writer) error {
+		var last entry
+		for i, e := range entries {
+			if i > 0 && e == last {
+				continue
+			}
+			if err := writeEntry(w, e); err != nil {
+				return err
+			}
+			last = e
+		}
+		return nil
+	})
+}
+
+// mergeSegments merges sorted segments into one
+func mergeSegments(path string, segments []*segment) error {
+	return writeFile(path, func(w *bufio.Writer) error {
+		readers := make([]*bufio.Reader, len(segments))
+		heads := make([]*entry, len(segments))
+		next := func(i int) error {
+			var buf [entrySize]byte
+			if _, err := io.ReadFull(readers[i], buf[:]); err == io.EOF {
+				heads[i] = nil
+				return nil
+			} else if err != nil {
+				return err
+			}
+			e := decodeEntry(buf[:])
+			heads[i] = &e
+			return nil
+		}
+		for i, s := range segments {
+			readers[i] = bufio.NewReaderSize(io.NewSectionReader(s.f, 0, s.size), 64*1024)
+			if err := next(i); err != nil {
+				return err
+			}
+		}
+		for {
+			min := -1
+			for i, head := range heads {
+				if head != nil && (min < 0 || entryLess(*head, *heads[min])) {
+					min = i
+				}
+			}
+			if min < 0 {
+				return nil
+			}
+			if err := writeEntry(w, *heads[min]); err != nil {
+				return err
+			}
+			if err := next(min); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+func writeEntry(w io.Writer, e entry) error {
+	var buf [entrySize]byte
+	binary.BigEndian.PutUint64(buf[:], e.key)
+	binary.BigEndian.PutUint32(buf[8:], e.game)
+	_, err := w.Write(buf[:])
+	return err
+}
+
+// writeFile writes a file through a temporary file which is renamed into place
+func writeFile(path string, write func(*bufio.Writer) error) error {
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriterSize(f, 64*1024)
+	err = write(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(path+".tmp", path)
+	}
+	if err != nil {
+		os.Remove(path + ".tmp")
+	}
+	return err
+}
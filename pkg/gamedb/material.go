@@ -0,0 +1,114 @@
+package gamedb
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// materialSymbols are the pieces counted in a material signature, in the order they're
+// written
+var materialSymbols = [6]chess.Symbol{chess.KING, chess.QUEEN, chess.ROOK, chess.BISHOP, chess.KNIGHT, chess.PAWN}
+
+// materialLetters are the letters of the pieces in materialSymbols
+const materialLetters = "KQRBNP"
+
+// Material counts the pieces each side has, indexed by color and then by the order of
+// "KQRBNP". It's written as the white pieces then the black ones, e.g. "KRPPvKR".
+type Material [2][6]uint8
+
+// MaterialOf returns the material on a board
+func MaterialOf(board *chess.Board) Material {
+	var m Material
+	for c := chess.WHITE; c <= chess.BLACK; c++ {
+		for i, s := range materialSymbols {
+			if index := chess.PieceIndex(c, s); index != chess.NoPiece && index < len(board.Positions) {
+				m[c][i] = uint8(board.Positions[index].Population())
+			}
+		}
+	}
+	return m
+}
+
+// ParseMaterial parses a material signature such as "KRPPvKR"
+func ParseMaterial(s string) (Material, error) {
+	var m Material
+	sides := strings.Split(strings.ToUpper(s), "V")
+	if len(sides) != 2 {
+		return m, fmt.Errorf("invalid material %q: expected white and black pieces separated by v", s)
+	}
+	for c, side := range sides {
+		for _, r := range side {
+			i := strings.IndexRune(materialLetters, r)
+			if i < 0 {
+				return m, fmt.Errorf("invalid material %q: unknown piece %q", s, r)
+			}
+			if m[c][i] == 15 {
+				return m, fmt.Errorf("invalid material %q: too many pieces", s)
+			}
+			m[c][i]++
+		}
+	}
+	return m, nil
+}
+
+func (m Material) String() string {
+	var s strings.Builder
+	for c := range m {
+		if c == 1 {
+			s.WriteByte('v')
+		}
+		for i, n := range m[c] {
+			s.WriteString(strings.Repeat(materialLetters[i:i+1], int(n)))
+		}
+	}
+	return s.String()
+}
+
+// Count returns the number of pieces of a kind a side has
+func (m Material) Count(c chess.Color, s chess.Symbol) int {
+	for i, symbol := range materialSymbols {
+		if symbol == s {
+			return int(m[c][i])
+		}
+	}
+	return 0
+}
+
+// Pieces returns the number of pieces on the board, kings and pawns included
+func (m Material) Pieces() int {
+	n := 0
+	for c := range m {
+		for _, count := range m[c] {
+			n += int(count)
+		}
+	}
+	return n
+}
+
+// key packs the counts four bits each, as the material index stores them
+func (m Material) key() uint64 {
+	var k uint64
+	for c := range m {
+		for _, n := range m[c] {
+			if n > 15 {
+				n = 15
+			}
+			k = k<<4 | uint64(n)
+		}
+	}
+	return k
+}
+
+// materialFromKey unpacks a material index key
+func materialFromKey(k uint64) Material {
+	var m Material
+	for c := 1; c >= 0; c-- {
+		for i := 5; i >= 0; i-- {
+			m[c][i] = uint8(k & 15)
+			k >>= 4
+		}
+	}
+	return m
+}
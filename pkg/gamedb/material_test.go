@@ -0,0 +1,40 @@
+package gamedb
+
+import (
+	"testing"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+func TestMaterial(t *testing.T) {
+	board, _ := chess.ParseFEN(chess.StartFEN)
+	m := MaterialOf(board)
+	if s := m.String(); s != "KQRRBBNNPPPPPPPPvKQRRBBNNPPPPPPPP" {
+		t.Errorf("unexpected starting material %s", s)
+	}
+	if m.Pieces() != 32 || m.Count(chess.BLACK, chess.KNIGHT) != 2 {
+		t.Errorf("expected 32 pieces and 2 black knights, got %d and %d", m.Pieces(), m.Count(chess.BLACK, chess.KNIGHT))
+	}
+	if materialFromKey(m.key()) != m {
+		t.Error("material changed by its index key")
+	}
+
+	for _, s := range []string{"KRPPvKR", "KvK", "KQQQQQQQQQvKR", "KBNvK"} {
+		m, err := ParseMaterial(s)
+		if err != nil {
+			t.Errorf("%s: %s", s, err)
+		} else if m.String() != s {
+			t.Errorf("expected %s, got %s", s, m)
+		} else if materialFromKey(m.key()) != m {
+			t.Errorf("%s changed by its index key", s)
+		}
+	}
+	if m, _ := ParseMaterial("kprvkr"); m.String() != "KRPvKR" {
+		t.Errorf("expected pieces to be put in order, got %s", m)
+	}
+	for _, s := range []string{"KRvKRvK", "KXvK", "KR"} {
+		if _, err := ParseMaterial(s); err == nil {
+			t.Errorf("expected an error parsing %s", s)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package gamedb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// A game is stored as its tags followed by its moves. Every length and number is a
+// varint. Each move is stored as its chess.Board.MoveIndex, a single byte when the
+// position has fewer than 128 legal moves.
+//
+//	tags:  count, then length and bytes of each name and value, sorted by name
+//	moves: count, then the index of each move
+//
+// The result is kept in the game index rather than the record.
+
+// Results as stored in the game index
+var results = []string{chess.InProgress, chess.WhiteWins, chess.BlackWins, chess.Draw}
+
+func resultCode(result string) uint8 {
+	for i, r := range results {
+		if r == result {
+			return uint8(i)
+		}
+	}
+	return 0
+}
+
+// encodeGame appends a game's record, calling visit with the board before each move
+// and after the last one
+func encodeGame(b []byte, game *chess.Game, visit func(*chess.Board)) ([]byte, error) {
+	board, err := game.StartingPosition()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(game.Tags))
+	for name := range game.Tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	b = appendUvarint(b, uint64(len(names)))
+	for _, name := range names {
+		b = appendString(b, name)
+		b = appendString(b, game.Tags[name])
+	}
+
+	b = appendUvarint(b, uint64(len(game.Moves)))
+	for ply, m := range game.Moves {
+		visit(board)
+		i := board.MoveIndex(m)
+		if i < 0 {
+			return nil, fmt.Errorf("illegal move %s at ply %d", m.UCI(), ply+1)
+		}
+		b = appendUvarint(b, uint64(i))
+		board.MakeMove(m)
+	}
+	visit(board)
+	return b, nil
+}
+
+// decodeGame reads a game's record
+func decodeGame(b []byte, result string) (*chess.Game, error) {
+	r := recordReader{b: b}
+	game := &chess.Game{Tags: map[string]string{}, Result: result}
+	for n := r.uvarint(); n > 0 && r.err == nil; n-- {
+		name := r.string()
+		game.Tags[name] = r.string()
+	}
+	count := r.uvarint()
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	board, err := game.StartingPosition()
+	if err != nil {
+		return nil, err
+	}
+	for ply := uint64(0); ply < count; ply++ {
+		i := r.uvarint()
+		m, ok := board.MoveAt(int(i))
+		if r.err != nil || !ok {
+			return nil, fmt.Errorf("gamedb: invalid move at ply %d", ply+1)
+		}
+		game.Moves = append(game.Moves, m)
+		board.MakeMove(m)
+	}
+	return game, nil
+}
+
+func appendString(b []byte, s string) []byte {
+	b = appendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// recordReader reads varints and strings, keeping the first error
+type recordReader struct {
+	b   []byte
+	err error
+}
+
+func (r *recordReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.b)
+	if n <= 0 {
+		r.err = fmt.Errorf("gamedb: truncated record")
+		return 0
+	}
+	r.b = r.b[n:]
+	return v
+}
+
+func (r *recordReader) string() string {
+	n := r.uvarint()
+	if r.err != nil {
+		return ""
+	}
+	if n > uint64(len(r.b)) {
+		r.err = fmt.Errorf("gamedb: truncated record")
+		return ""
+	}
+	s := string(r.b[:n])
+	r.b = r.b[n:]
+	return s
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
@@ -0,0 +1,155 @@
+package gamedb
+
+import (
+	"fmt"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// Query selects games. Games must match every criterion given; an empty query selects
+// every game.
+type Query struct {
+	Position *chess.Board        // Games reaching the position
+	FEN      string              // Games reaching the position in Forsyth-Edwards Notation
+	Material func(Material) bool // Games reaching material that matches, e.g. a rook endgame
+	Player   string              // Games played by a player with either color, ignoring case
+	White    string              // Games played by a player with white
+	Black    string              // Games played by a player with black
+	Result   string              // Games with a result, e.g. chess.WhiteWins
+}
+
+// Search returns the IDs of the games matching a query, in ascending order. Positions
+// are matched by their Zobrist hash.
+func (db *DB) Search(q Query) ([]int, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if q.Result != "" && results[resultCode(q.Result)] != q.Result {
+		return nil, fmt.Errorf("gamedb: unknown result %q", q.Result)
+	}
+
+	var games []int
+	all := true
+	match := func(ids []int) {
+		if all {
+			games, all = ids, false
+		} else {
+			games = intersect(games, ids)
+		}
+	}
+
+	if q.FEN != "" {
+		board, err := chess.ParseFEN(q.FEN)
+		if err != nil {
+			return nil, err
+		}
+		ids, err := db.lookup(positionIndex, board.Hash())
+		if err != nil {
+			return nil, err
+		}
+		match(ids)
+	}
+	if q.Position != nil {
+		ids, err := db.lookup(positionIndex, q.Position.Hash())
+		if err != nil {
+			return nil, err
+		}
+		match(ids)
+	}
+	players := []struct {
+		name   string
+		colors []chess.Color
+	}{
+		{q.Player, []chess.Color{chess.WHITE, chess.BLACK}},
+		{q.White, []chess.Color{chess.WHITE}},
+		{q.Black, []chess.Color{chess.BLACK}},
+	}
+	for _, p := range players {
+		if p.name == "" {
+			continue
+		}
+		var ids []int
+		for _, c := range p.colors {
+			found, err := db.lookup(playerIndex, playerKey(p.name, c))
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, found...)
+		}
+		match(unique(ids))
+	}
+	if q.Material != nil {
+		ids, err := db.searchMaterial(q.Material)
+		if err != nil {
+			return nil, err
+		}
+		match(ids)
+	}
+
+	if all {
+		games = make([]int, db.count)
+		for id := range games {
+			games[id] = id
+		}
+	}
+	if q.Result == "" {
+		return games, nil
+	}
+	code := resultCode(q.Result)
+	n := 0
+	for _, id := range games {
+		_, _, result, err := db.gameEntry(id)
+		if err != nil {
+			return nil, err
+		}
+		if result == code {
+			games[n] = id
+			n++
+		}
+	}
+	return games[:n], nil
+}
+
+// searchMaterial returns the games reaching material that matches, deciding once for
+// each signature in the index
+func (db *DB) searchMaterial(matches func(Material) bool) ([]int, error) {
+	decided := map[uint64]bool{}
+	match := func(key uint64) bool {
+		ok, seen := decided[key]
+		if !seen {
+			ok = matches(materialFromKey(key))
+			decided[key] = ok
+		}
+		return ok
+	}
+	var games []int
+	for _, s := range db.segments[materialIndex] {
+		var err error
+		if games, err = s.match(match, games); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range db.pending[materialIndex] {
+		if match(e.key) {
+			games = append(games, int(e.game))
+		}
+	}
+	return unique(games), nil
+}
+
+// intersect returns the IDs in both sorted lists
+func intersect(a, b []int) []int {
+	var games []int
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			games = append(games, a[i])
+			i++
+			j++
+		}
+	}
+	return games
+}
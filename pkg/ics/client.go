@@ -0,0 +1,319 @@
+// Package ics is a client of Internet Chess Servers speaking the FICS protocol, see
+// <https://www.freechess.org/Help/HelpFiles/style12.html>.
+//
+// A client logs in, asks for board updates in style 12 and seeks in the seekinfo format,
+// then reads the server's output with Run, parsing each board into a chess.Board. Moves,
+// seeks and observation requests are plain commands sent while Run reads.
+package ics
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultAddr is the address of the Free Internet Chess Server
+const DefaultAddr = "freechess.org:5000"
+
+// prompt is the FICS prompt, which starts lines of output and waits for commands
+const prompt = "fics% "
+
+// ErrLogin is returned when the server rejects a login
+var ErrLogin = errors.New("ics: login failed")
+
+// setup are the commands sent after logging in, for output the client can parse
+var setup = []string{
+	"set style 12",
+	"iset nowrap 1",
+	"iset seekinfo 1",
+	"iset seekremove 1",
+	"set seek 0",
+	"set bell 0",
+}
+
+// Client is a session on a chess server. The callbacks are called by Run as output
+// arrives; any may be nil.
+type Client struct {
+	OnPosition    func(*Position)   // A board update
+	OnSeek        func(*Seek)       // A seek was posted
+	OnSeekRemoved func(id int)      // A seek was withdrawn or accepted
+	OnGame        func(*GameNotice) // A game started or ended
+	OnLine        func(string)      // Any other output, without the prompt
+	Timeout       time.Duration     // Limits how long logging in takes, if the connection is a net.Conn
+
+	conn io.ReadWriteCloser
+	r    *bufio.Reader
+	wmu  sync.Mutex
+
+	mu        sync.Mutex
+	handle    string
+	positions map[int]*Position
+	seeks     map[int]*Seek
+}
+
+// Dial connects to a chess server, e.g. DefaultAddr
+func Dial(addr string) (*Client, error) {
+	conn, err := net.DialTimeout("tcp", addr, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(conn), nil
+}
+
+// NewClient returns a client talking to a server over a connection
+func NewClient(conn io.ReadWriteCloser) *Client {
+	return &Client{
+		Timeout:   30 * time.Second,
+		conn:      conn,
+		r:         bufio.NewReader(conn),
+		positions: map[int]*Position{},
+		seeks:     map[int]*Seek{},
+	}
+}
+
+// Login logs in and sets up the session, returning the handle the server assigned. A
+// user of "guest" logs in as an unregistered guest and needs no password.
+func (c *Client) Login(user, password string) (string, error) {
+	if conn, ok := c.conn.(net.Conn); ok && c.Timeout > 0 {
+		conn.SetReadDeadline(time.Now().Add(c.Timeout))
+		defer conn.SetReadDeadline(time.Time{})
+	}
+	if _, _, err := c.readUntil("login: "); err != nil {
+		return "", err
+	}
+	if err := c.Send(user); err != nil {
+		return "", err
+	}
+
+	for {
+		i, _, err := c.readUntil(`":`, "password: ", "Starting FICS session as ", "Invalid password", "login: ")
+		if err != nil {
+			return "", err
+		}
+		switch i {
+		case 0: // Press return to enter the server as "GuestABCD":
+			err = c.Send("")
+		case 1:
+			err = c.Send(password)
+		case 2:
+			var handle string
+			if _, handle, err = c.readUntil(" ****"); err == nil {
+				_, _, err = c.readUntil(prompt)
+			}
+			if err != nil {
+				return "", err
+			}
+			handle = strings.TrimSuffix(handle, " ****")
+			if i := strings.IndexByte(handle, '('); i >= 0 {
+				handle = handle[:i] // Titles, e.g. "GuestABCD(U)"
+			}
+			c.mu.Lock()
+			c.handle = handle
+			c.mu.Unlock()
+			for _, command := range setup {
+				if err := c.Send(command); err != nil {
+					return "", err
+				}
+			}
+			return handle, nil
+		default:
+			return "", ErrLogin
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// readUntil reads the server's output until it ends with one of the patterns, returning
+// which and the output read
+func (c *Client) readUntil(patterns ...string) (int, string, error) {
+	var text strings.Builder
+	for {
+		b, err := c.r.ReadByte()
+		if err != nil {
+			return -1, text.String(), err
+		}
+		text.WriteByte(b)
+		for i, pattern := range patterns {
+			if strings.HasSuffix(text.String(), pattern) {
+				return i, text.String(), nil
+			}
+		}
+	}
+}
+
+// Run reads the server's output until the connection is closed, calling the callbacks
+func (c *Client) Run() error {
+	for {
+		line, err := c.r.ReadString('\n')
+		line = strings.Trim(line, "\r\n")
+		for strings.HasPrefix(line, prompt) {
+			line = strings.TrimPrefix(line, prompt)
+		}
+		if line != "" {
+			c.dispatch(line)
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// dispatch parses a line of output, keeping track of positions and seeks
+func (c *Client) dispatch(line string) {
+	switch {
+	case strings.HasPrefix(line, "<12> "):
+		if p, err := ParseStyle12(line); err == nil {
+			c.mu.Lock()
+			c.positions[p.Game] = p
+			c.mu.Unlock()
+			if c.OnPosition != nil {
+				c.OnPosition(p)
+			}
+			return
+		}
+	case strings.HasPrefix(line, "<s> "):
+		if s, err := ParseSeek(line); err == nil {
+			c.mu.Lock()
+			c.seeks[s.ID] = s
+			c.mu.Unlock()
+			if c.OnSeek != nil {
+				c.OnSeek(s)
+			}
+			return
+		}
+	case strings.HasPrefix(line, "<sr> "), line == "<sc>":
+		var ids []int
+		c.mu.Lock()
+		if line == "<sc>" {
+			for id := range c.seeks {
+				ids = append(ids, id)
+			}
+			sort.Ints(ids)
+		} else {
+			for _, field := range strings.Fields(line)[1:] {
+				if id, err := strconv.Atoi(field); err == nil {
+					ids = append(ids, id)
+				}
+			}
+		}
+		for _, id := range ids {
+			delete(c.seeks, id)
+		}
+		c.mu.Unlock()
+		if c.OnSeekRemoved != nil {
+			for _, id := range ids {
+				c.OnSeekRemoved(id)
+			}
+		}
+		return
+	case strings.HasPrefix(line, "{Game "):
+		if n, err := ParseGameNotice(line); err == nil {
+			if n.Result != "" {
+				c.mu.Lock()
+				delete(c.positions, n.Game)
+				c.mu.Unlock()
+			}
+			if c.OnGame != nil {
+				c.OnGame(n)
+			}
+			return
+		}
+	}
+	if c.OnLine != nil {
+		c.OnLine(line)
+	}
+}
+
+// Handle returns the handle the client is logged in as
+func (c *Client) Handle() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.handle
+}
+
+// Position returns the latest position of a game being played or observed, or nil
+func (c *Client) Position(game int) *Position {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.positions[game]
+}
+
+// Seeks returns the seeks posted on the server, by ID
+func (c *Client) Seeks() []*Seek {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	seeks := make([]*Seek, 0, len(c.seeks))
+	for _, s := range c.seeks {
+		seeks = append(seeks, s)
+	}
+	sort.Slice(seeks, func(i, j int) bool { return seeks[i].ID < seeks[j].ID })
+	return seeks
+}
+
+// Send sends a command
+func (c *Client) Send(command string) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	_, err := io.WriteString(c.conn, command+"\n")
+	return err
+}
+
+// Move plays a move in SAN or coordinate notation, e.g. "Nf3" or "g1f3"
+func (c *Client) Move(move string) error {
+	return c.Send(move)
+}
+
+// Observe starts observing a game
+func (c *Client) Observe(game int) error {
+	return c.Send(fmt.Sprintf("observe %d", game))
+}
+
+// Unobserve stops observing a game
+func (c *Client) Unobserve(game int) error {
+	c.mu.Lock()
+	delete(c.positions, game)
+	c.mu.Unlock()
+	return c.Send(fmt.Sprintf("unobserve %d", game))
+}
+
+// Seek advertises for a game with a time control in minutes and an increment in seconds
+func (c *Client) Seek(minutes, increment int, rated bool) error {
+	r := "u"
+	if rated {
+		r = "r"
+	}
+	return c.Send(fmt.Sprintf("seek %d %d %s", minutes, increment, r))
+}
+
+// Unseek withdraws the client's seeks
+func (c *Client) Unseek() error {
+	return c.Send("unseek")
+}
+
+// Play accepts a seek, starting a game
+func (c *Client) Play(seek int) error {
+	return c.Send(fmt.Sprintf("play %d", seek))
+}
+
+// Resign resigns the game being played
+func (c *Client) Resign() error {
+	return c.Send("resign")
+}
+
+// Close logs out and closes the connection
+func (c *Client) Close() error {
+	c.Send("quit")
+	return c.conn.Close()
+}
@@ -0,0 +1,191 @@
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// step is a turn of a scripted server: it waits for a command, if any, then sends its
+// output
+type step struct {
+	expect string
+	send   string
+}
+
+// serve starts a server on a local port which plays a script to one client, reporting
+// the first command that doesn't match on the returned channel, which is closed when
+// the script ends
+func serve(t *testing.T, script ...step) (string, <-chan error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		defer close(done)
+		defer l.Close()
+		conn, err := l.Accept()
+		if err != nil {
+			done <- err
+			return
+		}
+		defer conn.Close()
+		conn.SetDeadline(time.Now().Add(5 * time.Second))
+		r := bufio.NewReader(conn)
+		for _, s := range script {
+			if s.expect != "-" {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					done <- err
+					return
+				}
+				if line = strings.TrimRight(line, "\r\n"); line != s.expect {
+					done <- fmt.Errorf("expected command %q, got %q", s.expect, line)
+					return
+				}
+			}
+			if _, err := conn.Write([]byte(s.send)); err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+	return l.Addr().String(), done
+}
+
+// setupSteps are the commands sent after logging in
+func setupSteps() []step {
+	var steps []step
+	for _, command := range setup {
+		steps = append(steps, step{command, ""})
+	}
+	return steps
+}
+
+func TestClient(t *testing.T) {
+	script := []step{
+		{"-", "\n\r\tWelcome to the stand-in Internet Chess Server\n\rlogin: "},
+		{"guest", "\n\rLogging you in as \"GuestWXYZ\"; you may use this name to play unrated games.\n\rPress return to enter the server as \"GuestWXYZ\":"},
+		{"", "\n\r**** Starting FICS session as GuestWXYZ(U) ****\n\r\n\rfics% "},
+	}
+	script = append(script, setupSteps()...)
+	script = append(script,
+		step{"-", "\n\r<sc>\n\r<s> 8 w=Newton ti=00 rt=1650 t=5 i=0 r=u tp=blitz c=? rr=0-9999 a=t f=f\n\r<s> 9 w=Einstein ti=02 rt=1500P t=1 i=0 r=r tp=lightning c=W rr=0-9999 a=f f=f\n\rfics% "},
+		step{"observe 12", "\n\rYou are now observing game 12.\n\rGame 12: alice (1800) bob (1750) rated blitz 5 0\n\r\n\r" +
+			"<12> rnbqkbnr pppppppp -------- -------- ----P--- -------- PPPP-PPP RNBQKBNR B 4 1 1 1 1 0 12 alice bob 0 5 0 39 39 298 300 1 P/e2-e4 (0:02) e4 0 1 0\n\rfics% "},
+		step{"play 8", "\n\r<sr> 8 9\n\r{Game 42 (GuestWXYZ vs. Newton) Creating unrated blitz match.}\n\r\n\r" +
+			"<12> rnbqkbnr pppppppp -------- -------- -------- -------- PPPPPPPP RNBQKBNR W -1 1 1 1 1 0 42 GuestWXYZ Newton 1 5 0 39 39 300 300 1 none (0:00) none 0 0 0\n\rfics% "},
+		step{"e4", "\n\r<12> rnbqkbnr pppppppp -------- -------- ----P--- -------- PPPP-PPP RNBQKBNR B 4 1 1 1 1 0 42 GuestWXYZ Newton -1 5 0 39 39 300 300 1 P/e2-e4 (0:00) e4 0 1 0\n\rfics% " +
+			"\n\r{Game 42 (GuestWXYZ vs. Newton) Newton resigns} 1-0\n\r\n\rNo ratings adjustment done.\n\rfics% "},
+		step{"quit", ""},
+	)
+	addr, done := serve(t, script...)
+
+	c, err := Dial(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handle, err := c.Login("guest", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if handle != "GuestWXYZ" || c.Handle() != "GuestWXYZ" {
+		t.Errorf("expected to be logged in as GuestWXYZ, got %q", handle)
+	}
+
+	var events []string
+	c.OnPosition = func(p *Position) {
+		events = append(events, fmt.Sprintf("position %d %d %s", p.Game, p.Relation, p.Board.FEN()))
+	}
+	c.OnSeek = func(s *Seek) { events = append(events, fmt.Sprintf("seek %d %s", s.ID, s.Player)) }
+	c.OnSeekRemoved = func(id int) { events = append(events, fmt.Sprintf("seek removed %d", id)) }
+	c.OnGame = func(n *GameNotice) {
+		events = append(events, fmt.Sprintf("game %d %s %s", n.Game, n.Message, n.Result))
+	}
+	var lines []string
+	c.OnLine = func(line string) { lines = append(lines, line) }
+
+	ran := make(chan error, 1)
+	go func() { ran <- c.Run() }()
+	for _, err := range []error{c.Observe(12), c.Play(8), c.Move("e4")} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Wait for the server to resign before quitting
+	deadline := time.Now().Add(5 * time.Second)
+	for c.Position(42) != nil || len(c.Seeks()) != 0 || c.Position(12) == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the game to end")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-ran; err != nil && !strings.Contains(err.Error(), "closed") {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"seek 8 Newton",
+		"seek 9 Einstein",
+		"position 12 0 rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1",
+		"seek removed 8",
+		"seek removed 9",
+		"game 42 Creating unrated blitz match. ",
+		"position 42 1 rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+		"position 42 -1 rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1",
+		"game 42 Newton resigns 1-0",
+	}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("expected events\n%s\ngot\n%s", strings.Join(expected, "\n"), strings.Join(events, "\n"))
+	}
+	if len(lines) < 2 || lines[0] != "You are now observing game 12." {
+		t.Errorf("unexpected output %q", lines)
+	}
+}
+
+func TestLogin(t *testing.T) {
+	script := []step{
+		{"-", "login: "},
+		{"newton", "\n\rpassword: "},
+		{"apple", "\n\r**** Starting FICS session as Newton(GM) ****\n\rfics% "},
+	}
+	addr, done := serve(t, append(script, setupSteps()...)...)
+	c, err := Dial(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if handle, err := c.Login("newton", "apple"); err != nil || handle != "Newton" {
+		t.Errorf("expected to be logged in as Newton, got %q, %v", handle, err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	addr, done = serve(t,
+		step{"-", "login: "},
+		step{"newton", "\n\rpassword: "},
+		step{"pear", "\n\r**** Invalid password! ****\n\rlogin: "},
+	)
+	c, err = Dial(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if _, err := c.Login("newton", "pear"); err != ErrLogin {
+		t.Errorf("expected ErrLogin, got %v", err)
+	}
+	<-done
+}
@@ -0,0 +1,118 @@
+package ics
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Seek is an advertisement for a game, as sent with the seekinfo ivariable set, e.g.
+//
+//	<s> 8 w=GuestXYZZ ti=00 rt=1500P t=3 i=0 r=u tp=blitz c=? rr=0-9999 a=t f=f
+type Seek struct {
+	ID         int
+	Player     string
+	Titles     int    // Bit field of the player's titles, e.g. 0x02 for a computer
+	Rating     int    // 0 if the player is unrated
+	RatingType string // "" for an established rating, "E" if estimated and "P" if provisional
+	Time       time.Duration
+	Increment  time.Duration
+	Rated      bool
+	Type       string // e.g. "blitz", "lightning" or "wild/fr"
+	Color      string // Color the player asked for: "W", "B" or "?"
+	MinRating  int
+	MaxRating  int
+	Automatic  bool // The game starts as soon as the seek is accepted
+	Formula    bool // The seeker's formula is checked
+}
+
+// ParseSeek parses a seek
+func ParseSeek(line string) (*Seek, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 || fields[0] != "<s>" {
+		return nil, fmt.Errorf("ics: invalid seek %q", line)
+	}
+	id, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return nil, fmt.Errorf("ics: invalid seek %q", line)
+	}
+	s := &Seek{ID: id}
+	for _, field := range fields[2:] {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("ics: invalid seek field %q", field)
+		}
+		key, value := kv[0], kv[1]
+		switch key {
+		case "w":
+			s.Player = value
+		case "ti":
+			n, err := strconv.ParseInt(value, 16, 32)
+			s.Titles = int(n)
+			if err != nil {
+				return nil, fmt.Errorf("ics: invalid seek field %q", field)
+			}
+		case "rt":
+			if strings.HasSuffix(value, "E") || strings.HasSuffix(value, "P") {
+				value, s.RatingType = value[:len(value)-1], value[len(value)-1:]
+			}
+			s.Rating, err = strconv.Atoi(strings.TrimSpace(value))
+		case "t":
+			var n int
+			n, err = strconv.Atoi(value)
+			s.Time = time.Duration(n) * time.Minute
+		case "i":
+			var n int
+			n, err = strconv.Atoi(value)
+			s.Increment = time.Duration(n) * time.Second
+		case "r":
+			s.Rated = value == "r"
+		case "tp":
+			s.Type = value
+		case "c":
+			s.Color = value
+		case "rr":
+			limits := strings.SplitN(value, "-", 2)
+			if len(limits) != 2 {
+				return nil, fmt.Errorf("ics: invalid seek field %q", field)
+			}
+			if s.MinRating, err = strconv.Atoi(limits[0]); err == nil {
+				s.MaxRating, err = strconv.Atoi(limits[1])
+			}
+		case "a":
+			s.Automatic = value == "t"
+		case "f":
+			s.Formula = value == "t"
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ics: invalid seek field %q", field)
+		}
+	}
+	return s, nil
+}
+
+// GameNotice is a notice about a game the client is playing or observing, e.g.
+//
+//	{Game 12 (Newton vs. Einstein) Creating unrated blitz match.}
+//	{Game 12 (Newton vs. Einstein) Einstein resigns} 1-0
+type GameNotice struct {
+	Game    int
+	White   string
+	Black   string
+	Message string
+	Result  string // "1-0", "0-1", "1/2-1/2" or "*" once the game ends, otherwise empty
+}
+
+var gameNotice = regexp.MustCompile(`^\{Game (\d+) \((\S+) vs\. (\S+)\) ([^}]*)\}\s*(1-0|0-1|1/2-1/2|\*)?`)
+
+// ParseGameNotice parses a game notice
+func ParseGameNotice(line string) (*GameNotice, error) {
+	match := gameNotice.FindStringSubmatch(strings.TrimSpace(line))
+	if match == nil {
+		return nil, fmt.Errorf("ics: invalid game notice %q", line)
+	}
+	id, _ := strconv.Atoi(match[1])
+	return &GameNotice{Game: id, White: match[2], Black: match[3], Message: match[4], Result: match[5]}, nil
+}
@@ -0,0 +1,51 @@
+package ics
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseSeek(t *testing.T) {
+	s, err := ParseSeek("<s> 8 w=GuestXYZZ ti=02 rt=1500P t=3 i=2 r=r tp=blitz c=W rr=1200-1800 a=t f=f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &Seek{
+		ID: 8, Player: "GuestXYZZ", Titles: 2, Rating: 1500, RatingType: "P",
+		Time: 3 * time.Minute, Increment: 2 * time.Second, Rated: true, Type: "blitz",
+		Color: "W", MinRating: 1200, MaxRating: 1800, Automatic: true,
+	}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("expected %+v, got %+v", expected, s)
+	}
+
+	for _, line := range []string{"<s> x w=a", "<s> 8 rt=abc", "<s> 8 rr=1200", "<s> 8 w"} {
+		if _, err := ParseSeek(line); err == nil {
+			t.Errorf("expected an error parsing %q", line)
+		}
+	}
+}
+
+func TestParseGameNotice(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected GameNotice
+	}{
+		{"{Game 12 (Newton vs. Einstein) Creating unrated blitz match.}", GameNotice{12, "Newton", "Einstein", "Creating unrated blitz match.", ""}},
+		{"{Game 12 (Newton vs. Einstein) Einstein resigns} 1-0", GameNotice{12, "Newton", "Einstein", "Einstein resigns", "1-0"}},
+		{"{Game 3 (a vs. b) Game drawn by repetition} 1/2-1/2", GameNotice{3, "a", "b", "Game drawn by repetition", "1/2-1/2"}},
+		{"{Game 3 (a vs. b) Game aborted on move 1} *", GameNotice{3, "a", "b", "Game aborted on move 1", "*"}},
+	}
+	for _, test := range tests {
+		n, err := ParseGameNotice(test.line)
+		if err != nil {
+			t.Error(err)
+		} else if *n != test.expected {
+			t.Errorf("%s: expected %+v, got %+v", test.line, test.expected, *n)
+		}
+	}
+	if _, err := ParseGameNotice("{Game twelve}"); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -0,0 +1,179 @@
+package ics
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// Relation is how the client is involved in a game, as given by style 12
+type Relation int
+
+const (
+	Isolated          Relation = -3 // A position sent by a command such as refresh
+	ObservingExamined Relation = -2 // Observing a game being examined
+	OpponentsMove     Relation = -1 // Playing, with the opponent to move
+	Observing         Relation = 0  // Observing a game being played
+	MyMove            Relation = 1  // Playing, with the client to move
+	Examining         Relation = 2  // Examining the game
+)
+
+// Position is a board update in style 12, sent when a game starts, after each move and
+// when asked to refresh
+type Position struct {
+	Board       *chess.Board
+	Game        int
+	White       string
+	Black       string
+	Relation    Relation
+	Time        time.Duration // Initial time of each player
+	Increment   time.Duration
+	WhiteMat    int // Material strength, by the usual piece values
+	BlackMat    int
+	WhiteClock  time.Duration // Time remaining, which is negative once a player's flag falls
+	BlackClock  time.Duration
+	MoveNumber  int           // Number of the move about to be made
+	LastMove    string        // Previous move in verbose notation, e.g. "P/c2-c4", or "none"
+	LastMoveSAN string        // Previous move in SAN, e.g. "c4", or "none"
+	MoveTime    time.Duration // Time taken by the previous move
+	Flipped     bool          // The board is shown from black's side
+	Ticking     bool          // The clock of the side to move is running
+}
+
+// ParseStyle12 parses a board update in style 12, e.g.
+//
+//	<12> rnbqkbnr pppppppp -------- -------- ----P--- -------- PPPP-PPP RNBQKBNR B 4 1 1 1 1 0 7 Newton Einstein 1 2 12 39 39 119 122 1 P/e2-e4 (0:06) e4 0 1 0
+//
+// The ranks are listed from the 8th, followed by the side to move, the file of a pawn
+// which just advanced two squares or -1, the castling rights, the halfmove clock, the
+// game number, the players, the relation, the time control, the material, the clocks
+// in seconds, the move number, the last move and its time, whether the board is
+// flipped and optionally whether the clock is ticking.
+func ParseStyle12(line string) (*Position, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 31 || fields[0] != "<12>" {
+		return nil, fmt.Errorf("ics: invalid style 12 %q", line)
+	}
+	fields = fields[1:]
+
+	// The ranks, side to move and castling rights become a FEN for the chess package
+	var fen strings.Builder
+	for rank := 0; rank < 8; rank++ {
+		row := fields[rank]
+		if len(row) != 8 {
+			return nil, fmt.Errorf("ics: invalid rank %q in style 12", row)
+		}
+		empty := 0
+		for _, r := range row {
+			if r == '-' {
+				empty++
+				continue
+			}
+			if empty > 0 {
+				fen.WriteString(strconv.Itoa(empty))
+				empty = 0
+			}
+			fen.WriteRune(r)
+		}
+		if empty > 0 {
+			fen.WriteString(strconv.Itoa(empty))
+		}
+		if rank < 7 {
+			fen.WriteByte('/')
+		}
+	}
+
+	var turn string
+	switch fields[8] {
+	case "W":
+		turn = "w"
+	case "B":
+		turn = "b"
+	default:
+		return nil, fmt.Errorf("ics: invalid side to move %q in style 12", fields[8])
+	}
+
+	ints := make([]int, len(fields))
+	for _, i := range []int{9, 10, 11, 12, 13, 14, 15, 18, 19, 20, 21, 22, 23, 24, 25, 29} {
+		n, err := strconv.Atoi(fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("ics: invalid field %d %q in style 12", i+1, fields[i])
+		}
+		ints[i] = n
+	}
+
+	castling := ""
+	for i, right := range "KQkq" {
+		if ints[10+i] == 1 {
+			castling += string(right)
+		}
+	}
+	if castling == "" {
+		castling = "-"
+	}
+	ep := "-"
+	if file := ints[9]; file >= 0 && file < 8 {
+		ep = string(rune('a'+file)) + map[string]string{"w": "6", "b": "3"}[turn]
+	}
+	moveNumber := ints[25]
+	if moveNumber < 1 {
+		moveNumber = 1
+	}
+
+	board, err := chess.ParseFEN(fmt.Sprintf("%s %s %s %s %d %d", fen.String(), turn, castling, ep, ints[14], moveNumber))
+	if err != nil {
+		return nil, fmt.Errorf("ics: invalid style 12 position: %s", err)
+	}
+	moveTime, err := parseMoveTime(fields[27])
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Position{
+		Board:       board,
+		Game:        ints[15],
+		White:       fields[16],
+		Black:       fields[17],
+		Relation:    Relation(ints[18]),
+		Time:        time.Duration(ints[19]) * time.Minute,
+		Increment:   time.Duration(ints[20]) * time.Second,
+		WhiteMat:    ints[21],
+		BlackMat:    ints[22],
+		WhiteClock:  time.Duration(ints[23]) * time.Second,
+		BlackClock:  time.Duration(ints[24]) * time.Second,
+		MoveNumber:  ints[25],
+		LastMove:    fields[26],
+		LastMoveSAN: fields[28],
+		MoveTime:    moveTime,
+		Flipped:     ints[29] == 1,
+		Ticking:     true,
+	}
+	if len(fields) > 30 {
+		p.Ticking = fields[30] != "0"
+	}
+	return p, nil
+}
+
+// parseMoveTime parses the time a move took, e.g. "(0:06)" or "(1:02.345)"
+func parseMoveTime(s string) (time.Duration, error) {
+	invalid := fmt.Errorf("ics: invalid move time %q in style 12", s)
+	if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") {
+		return 0, invalid
+	}
+	parts := strings.SplitN(s[1:len(s)-1], ":", 2)
+	if len(parts) != 2 {
+		return 0, invalid
+	}
+	minutes, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, invalid
+	}
+	seconds, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return 0, invalid
+	}
+	return time.Duration(minutes)*time.Minute + time.Duration(seconds*float64(time.Second)), nil
+}
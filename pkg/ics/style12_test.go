@@ -0,0 +1,63 @@
+package ics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+func TestParseStyle12(t *testing.T) {
+	p, err := ParseStyle12("<12> rnbqkbnr pppppppp -------- -------- ----P--- -------- PPPP-PPP RNBQKBNR B 4 1 1 1 1 0 7 Newton Einstein 1 2 12 39 39 119 122 1 P/e2-e4 (0:06.250) e4 0 1 0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fen := p.Board.FEN(); fen != "rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1" {
+		t.Errorf("unexpected position %s", fen)
+	}
+	if p.Board.Turn != chess.BLACK {
+		t.Error("expected black to move")
+	}
+	if p.Game != 7 || p.White != "Newton" || p.Black != "Einstein" || p.Relation != MyMove {
+		t.Errorf("unexpected game %d %s-%s %d", p.Game, p.White, p.Black, p.Relation)
+	}
+	if p.Time != 2*time.Minute || p.Increment != 12*time.Second || p.WhiteMat != 39 || p.BlackMat != 39 {
+		t.Errorf("unexpected time control %s+%s or material %d-%d", p.Time, p.Increment, p.WhiteMat, p.BlackMat)
+	}
+	if p.WhiteClock != 119*time.Second || p.BlackClock != 122*time.Second || p.MoveTime != 6250*time.Millisecond {
+		t.Errorf("unexpected clocks %s %s %s", p.WhiteClock, p.BlackClock, p.MoveTime)
+	}
+	if p.MoveNumber != 1 || p.LastMove != "P/e2-e4" || p.LastMoveSAN != "e4" || p.Flipped || !p.Ticking {
+		t.Errorf("unexpected move %d %s %s %v %v", p.MoveNumber, p.LastMove, p.LastMoveSAN, p.Flipped, p.Ticking)
+	}
+
+	// Castling rights which have been lost and a flag which has fallen
+	p, err = ParseStyle12("<12> r---k--r pppq-ppp --np-n-- --b-p--- --B-P-b- --NP-N-- PPPQ-PPP R---K--R W -1 0 1 1 0 4 42 alice bob -1 5 0 37 37 -3 41 9 B/f8-c5 (0:03) Bc5 1 0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fen := p.Board.FEN(); fen != "r3k2r/pppq1ppp/2np1n2/2b1p3/2B1P1b1/2NP1N2/PPPQ1PPP/R3K2R w Qk - 4 9" {
+		t.Errorf("unexpected position %s", fen)
+	}
+	if p.WhiteClock != -3*time.Second || p.Relation != OpponentsMove || !p.Flipped || p.Ticking {
+		t.Errorf("unexpected clock %s, relation %d, flipped %v or ticking %v", p.WhiteClock, p.Relation, p.Flipped, p.Ticking)
+	}
+	if _, err := p.Board.ParseMove("O-O"); err == nil {
+		t.Error("expected white to have lost the right to castle short")
+	}
+	if _, err := p.Board.ParseMove("O-O-O"); err != nil {
+		t.Error(err)
+	}
+
+	for _, line := range []string{
+		"<12> rnbqkbnr",
+		"<12> rnbqkbnr pppppppp -------- -------- ----P--- -------- PPPP-PPP RNBQKBNR X 4 1 1 1 1 0 7 Newton Einstein 1 2 12 39 39 119 122 1 P/e2-e4 (0:06) e4 0 1 0",
+		"<12> rnbqkbnr pppppppp -------- -------- ----P--- ------- PPPP-PPP RNBQKBNR B 4 1 1 1 1 0 7 Newton Einstein 1 2 12 39 39 119 122 1 P/e2-e4 (0:06) e4 0 1 0",
+		"<12> rnbqkbnr pppppppp -------- -------- ----P--- -------- PPPP-PPP RNBQKBNR B 4 1 1 1 1 0 x Newton Einstein 1 2 12 39 39 119 122 1 P/e2-e4 (0:06) e4 0 1 0",
+		"<12> rnbqkbnr pppppppp -------- -------- ----P--- -------- PPPP-PPP RNBQKBNR B 4 1 1 1 1 0 7 Newton Einstein 1 2 12 39 39 119 122 1 P/e2-e4 0:06 e4 0 1 0",
+	} {
+		if _, err := ParseStyle12(line); err == nil {
+			t.Errorf("expected an error parsing %q", line)
+		}
+	}
+}
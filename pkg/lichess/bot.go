@@ -0,0 +1,229 @@
+package lichess
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/clock"
+	"github.com/aaronireland/go-chess/pkg/engine"
+)
+
+// Player chooses the moves a bot plays
+type Player interface {
+	// Move returns the move to play on the board, which has the game's moves played on
+	// it and may be changed
+	Move(ctx context.Context, board *chess.Board, state GameState) (chess.Move, error)
+}
+
+// PlayerFunc is a function that chooses moves
+type PlayerFunc func(ctx context.Context, board *chess.Board, state GameState) (chess.Move, error)
+
+// Move calls the function
+func (f PlayerFunc) Move(ctx context.Context, board *chess.Board, state GameState) (chess.Move, error) {
+	return f(ctx, board, state)
+}
+
+// EnginePlayer returns a player which searches with the engine at a level from 1 to
+// engine.MaxLevel, thinking for no longer than the clock allows
+func EnginePlayer(level int) Player {
+	return PlayerFunc(func(ctx context.Context, board *chess.Board, state GameState) (chess.Move, error) {
+		e, limits := engine.Level(level)
+		remaining, increment := state.WTime, state.WInc
+		if board.Turn == chess.BLACK {
+			remaining, increment = state.BTime, state.BInc
+		}
+		if remaining > 0 {
+			budget := clock.DefaultAllocator.Allocate(time.Duration(remaining)*time.Millisecond, time.Duration(increment)*time.Millisecond, 0)
+			if budget.Optimum > 0 && budget.Optimum < limits.MoveTime {
+				limits.MoveTime = budget.Optimum
+			}
+		}
+		result, err := e.Search(ctx, board, limits)
+		return result.Move, err
+	})
+}
+
+// Bot plays games on Lichess, accepting the challenges it's sent and answering with the
+// moves of its player
+type Bot struct {
+	Client *Client
+	Player Player
+	// Accept decides whether to accept a challenge, giving the reason for declining it
+	// otherwise (see DeclineChallenge). If nil, challenges in every variant the chess
+	// package supports are accepted.
+	Accept func(Challenge) (bool, string)
+	// Logf logs what the bot does, if not nil
+	Logf func(format string, args ...interface{})
+
+	id string
+	wg sync.WaitGroup
+}
+
+// Run answers challenges and plays games until the event stream ends or the context is
+// done, then waits for the games being played to end
+func (b *Bot) Run(ctx context.Context) error {
+	account, err := b.Client.Account(ctx)
+	if err != nil {
+		return err
+	}
+	b.id = account.ID
+	defer b.wg.Wait()
+
+	return b.Client.StreamEvents(ctx, func(e Event) error {
+		switch {
+		case e.Type == "challenge" && e.Challenge != nil:
+			b.challenge(ctx, *e.Challenge)
+		case e.Type == "gameStart" && e.Game != nil:
+			b.logf("game %s started against %s", e.Game.ID, e.Game.Opponent.Username)
+			b.wg.Add(1)
+			go func(id string) {
+				defer b.wg.Done()
+				if err := b.Play(ctx, id); err != nil {
+					b.logf("game %s: %s", id, err)
+				}
+			}(e.Game.ID)
+		case e.Type == "gameFinish" && e.Game != nil:
+			b.logf("game %s finished", e.Game.ID)
+		}
+		return nil
+	})
+}
+
+// challenge accepts or declines a challenge sent to the bot
+func (b *Bot) challenge(ctx context.Context, c Challenge) {
+	if c.Challenger.ID == b.id {
+		return // The bot's own challenge to someone else
+	}
+	accept, reason := supported(c)
+	if b.Accept != nil {
+		accept, reason = b.Accept(c)
+	}
+	var err error
+	if accept {
+		b.logf("accepting challenge %s from %s", c.ID, c.Challenger.ID)
+		err = b.Client.AcceptChallenge(ctx, c.ID)
+	} else {
+		b.logf("declining challenge %s from %s: %s", c.ID, c.Challenger.ID, reason)
+		err = b.Client.DeclineChallenge(ctx, c.ID, reason)
+	}
+	if err != nil {
+		b.logf("challenge %s: %s", c.ID, err)
+	}
+}
+
+// supported accepts challenges in the variants the chess package supports
+func supported(c Challenge) (bool, string) {
+	if c.Variant.Key == "chess960" {
+		return true, "" // Each game's starting position comes with it
+	}
+	if _, err := startingPosition(c.Variant, ""); err != nil {
+		return false, "variant"
+	}
+	return true, ""
+}
+
+// Play plays a game until it's over, moving whenever it's the bot's turn. Run plays
+// every game the bot starts, so Play is only needed to rejoin a game.
+func (b *Bot) Play(ctx context.Context, gameID string) error {
+	if b.id == "" {
+		account, err := b.Client.Account(ctx)
+		if err != nil {
+			return err
+		}
+		b.id = account.ID
+	}
+
+	var start *chess.Board
+	var color chess.Color
+	answered := -1 // Ply of the last position the bot moved in
+	return b.Client.StreamGame(ctx, gameID, func(e GameEvent) error {
+		switch e.Type {
+		case "gameFull":
+			var err error
+			if start, err = startingPosition(e.Variant, e.InitialFEN); err != nil {
+				return err
+			}
+			color = chess.WHITE
+			if e.Black.ID == b.id {
+				color = chess.BLACK
+			}
+			return b.move(ctx, gameID, start, color, e.State, &answered)
+		case "gameState":
+			if start == nil {
+				return fmt.Errorf("game state received before the game")
+			}
+			return b.move(ctx, gameID, start, color, e.GameState, &answered)
+		}
+		return nil
+	})
+}
+
+// move plays the player's move if it's the bot's turn in a game in progress and it
+// hasn't already moved at this ply, as Lichess sends the state again for draw offers and
+// the like. A move Lichess rejects is logged and tried again on the next state.
+func (b *Bot) move(ctx context.Context, gameID string, start *chess.Board, color chess.Color, state GameState, answered *int) error {
+	if state.Status != "started" {
+		return nil
+	}
+	board := start.Copy()
+	moves := strings.Fields(state.Moves)
+	for _, uci := range moves {
+		m, err := board.ParseUCI(uci)
+		if err != nil {
+			return err
+		}
+		board.MakeMove(m)
+	}
+	if board.Turn != color || len(moves) == *answered {
+		return nil
+	}
+
+	m, err := b.Player.Move(ctx, board.Copy(), state)
+	if err != nil {
+		return err
+	}
+	b.logf("game %s: playing %s", gameID, board.SAN(m))
+	if err := b.Client.MakeMove(ctx, gameID, m.UCI(), false); err != nil {
+		b.logf("game %s: %s was rejected: %s", gameID, board.SAN(m), err)
+		return nil
+	}
+	*answered = len(moves)
+	return nil
+}
+
+// startingPosition returns a board set up for a variant from a FEN, or "startpos" or an
+// empty string for the variant's starting position
+func startingPosition(variant Variant, fen string) (*chess.Board, error) {
+	if fen == "startpos" {
+		fen = ""
+	}
+	switch variant.Key {
+	case "", "standard", "fromPosition":
+		return chess.NewBoardWith(chess.WithFEN(fen))
+	case "chess960":
+		if fen == "" {
+			return nil, fmt.Errorf("chess960 game without a starting position")
+		}
+		board, err := chess.ParseFEN(fen)
+		if err != nil {
+			return nil, err
+		}
+		board.Chess960 = true
+		return board, nil
+	}
+	v, err := chess.VariantByName(variant.Key)
+	if err != nil {
+		return nil, err
+	}
+	return chess.NewBoardWith(chess.WithVariant(v), chess.WithFEN(fen))
+}
+
+func (b *Bot) logf(format string, args ...interface{}) {
+	if b.Logf != nil {
+		b.Logf(format, args...)
+	}
+}
@@ -0,0 +1,149 @@
+package lichess_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/lichess"
+	"github.com/aaronireland/go-chess/pkg/lichess/lichesstest"
+)
+
+const events = `
+{"type":"challenge","challenge":{"id":"c1","status":"created","challenger":{"id":"alice","name":"Alice"},"destUser":{"id":"gobot","name":"GoBot"},"variant":{"key":"standard","name":"Standard"},"rated":false,"speed":"blitz","timeControl":{"type":"clock","limit":300,"increment":2},"color":"white"}}
+
+{"type":"challenge","challenge":{"id":"c2","status":"created","challenger":{"id":"bob","name":"Bob"},"destUser":{"id":"gobot","name":"GoBot"},"variant":{"key":"shogi","name":"Shogi"},"speed":"blitz","timeControl":{"type":"clock","limit":300,"increment":0},"color":"random"}}
+{"type":"challenge","challenge":{"id":"c3","status":"created","challenger":{"id":"gobot","name":"GoBot"},"destUser":{"id":"carol","name":"Carol"},"variant":{"key":"standard","name":"Standard"},"speed":"blitz","color":"random"}}
+{"type":"gameStart","game":{"gameId":"g1","fullId":"g1abcd","color":"black","fen":"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1","isMyTurn":false,"opponent":{"id":"alice","username":"Alice"},"variant":{"key":"standard","name":"Standard"}}}
+`
+
+// g1 is fool's mate with the bot, black, delivering it
+const g1 = `
+{"type":"gameFull","id":"g1","variant":{"key":"standard","name":"Standard"},"speed":"blitz","rated":false,"white":{"id":"alice","name":"Alice"},"black":{"id":"gobot","name":"GoBot"},"initialFen":"startpos","state":{"type":"gameState","moves":"f2f3","wtime":300000,"btime":300000,"winc":2000,"binc":2000,"status":"started"}}
+
+{"type":"gameState","moves":"f2f3 e7e5","wtime":300000,"btime":298000,"winc":2000,"binc":2000,"status":"started"}
+{"type":"chatLine","username":"alice","text":"good luck","room":"player"}
+{"type":"gameState","moves":"f2f3 e7e5 g2g4","wtime":297000,"btime":298000,"winc":2000,"binc":2000,"status":"started"}
+
+{"type":"gameState","moves":"f2f3 e7e5 g2g4 d8h4","wtime":297000,"btime":297000,"winc":2000,"binc":2000,"status":"mate","winner":"black"}
+`
+
+func TestBot(t *testing.T) {
+	s := lichesstest.NewServer(lichess.User{ID: "gobot", Username: "GoBot", Title: "BOT"})
+	defer s.Close()
+	s.Token = "lip_secret"
+	s.AddEvents(events)
+	s.AddGame("g1", g1)
+
+	var asked []string
+	bot := &lichess.Bot{
+		Client: s.Client(),
+		Player: lichess.PlayerFunc(func(ctx context.Context, board *chess.Board, state lichess.GameState) (chess.Move, error) {
+			asked = append(asked, state.Moves)
+			reply := map[string]string{"f2f3": "e7e5", "f2f3 e7e5 g2g4": "d8h4"}[state.Moves]
+			return board.ParseMove(reply)
+		}),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := bot.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	var requests []string
+	for _, r := range s.Requests() {
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.Path, r.Form.Encode()))
+	}
+	expected := []string{
+		"POST /api/challenge/c1/accept ",
+		"POST /api/challenge/c2/decline reason=variant",
+		"POST /api/bot/game/g1/move/e7e5 ",
+		"POST /api/bot/game/g1/move/d8h4 ",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %q, got %q", expected, requests)
+	}
+	if expected := []string{"f2f3", "f2f3 e7e5 g2g4"}; !reflect.DeepEqual(asked, expected) {
+		t.Errorf("expected the player to be asked for %q, got %q", expected, asked)
+	}
+}
+
+// g2 repeats states the bot has already answered, and rejects its first try at move 2
+const g2 = `
+{"type":"gameFull","id":"g2","variant":{"key":"standard","name":"Standard"},"speed":"blitz","rated":false,"white":{"id":"gobot","name":"GoBot"},"black":{"id":"alice","name":"Alice"},"initialFen":"startpos","state":{"type":"gameState","moves":"","wtime":300000,"btime":300000,"winc":2000,"binc":2000,"status":"started"}}
+{"type":"gameState","moves":"","wtime":300000,"btime":300000,"winc":2000,"binc":2000,"status":"started","bdraw":true}
+{"type":"gameState","moves":"e2e4 e7e5","wtime":298000,"btime":300000,"winc":2000,"binc":2000,"status":"started"}
+{"type":"gameState","moves":"e2e4 e7e5","wtime":298000,"btime":300000,"winc":2000,"binc":2000,"status":"started","bdraw":true}
+{"type":"gameState","moves":"e2e4 e7e5 g1f3","wtime":297000,"btime":300000,"winc":2000,"binc":2000,"status":"resign","winner":"white"}
+`
+
+func TestBotRepeatedStates(t *testing.T) {
+	s := lichesstest.NewServer(lichess.User{ID: "gobot", Username: "GoBot", Title: "BOT"})
+	defer s.Close()
+	s.AddGame("g2", g2)
+	s.Reject = []string{"d2d4"}
+
+	var asked, logged []string
+	replies := []string{"e4", "d4", "Nf3"}
+	bot := &lichess.Bot{
+		Client: s.Client(),
+		Player: lichess.PlayerFunc(func(ctx context.Context, board *chess.Board, state lichess.GameState) (chess.Move, error) {
+			asked = append(asked, state.Moves)
+			reply := replies[0]
+			replies = replies[1:]
+			return board.ParseMove(reply)
+		}),
+		Logf: func(format string, args ...interface{}) {
+			logged = append(logged, fmt.Sprintf(format, args...))
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := bot.Play(ctx, "g2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []string{"", "e2e4 e7e5", "e2e4 e7e5"}; !reflect.DeepEqual(asked, expected) {
+		t.Errorf("expected the player to be asked for %q, got %q", expected, asked)
+	}
+	var moves []string
+	for _, r := range s.Requests() {
+		moves = append(moves, r.Path)
+	}
+	expected := []string{"/api/bot/game/g2/move/e2e4", "/api/bot/game/g2/move/d2d4", "/api/bot/game/g2/move/g1f3"}
+	if !reflect.DeepEqual(moves, expected) {
+		t.Errorf("expected requests %q, got %q", expected, moves)
+	}
+	if len(logged) != 4 || !strings.HasPrefix(logged[2], "game g2: d4 was rejected: ") {
+		t.Errorf("expected the rejected move to be logged, got %q", logged)
+	}
+}
+
+func TestBotUnauthorized(t *testing.T) {
+	s := lichesstest.NewServer(lichess.User{ID: "gobot"})
+	defer s.Close()
+	s.Token = "lip_secret"
+
+	client := s.Client()
+	client.Token = "lip_wrong"
+	err := (&lichess.Bot{Client: client}).Run(context.Background())
+	if apiErr, ok := err.(*lichess.APIError); !ok || apiErr.Status != 401 {
+		t.Errorf("expected a 401 API error, got %v", err)
+	}
+}
+
+func TestEnginePlayer(t *testing.T) {
+	board, _ := chess.ParseFEN("rnbqkbnr/pppp1ppp/8/4p3/6P1/5P2/PPPPP2P/RNBQKBNR b KQkq - 0 2")
+	state := lichess.GameState{Moves: "f2f3 e7e5 g2g4", WTime: 60000, BTime: 60000, Status: "started"}
+	m, err := lichess.EnginePlayer(8).Move(context.Background(), board, state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.UCI() != "d8h4" {
+		t.Errorf("expected d8h4, got %s", m.UCI())
+	}
+}
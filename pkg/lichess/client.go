@@ -0,0 +1,304 @@
+// Package lichess is a client of the Lichess Bot API, see <https://lichess.org/api#tag/Bot>.
+//
+// Bots receive their challenges and games on a stream of events and each game's moves
+// on a stream of its own. Both are newline delimited JSON (NDJSON) which Lichess keeps
+// alive with empty lines. A Bot ties the streams together, accepting challenges and
+// answering with the moves a Player chooses on a chess.Board.
+package lichess
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultURL is the address of the Lichess API
+const DefaultURL = "https://lichess.org"
+
+// Client makes requests to the Lichess API on behalf of a bot account
+type Client struct {
+	BaseURL string       // Address of the API, DefaultURL unless testing
+	Token   string       // Personal API access token with the bot:play scope
+	HTTP    *http.Client // http.DefaultClient if nil
+}
+
+// NewClient returns a client of the Lichess API authenticated with a token
+func NewClient(token string) *Client {
+	return &Client{BaseURL: DefaultURL, Token: token}
+}
+
+// APIError is an error response from the API
+type APIError struct {
+	Status  int    // HTTP status code
+	Message string // Lichess's description of the error
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("lichess: %d %s", e.Status, e.Message)
+}
+
+// User is a Lichess account
+type User struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"` // Game streams name players rather than giving usernames
+	Title    string `json:"title"`
+	Rating   int    `json:"rating"`
+}
+
+// Variant is the variant a challenge or game is played under, e.g. {"kingOfTheHill",
+// "King of the Hill"}
+type Variant struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// TimeControl is the time control of a challenge. Type is "clock", "correspondence" or
+// "unlimited"; Limit and Increment are in seconds.
+type TimeControl struct {
+	Type        string `json:"type"`
+	Limit       int    `json:"limit"`
+	Increment   int    `json:"increment"`
+	DaysPerTurn int    `json:"daysPerTurn"`
+}
+
+// Challenge is an invitation to play a game
+type Challenge struct {
+	ID          string      `json:"id"`
+	URL         string      `json:"url"`
+	Status      string      `json:"status"`
+	Challenger  User        `json:"challenger"`
+	DestUser    *User       `json:"destUser"`
+	Variant     Variant     `json:"variant"`
+	Rated       bool        `json:"rated"`
+	Speed       string      `json:"speed"`
+	TimeControl TimeControl `json:"timeControl"`
+	Color       string      `json:"color"` // Color the challenger asked for: "white", "black" or "random"
+	InitialFEN  string      `json:"initialFen"`
+}
+
+// GameStart describes a game the bot is playing, as sent when it starts or finishes
+type GameStart struct {
+	ID       string  `json:"gameId"`
+	FullID   string  `json:"fullId"`
+	Color    string  `json:"color"`
+	FEN      string  `json:"fen"`
+	IsMyTurn bool    `json:"isMyTurn"`
+	LastMove string  `json:"lastMove"`
+	Opponent User    `json:"opponent"`
+	Variant  Variant `json:"variant"`
+	Speed    string  `json:"speed"`
+	Rated    bool    `json:"rated"`
+}
+
+// Event is an event on the bot's stream: "challenge", "challengeCanceled",
+// "challengeDeclined", "gameStart" or "gameFinish"
+type Event struct {
+	Type      string     `json:"type"`
+	Challenge *Challenge `json:"challenge"`
+	Game      *GameStart `json:"game"`
+}
+
+// GameState is the moves and clocks of a game, in milliseconds. Status is "started"
+// while the game is in progress; Winner is "white" or "black" once it's won.
+type GameState struct {
+	Moves  string `json:"moves"` // Moves in UCI separated by spaces
+	WTime  int64  `json:"wtime"`
+	BTime  int64  `json:"btime"`
+	WInc   int64  `json:"winc"`
+	BInc   int64  `json:"binc"`
+	Status string `json:"status"`
+	Winner string `json:"winner"`
+	WDraw  bool   `json:"wdraw"` // White is offering a draw
+	BDraw  bool   `json:"bdraw"`
+}
+
+// GameEvent is an event on a game's stream. A stream starts with "gameFull", holding
+// the players, starting position and state of the game, and is followed by "gameState"
+// after each move and change of status, "chatLine" and "opponentGone".
+type GameEvent struct {
+	Type string `json:"type"`
+
+	// gameFull
+	ID         string    `json:"id"`
+	Variant    Variant   `json:"variant"`
+	Speed      string    `json:"speed"`
+	Rated      bool      `json:"rated"`
+	White      User      `json:"white"`
+	Black      User      `json:"black"`
+	InitialFEN string    `json:"initialFen"` // "startpos" for the variant's starting position
+	State      GameState `json:"state"`
+
+	// gameState
+	GameState
+
+	// chatLine
+	Username string `json:"username"`
+	Text     string `json:"text"`
+	Room     string `json:"room"`
+
+	// opponentGone
+	Gone              bool `json:"gone"`
+	ClaimWinInSeconds int  `json:"claimWinInSeconds"`
+}
+
+// Account returns the bot's account
+func (c *Client) Account(ctx context.Context) (*User, error) {
+	res, err := c.do(ctx, http.MethodGet, "/api/account", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	var user User
+	if err := json.NewDecoder(res.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("lichess: invalid account: %s", err)
+	}
+	return &user, nil
+}
+
+// StreamEvents calls fn with each event on the bot's stream until the stream ends, the
+// context is done or fn returns an error
+func (c *Client) StreamEvents(ctx context.Context, fn func(Event) error) error {
+	return c.stream(ctx, "/api/stream/event", func(line []byte) error {
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			return fmt.Errorf("lichess: invalid event: %s", err)
+		}
+		return fn(e)
+	})
+}
+
+// StreamGame calls fn with each event on a game's stream until the stream ends, the
+// context is done or fn returns an error
+func (c *Client) StreamGame(ctx context.Context, gameID string, fn func(GameEvent) error) error {
+	return c.stream(ctx, "/api/bot/game/stream/"+url.PathEscape(gameID), func(line []byte) error {
+		var e GameEvent
+		if err := json.Unmarshal(line, &e); err != nil {
+			return fmt.Errorf("lichess: invalid game event: %s", err)
+		}
+		return fn(e)
+	})
+}
+
+// AcceptChallenge accepts a challenge, starting the game
+func (c *Client) AcceptChallenge(ctx context.Context, id string) error {
+	return c.post(ctx, "/api/challenge/"+url.PathEscape(id)+"/accept", nil)
+}
+
+// DeclineChallenge declines a challenge, giving a reason such as "generic", "variant" or
+// "timeControl", see the API for the full list
+func (c *Client) DeclineChallenge(ctx context.Context, id, reason string) error {
+	form := url.Values{}
+	if reason != "" {
+		form.Set("reason", reason)
+	}
+	return c.post(ctx, "/api/challenge/"+url.PathEscape(id)+"/decline", form)
+}
+
+// MakeMove plays a move in UCI, optionally offering or accepting a draw
+func (c *Client) MakeMove(ctx context.Context, gameID, move string, offeringDraw bool) error {
+	path := "/api/bot/game/" + url.PathEscape(gameID) + "/move/" + url.PathEscape(move)
+	if offeringDraw {
+		path += "?offeringDraw=true"
+	}
+	return c.post(ctx, path, nil)
+}
+
+// Resign resigns a game
+func (c *Client) Resign(ctx context.Context, gameID string) error {
+	return c.post(ctx, "/api/bot/game/"+url.PathEscape(gameID)+"/resign", nil)
+}
+
+// Abort aborts a game before both players have moved
+func (c *Client) Abort(ctx context.Context, gameID string) error {
+	return c.post(ctx, "/api/bot/game/"+url.PathEscape(gameID)+"/abort", nil)
+}
+
+// Chat writes in a game's chat room, "player" or "spectator"
+func (c *Client) Chat(ctx context.Context, gameID, room, text string) error {
+	return c.post(ctx, "/api/bot/game/"+url.PathEscape(gameID)+"/chat", url.Values{"room": {room}, "text": {text}})
+}
+
+// post sends a form and discards the response
+func (c *Client) post(ctx context.Context, path string, form url.Values) error {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	res, err := c.do(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return err
+	}
+	io.Copy(ioutil.Discard, res.Body)
+	return res.Body.Close()
+}
+
+// stream calls fn with each line of an NDJSON response, skipping keep-alives
+func (c *Client) stream(ctx context.Context, path string, fn func([]byte) error) error {
+	res, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1<<20)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+		if err := fn(line); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// do sends an authenticated request, returning an APIError for unsuccessful responses
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	base := c.BaseURL
+	if base == "" {
+		base = DefaultURL
+	}
+	req, err := http.NewRequest(method, strings.TrimRight(base, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	client := c.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		defer res.Body.Close()
+		e := &APIError{Status: res.StatusCode, Message: http.StatusText(res.StatusCode)}
+		var reply struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(res.Body).Decode(&reply) == nil && reply.Error != "" {
+			e.Message = reply.Error
+		}
+		return nil, e
+	}
+	return res, nil
+}
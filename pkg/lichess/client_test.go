@@ -0,0 +1,131 @@
+package lichess
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClientRequests(t *testing.T) {
+	type request struct {
+		method, path, auth, body string
+	}
+	var requests []request
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, request{r.Method, r.URL.RequestURI(), r.Header.Get("Authorization"), string(body)})
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	defer ts.Close()
+
+	c := &Client{BaseURL: ts.URL, Token: "lip_secret"}
+	ctx := context.Background()
+	for _, err := range []error{
+		c.AcceptChallenge(ctx, "c1"),
+		c.DeclineChallenge(ctx, "c2", "timeControl"),
+		c.MakeMove(ctx, "g1", "e7e5", false),
+		c.MakeMove(ctx, "g1", "e1g1", true),
+		c.Resign(ctx, "g1"),
+		c.Abort(ctx, "g2"),
+		c.Chat(ctx, "g1", "player", "good game"),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := []request{
+		{"POST", "/api/challenge/c1/accept", "Bearer lip_secret", ""},
+		{"POST", "/api/challenge/c2/decline", "Bearer lip_secret", "reason=timeControl"},
+		{"POST", "/api/bot/game/g1/move/e7e5", "Bearer lip_secret", ""},
+		{"POST", "/api/bot/game/g1/move/e1g1?offeringDraw=true", "Bearer lip_secret", ""},
+		{"POST", "/api/bot/game/g1/resign", "Bearer lip_secret", ""},
+		{"POST", "/api/bot/game/g2/abort", "Bearer lip_secret", ""},
+		{"POST", "/api/bot/game/g1/chat", "Bearer lip_secret", "room=player&text=good+game"},
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestClientStream(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"type":"gameFull","id":"g1","variant":{"key":"standard","name":"Standard"},` +
+			`"white":{"id":"alice","name":"Alice"},"black":{"id":"bot","name":"Bot"},"initialFen":"startpos",` +
+			`"state":{"type":"gameState","moves":"e2e4","wtime":60000,"btime":60000,"winc":2000,"binc":2000,"status":"started"}}` + "\n\n" +
+			`{"type":"chatLine","username":"alice","text":"hi","room":"player"}` + "\n" +
+			`{"type":"gameState","moves":"e2e4 e7e5","wtime":58000,"btime":59000,"winc":2000,"binc":2000,"status":"started","wdraw":true}` + "\n"))
+	}))
+	defer ts.Close()
+
+	var events []GameEvent
+	err := (&Client{BaseURL: ts.URL}).StreamGame(context.Background(), "g1", func(e GameEvent) error {
+		events = append(events, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+	if e := events[0]; e.Type != "gameFull" || e.Black.ID != "bot" || e.State.Moves != "e2e4" || e.State.WInc != 2000 {
+		t.Errorf("unexpected gameFull %+v", e)
+	}
+	if e := events[1]; e.Type != "chatLine" || e.Text != "hi" {
+		t.Errorf("unexpected chatLine %+v", e)
+	}
+	if e := events[2]; e.Type != "gameState" || e.Moves != "e2e4 e7e5" || e.WTime != 58000 || !e.WDraw {
+		t.Errorf("unexpected gameState %+v", e)
+	}
+
+	stop := errors.New("stop")
+	err = (&Client{BaseURL: ts.URL}).StreamGame(context.Background(), "g1", func(e GameEvent) error { return stop })
+	if err != stop {
+		t.Errorf("expected the callback's error, got %v", err)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"No such token"}`))
+	}))
+	defer ts.Close()
+
+	_, err := (&Client{BaseURL: ts.URL, Token: "wrong"}).Account(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Status != 401 || apiErr.Message != "No such token" {
+		t.Errorf("expected a 401 API error, got %v", err)
+	}
+}
+
+func TestStartingPosition(t *testing.T) {
+	tests := []struct {
+		variant, fen, expected string
+	}{
+		{"standard", "startpos", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"},
+		{"fromPosition", "8/8/8/8/8/8/4K3/4k3 b - - 0 1", "8/8/8/8/8/8/4K3/4k3 b - - 0 1"},
+		{"threeCheck", "startpos", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 3+3 0 1"},
+		{"kingOfTheHill", "startpos", "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"},
+		{"chess960", "bbqnnrkr/pppppppp/8/8/8/8/PPPPPPPP/BBQNNRKR w KQkq - 0 1", "bbqnnrkr/pppppppp/8/8/8/8/PPPPPPPP/BBQNNRKR w KQkq - 0 1"},
+	}
+	for _, test := range tests {
+		board, err := startingPosition(Variant{Key: test.variant}, test.fen)
+		if err != nil {
+			t.Errorf("%s: %s", test.variant, err)
+		} else if board.FEN() != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.variant, test.expected, board.FEN())
+		}
+	}
+	if _, err := startingPosition(Variant{Key: "chess960"}, "startpos"); err == nil {
+		t.Error("expected an error for chess960 without a position")
+	}
+	if _, err := startingPosition(Variant{Key: "shogi"}, "startpos"); err == nil {
+		t.Error("expected an error for an unknown variant")
+	}
+}
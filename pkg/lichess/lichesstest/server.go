@@ -0,0 +1,216 @@
+// Package lichesstest provides a stand-in for the Lichess Bot API which replays scripted
+// event and game streams, for testing bots without a network or an account.
+package lichesstest
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/lichess"
+)
+
+// Server replays NDJSON streams to a bot. Each game's stream waits for the bot to post
+// its move before sending the state which includes it, so a scripted game unfolds as it
+// would against an opponent.
+type Server struct {
+	*httptest.Server
+	Account lichess.User  // The bot's account
+	Token   string        // Token requests must be authenticated with, if not empty
+	Timeout time.Duration // How long a game waits for the bot's move before its stream ends
+	Reject  []string      // Moves answered with an error, as Lichess answers illegal moves
+
+	mu       sync.Mutex
+	events   []string
+	games    map[string][]string
+	moves    map[string]chan string
+	requests []Request
+}
+
+// Request is a command the bot sent, such as accepting a challenge or making a move
+type Request struct {
+	Method string
+	Path   string
+	Form   url.Values
+}
+
+// NewServer starts a server for the bot's account. Close it when done.
+func NewServer(account lichess.User) *Server {
+	s := &Server{
+		Account: account,
+		Timeout: 5 * time.Second,
+		games:   map[string][]string{},
+		moves:   map[string]chan string{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/account", s.handleAccount)
+	mux.HandleFunc("/api/stream/event", s.handleEvents)
+	mux.HandleFunc("/api/bot/game/stream/", s.handleGame)
+	mux.HandleFunc("/api/", s.handleCommand)
+	s.Server = httptest.NewServer(s.authenticate(mux))
+	return s
+}
+
+// Client returns a client of the server authenticated with its token
+func (s *Server) Client() *lichess.Client {
+	return &lichess.Client{BaseURL: s.URL, Token: s.Token, HTTP: s.Server.Client()}
+}
+
+// AddEvents appends lines of NDJSON to the event stream, which ends once they've been
+// sent
+func (s *Server) AddEvents(ndjson string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, lines(ndjson)...)
+}
+
+// AddGame sets the NDJSON stream of a game, starting with its gameFull event
+func (s *Server) AddGame(id, ndjson string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.games[id] = lines(ndjson)
+	s.moves[id] = make(chan string, 16)
+}
+
+// Requests returns the commands the server has received, in order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// authenticate rejects requests without the server's token
+func (s *Server) authenticate(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.Token != "" && r.Header.Get("Authorization") != "Bearer "+s.Token {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "No such token"})
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleAccount(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.Account)
+}
+
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	events := s.events
+	s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	for _, line := range events {
+		writeLine(w, line)
+	}
+}
+
+// handleGame replays a game's stream, waiting for the bot's moves
+func (s *Server) handleGame(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/api/bot/game/stream/")
+	s.mu.Lock()
+	events, ok := s.games[id]
+	moves := s.moves[id]
+	s.mu.Unlock()
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "Not found"})
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-ndjson")
+
+	var color, fen string
+	played := 0
+	for _, line := range events {
+		var e lichess.GameEvent
+		json.Unmarshal([]byte(line), &e)
+		switch e.Type {
+		case "gameFull":
+			color, fen = s.color(e), e.InitialFEN
+			played = len(strings.Fields(e.State.Moves))
+		case "gameState":
+			// Wait for each of the bot's moves before sending the state which includes them
+			for ply := strings.Fields(e.Moves); played < len(ply); played++ {
+				if !botsMove(color, fen, played) {
+					continue
+				}
+				select {
+				case <-moves:
+				case <-time.After(s.Timeout):
+					return
+				case <-r.Context().Done():
+					return
+				}
+			}
+		}
+		writeLine(w, line)
+	}
+}
+
+// color returns the color the bot plays in a game
+func (s *Server) color(e lichess.GameEvent) string {
+	if e.Black.ID == s.Account.ID {
+		return "black"
+	}
+	return "white"
+}
+
+// handleCommand records commands, forwarding moves to their game's stream
+func (s *Server) handleCommand(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "Not found"})
+		return
+	}
+	r.ParseForm()
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{r.Method, r.URL.Path, r.Form})
+	if parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/bot/game/"), "/"); len(parts) == 3 && parts[1] == "move" {
+		for _, move := range s.Reject {
+			if move == parts[2] {
+				s.mu.Unlock()
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "Not your turn, or game already over"})
+				return
+			}
+		}
+		if moves, ok := s.moves[parts[0]]; ok {
+			moves <- parts[2]
+		}
+	}
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]bool{"ok": true})
+}
+
+// botsMove reports whether a ply of a game is played by the bot
+func botsMove(color, initialFEN string, ply int) bool {
+	first := "white"
+	if fields := strings.Fields(initialFEN); len(fields) > 1 && fields[1] == "b" {
+		first = "black"
+	}
+	return (ply%2 == 0) == (color == first)
+}
+
+// lines splits NDJSON into lines, keeping empty keep-alive lines
+func lines(ndjson string) []string {
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(strings.TrimLeft(ndjson, "\n")))
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimSpace(scanner.Text()))
+	}
+	return lines
+}
+
+func writeLine(w http.ResponseWriter, line string) {
+	w.Write([]byte(line + "\n"))
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,296 @@
+// Package polyglot reads and writes opening books in the Polyglot .bin format, see
+// <http://hgm.nubati.net/book_format.html>.
+//
+// A book is a file of 16 byte big-endian entries sorted by position key. Each entry
+// holds a Zobrist key of a position (see chess.Board.Hash), a move played from that
+// position, a weight used to choose between the moves of a position and a learn value
+// which programs may use to record their experience with the move.
+package polyglot
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"sort"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// EntrySize is the size in bytes of a single book entry
+const EntrySize = 16
+
+// Entry is a single record in a Polyglot book
+type Entry struct {
+	Key    uint64
+	Move   uint16
+	Weight uint16
+	Learn  uint32
+}
+
+// MarshalBinary encodes the entry in the 16 byte big-endian Polyglot format
+func (e Entry) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, EntrySize)
+	binary.BigEndian.PutUint64(buf[0:8], e.Key)
+	binary.BigEndian.PutUint16(buf[8:10], e.Move)
+	binary.BigEndian.PutUint16(buf[10:12], e.Weight)
+	binary.BigEndian.PutUint32(buf[12:16], e.Learn)
+	return buf, nil
+}
+
+// UnmarshalBinary decodes an entry from the 16 byte big-endian Polyglot format
+func (e *Entry) UnmarshalBinary(data []byte) error {
+	if len(data) != EntrySize {
+		return fmt.Errorf("polyglot entry must be %d bytes, received %d", EntrySize, len(data))
+	}
+	e.Key = binary.BigEndian.Uint64(data[0:8])
+	e.Move = binary.BigEndian.Uint16(data[8:10])
+	e.Weight = binary.BigEndian.Uint16(data[10:12])
+	e.Learn = binary.BigEndian.Uint32(data[12:16])
+	return nil
+}
+
+//-----------------------------------------------------------------------------
+// Move encoding
+//-----------------------------------------------------------------------------
+
+// promotionCodes gives the Polyglot promotion field for each promotion piece
+var promotionCodes = map[chess.Symbol]uint16{
+	chess.PAWN:   0,
+	chess.KNIGHT: 1,
+	chess.BISHOP: 2,
+	chess.ROOK:   3,
+	chess.QUEEN:  4,
+}
+
+// EncodeMove converts a move to the Polyglot format: bits 0-5 hold the destination
+// square, bits 6-11 the origin square and bits 12-14 the promotion piece. Castling is
+// encoded as the king capturing its own rook, e.g. e1h1 for white castling king side.
+func EncodeMove(m chess.Move) uint16 {
+	to := m.To
+	if m.Flags&chess.KingSideCastle != 0 {
+		to = m.From - m.From%chess.FILES + 7
+	} else if m.Flags&chess.QueenSideCastle != 0 {
+		to = m.From - m.From%chess.FILES
+	}
+	return uint16(to) | uint16(m.From)<<6 | promotionCodes[m.Promotion]<<12
+}
+
+// DecodeMove finds the legal move on the board matching a move in the Polyglot format
+func DecodeMove(board *chess.Board, move uint16) (chess.Move, error) {
+	for _, m := range board.LegalMoves() {
+		if EncodeMove(m) == move {
+			return m, nil
+		}
+	}
+	return chess.Move{}, fmt.Errorf("book move 0x%04x is not legal in position %s", move, board.FEN())
+}
+
+//-----------------------------------------------------------------------------
+// Reading books
+//-----------------------------------------------------------------------------
+
+// Book is a Polyglot opening book which is searched in place, so large books don't
+// need to be loaded into memory
+type Book struct {
+	r      io.ReaderAt
+	size   int64
+	closer io.Closer
+}
+
+// BookMove is a legal move found in a book for a position, with its book entry
+type BookMove struct {
+	Move  chess.Move
+	Entry Entry
+}
+
+// NewBook returns a book read from the given source of entries
+func NewBook(r io.ReaderAt, size int64) (*Book, error) {
+	if size%EntrySize != 0 {
+		return nil, fmt.Errorf("polyglot book size %d is not a multiple of %d bytes", size, EntrySize)
+	}
+	return &Book{r: r, size: size}, nil
+}
+
+// Open opens a Polyglot book file
+func Open(path string) (*Book, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	book, err := NewBook(f, info.Size())
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	book.closer = f
+	return book, nil
+}
+
+// OpenForLearning opens a Polyglot book file for reading and writing so that the learn
+// values of its entries can be updated with SetLearn
+func OpenForLearning(path string) (*Book, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	book, err := NewBook(f, info.Size())
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	book.closer = f
+	return book, nil
+}
+
+// Close closes the underlying file of a book opened with Open
+func (b *Book) Close() error {
+	if b.closer != nil {
+		return b.closer.Close()
+	}
+	return nil
+}
+
+// Len returns the number of entries in the book
+func (b *Book) Len() int {
+	return int(b.size / EntrySize)
+}
+
+// entry reads the entry at the given index
+func (b *Book) entry(i int) (Entry, error) {
+	var e Entry
+	buf := make([]byte, EntrySize)
+	if _, err := b.r.ReadAt(buf, int64(i)*EntrySize); err != nil {
+		return e, err
+	}
+	return e, e.UnmarshalBinary(buf)
+}
+
+// Lookup returns the entries for a position key using a binary search of the book
+func (b *Book) Lookup(key uint64) ([]Entry, error) {
+	var err error
+	first := sort.Search(b.Len(), func(i int) bool {
+		e, readErr := b.entry(i)
+		if readErr != nil {
+			err = readErr
+			return true
+		}
+		return e.Key >= key
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for i := first; i < b.Len(); i++ {
+		e, err := b.entry(i)
+		if err != nil {
+			return nil, err
+		}
+		if e.Key != key {
+			break
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// SetLearn updates the learn value of the entry for a position key and move. The book's
+// source must also implement io.WriterAt, e.g. a book opened with OpenForLearning.
+func (b *Book) SetLearn(key uint64, move uint16, learn uint32) error {
+	w, ok := b.r.(io.WriterAt)
+	if !ok {
+		return fmt.Errorf("polyglot book is read only")
+	}
+	first := sort.Search(b.Len(), func(i int) bool {
+		e, err := b.entry(i)
+		return err != nil || e.Key >= key
+	})
+	for i := first; i < b.Len(); i++ {
+		e, err := b.entry(i)
+		if err != nil {
+			return err
+		}
+		if e.Key != key {
+			break
+		}
+		if e.Move == move {
+			buf := make([]byte, 4)
+			binary.BigEndian.PutUint32(buf, learn)
+			_, err = w.WriteAt(buf, int64(i)*EntrySize+12)
+			return err
+		}
+	}
+	return fmt.Errorf("no book entry for key 0x%016x and move 0x%04x", key, move)
+}
+
+// Moves returns the legal book moves for the board's position, highest weight first.
+// Entries whose move isn't legal (e.g. from a hash collision) are skipped.
+func (b *Book) Moves(board *chess.Board) ([]BookMove, error) {
+	entries, err := b.Lookup(board.Hash())
+	if err != nil {
+		return nil, err
+	}
+	var moves []BookMove
+	for _, e := range entries {
+		if m, err := DecodeMove(board, e.Move); err == nil {
+			moves = append(moves, BookMove{m, e})
+		}
+	}
+	sort.SliceStable(moves, func(i, j int) bool {
+		return moves[i].Entry.Weight > moves[j].Entry.Weight
+	})
+	return moves, nil
+}
+
+// BestMove returns the book move with the highest weight for the board's position, or
+// false if the position isn't in the book
+func (b *Book) BestMove(board *chess.Board) (chess.Move, bool, error) {
+	moves, err := b.Moves(board)
+	if err != nil || len(moves) == 0 {
+		return chess.Move{}, false, err
+	}
+	return moves[0].Move, true, nil
+}
+
+// RandomMove picks a book move for the board's position at random with a probability
+// proportional to its weight, or returns false if the position isn't in the book. If
+// rng is nil the global source of randomness is used.
+func (b *Book) RandomMove(board *chess.Board, rng *rand.Rand) (chess.Move, bool, error) {
+	moves, err := b.Moves(board)
+	if err != nil || len(moves) == 0 {
+		return chess.Move{}, false, err
+	}
+
+	total := 0
+	for _, m := range moves {
+		total += int(m.Entry.Weight)
+	}
+	if total == 0 {
+		return moves[0].Move, true, nil
+	}
+
+	var pick int
+	if rng != nil {
+		pick = rng.Intn(total)
+	} else {
+		pick = rand.Intn(total)
+	}
+	for _, m := range moves {
+		if pick -= int(m.Entry.Weight); pick < 0 {
+			return m.Move, true, nil
+		}
+	}
+	return moves[len(moves)-1].Move, true, nil
+}
@@ -0,0 +1,176 @@
+package polyglot
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+const games = `
+[Result "1-0"]
+1. e4 e5 2. Nf3 Nc6 3. Bb5 a6 1-0
+
+[Result "1/2-1/2"]
+1. e4 e5 2. Nf3 Nf6 1/2-1/2
+
+[Result "0-1"]
+1. d4 d5 2. c4 e6 0-1
+
+[Result "1-0"]
+1. e4 c5 2. Nf3 d6 1-0
+
+[Result "1-0"]
+[SetUp "1"]
+[FEN "r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1"]
+1. O-O-O O-O 1-0
+`
+
+func buildBook(t *testing.T, minFrequency, maxPly int) *Book {
+	builder := NewBuilder(minFrequency, maxPly)
+	n, err := builder.AddPGN(strings.NewReader(games))
+	if err != nil {
+		t.Fatalf("unexpected error adding games: %s (after %d games)", err, n)
+	}
+	var buf bytes.Buffer
+	if _, err := builder.WriteTo(&buf); err != nil {
+		t.Fatalf("unexpected error writing book: %s", err)
+	}
+	book, err := NewBook(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error reading book: %s", err)
+	}
+	return book
+}
+
+func TestEncodeMove(t *testing.T) {
+	board, _ := chess.ParseFEN("r3k2r/8/8/8/8/8/1p6/R3K2R b KQkq - 0 1")
+	for uci, expected := range map[string]uint16{
+		"e8g8":  60<<6 | 63,
+		"e8c8":  60<<6 | 56,
+		"b2a1q": 4<<12 | 9<<6 | 0,
+		"b2b1n": 1<<12 | 9<<6 | 1,
+		"a8a1":  56<<6 | 0,
+	} {
+		m, err := board.ParseUCI(uci)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %s: %s", uci, err)
+		}
+		if actual := EncodeMove(m); actual != expected {
+			t.Errorf("expected %s to encode as 0x%04x, actual: 0x%04x", uci, expected, actual)
+		}
+		if decoded, err := DecodeMove(board, expected); err != nil || decoded != m {
+			t.Errorf("expected 0x%04x to decode as %s, actual: %s (%v)", expected, uci, decoded, err)
+		}
+	}
+	if _, err := DecodeMove(board, 12<<6|28); err == nil {
+		t.Errorf("decoding an illegal move should fail")
+	}
+}
+
+func TestBook(t *testing.T) {
+	book := buildBook(t, 1, 0)
+	board, _ := chess.NewBoard()
+
+	moves, err := book.Moves(board)
+	if err != nil {
+		t.Fatalf("unexpected error looking up moves: %s", err)
+	}
+	// e4 won twice and drew once (5 points), d4 lost
+	if len(moves) != 1 || moves[0].Move.UCI() != "e2e4" || moves[0].Entry.Weight != 5 {
+		t.Errorf("expected only e4 with weight 5, actual: %v", moves)
+	}
+
+	board.Play("e4")
+	moves, _ = book.Moves(board)
+	if len(moves) != 1 || moves[0].Move.UCI() != "e7e5" || moves[0].Entry.Weight != 1 {
+		t.Errorf("expected only e5 with weight 1, actual: %v", moves)
+	}
+
+	board.Play("e5", "Nf3")
+	best, ok, err := book.BestMove(board)
+	if err != nil || !ok || best.UCI() != "g8f6" {
+		t.Errorf("expected best move Nf6, actual: %s %t %v", best, ok, err)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	counts := map[string]int{}
+	for i := 0; i < 300; i++ {
+		m, _, _ := book.RandomMove(board, rng)
+		counts[m.UCI()]++
+	}
+	// Nc6 weight 0 lost so only Nf6 (draw, weight 1) is in the book
+	if len(counts) != 1 || counts["g8f6"] != 300 {
+		t.Errorf("unexpected random move distribution: %v", counts)
+	}
+
+	board.Play("d5")
+	if _, ok, _ := book.BestMove(board); ok {
+		t.Errorf("position not in book should have no best move")
+	}
+
+	board, _ = chess.ParseFEN("r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1")
+	if best, ok, _ = book.BestMove(board); !ok || best.Flags&chess.QueenSideCastle == 0 {
+		t.Errorf("expected best move O-O-O, actual: %s", best)
+	}
+}
+
+func TestBuilderFilters(t *testing.T) {
+	book := buildBook(t, 2, 3)
+	board, _ := chess.NewBoard()
+	board.Play("e4", "e5")
+	moves, _ := book.Moves(board)
+	// Nf3 was played twice, won once and drew once
+	if len(moves) != 1 || moves[0].Move.UCI() != "g1f3" || moves[0].Entry.Weight != 3 {
+		t.Errorf("expected Nf3 with weight 3, actual: %v", moves)
+	}
+
+	board.Play("Nf3")
+	if moves, _ = book.Moves(board); len(moves) != 0 {
+		t.Errorf("moves beyond the maximum ply should not be in the book, actual: %v", moves)
+	}
+}
+
+func TestSetLearn(t *testing.T) {
+	dir, err := ioutil.TempDir("", "polyglot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "book.bin")
+	builder := NewBuilder(1, 0)
+	builder.AddPGN(strings.NewReader(games))
+	f, _ := os.Create(path)
+	builder.WriteTo(f)
+	f.Close()
+
+	readOnly, err := Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error opening book: %s", err)
+	}
+	defer readOnly.Close()
+	board, _ := chess.NewBoard()
+	move := EncodeMove(chess.Move{From: chess.E2, To: chess.E4})
+	if err := readOnly.SetLearn(board.Hash(), move, 42); err == nil {
+		t.Errorf("setting learn on a read only book should fail")
+	}
+
+	book, err := OpenForLearning(path)
+	if err != nil {
+		t.Fatalf("unexpected error opening book: %s", err)
+	}
+	defer book.Close()
+	if err := book.SetLearn(board.Hash(), move, 42); err != nil {
+		t.Errorf("unexpected error setting learn: %s", err)
+	}
+	entries, _ := readOnly.Lookup(board.Hash())
+	if len(entries) != 1 || entries[0].Learn != 42 {
+		t.Errorf("expected learn value 42, actual: %v", entries)
+	}
+}
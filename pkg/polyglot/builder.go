@@ -0,0 +1,144 @@
+package polyglot
+
+import (
+	"io"
+	"sort"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// Builder collects statistics for the moves played in a collection of games and turns
+// them into a Polyglot book. Moves are weighted by their score for the side that played
+// them: two points for a win and one for a draw.
+type Builder struct {
+	MinFrequency int // moves played in fewer games than this are left out of the book
+	MaxPly       int // only the first MaxPly moves of each game are used (0 for no limit)
+
+	stats map[statKey]*moveStats
+}
+
+type statKey struct {
+	key  uint64
+	move uint16
+}
+
+type moveStats struct {
+	games  int
+	points int
+}
+
+// NewBuilder returns a book builder with the given filters
+func NewBuilder(minFrequency, maxPly int) *Builder {
+	return &Builder{MinFrequency: minFrequency, MaxPly: maxPly, stats: map[statKey]*moveStats{}}
+}
+
+// Add records the moves of a game
+func (b *Builder) Add(game *chess.Game) error {
+	if b.stats == nil {
+		b.stats = map[statKey]*moveStats{}
+	}
+	board, err := game.StartingPosition()
+	if err != nil {
+		return err
+	}
+	for ply, m := range game.Moves {
+		if b.MaxPly > 0 && ply >= b.MaxPly {
+			break
+		}
+		points := 0
+		switch {
+		case game.Result == chess.Draw:
+			points = 1
+		case game.Result == chess.WhiteWins && board.Turn == chess.WHITE,
+			game.Result == chess.BlackWins && board.Turn == chess.BLACK:
+			points = 2
+		}
+
+		k := statKey{board.Hash(), EncodeMove(m)}
+		s, ok := b.stats[k]
+		if !ok {
+			s = &moveStats{}
+			b.stats[k] = s
+		}
+		s.games++
+		s.points += points
+		board.MakeMove(m)
+	}
+	return nil
+}
+
+// AddPGN records the moves of every game in a PGN stream, returning the number of games
+// added. Games that fail to parse stop the build with an error.
+func (b *Builder) AddPGN(r io.Reader) (int, error) {
+	reader := chess.NewPGNReader(r)
+	count := 0
+	for {
+		game, err := reader.Next()
+		if err == io.EOF {
+			return count, nil
+		} else if err != nil {
+			return count, err
+		}
+		if err = b.Add(game); err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+// Entries returns the book entries sorted by key (and by weight within each key).
+// Weights are scaled down if necessary so the largest fits in 16 bits and moves with
+// no points are left out since they would never be chosen.
+func (b *Builder) Entries() []Entry {
+	maxPoints := 0
+	for _, s := range b.stats {
+		if s.points > maxPoints {
+			maxPoints = s.points
+		}
+	}
+
+	var entries []Entry
+	for k, s := range b.stats {
+		if s.games < b.MinFrequency || s.points == 0 {
+			continue
+		}
+		weight := s.points
+		if maxPoints > 0xffff {
+			weight = s.points * 0xffff / maxPoints
+			if weight == 0 {
+				weight = 1
+			}
+		}
+		entries = append(entries, Entry{Key: k.key, Move: k.move, Weight: uint16(weight)})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Key != entries[j].Key {
+			return entries[i].Key < entries[j].Key
+		}
+		if entries[i].Weight != entries[j].Weight {
+			return entries[i].Weight > entries[j].Weight
+		}
+		return entries[i].Move < entries[j].Move
+	})
+	return entries
+}
+
+// WriteTo writes the book in the Polyglot format
+func (b *Builder) WriteTo(w io.Writer) (int64, error) {
+	return WriteEntries(w, b.Entries())
+}
+
+// WriteEntries writes entries in the Polyglot format. Entries must already be sorted by key.
+func WriteEntries(w io.Writer, entries []Entry) (int64, error) {
+	var written int64
+	for _, e := range entries {
+		buf, _ := e.MarshalBinary()
+		n, err := w.Write(buf)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
@@ -0,0 +1,132 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// PieceSet draws the pieces of a diagram. Pieces are drawn in a 45x45 box and scaled to
+// the size of the squares.
+type PieceSet interface {
+	// Name identifies the set, e.g. in the ids of the SVG definitions
+	Name() string
+	// SVG returns the SVG elements drawing the piece
+	SVG(p chess.Piece) string
+}
+
+// Cburnett is the set of vector pieces by Colin M.L. Burnett used by Wikipedia and
+// lichess, licensed under the GPLv2+. Pieces it doesn't have, such as fairy pieces, are
+// drawn as their Unicode glyph.
+var Cburnett PieceSet = cburnett{}
+
+// UnicodeGlyphs draws the pieces as the Unicode chess symbols. How they look depends on
+// the fonts available to the viewer.
+var UnicodeGlyphs PieceSet = unicodeGlyphs{}
+
+type cburnett struct{}
+
+func (cburnett) Name() string {
+	return "cburnett"
+}
+
+// SVG fills the outline of the piece with its color and draws the details in the other
+func (cburnett) SVG(p chess.Piece) string {
+	template, ok := cburnettPieces[p.Symbol]
+	if !ok {
+		return UnicodeGlyphs.SVG(p)
+	}
+	fill, detail := "#fff", "#000"
+	if p.Color == chess.BLACK {
+		fill, detail = "#000", "#fff"
+	}
+	return strings.NewReplacer("{fill}", fill, "{detail}", detail).Replace(template)
+}
+
+// cburnettPieces are the outlines of the pieces with {fill} and {detail} standing in for
+// the piece's color and the color of its markings
+var cburnettPieces = map[chess.Symbol]string{
+	chess.PAWN: `<path d="M22.5 9c-2.21 0-4 1.79-4 4 0 .89.29 1.71.78 2.38C17.33 16.5 16 18.59 16 21c0 ` +
+		`2.03.94 3.84 2.41 5.03-3 1.06-7.41 5.55-7.41 13.47h23c0-7.92-4.41-12.41-7.41-13.47 1.47-1.19 ` +
+		`2.41-3 2.41-5.03 0-2.41-1.33-4.5-3.28-5.62.49-.67.78-1.49.78-2.38 0-2.21-1.79-4-4-4z" ` +
+		`fill="{fill}" stroke="#000" stroke-width="1.5" stroke-linecap="round"/>`,
+
+	chess.ROOK: `<g fill="{fill}" fill-rule="evenodd" stroke="#000" stroke-width="1.5" stroke-linecap="round" ` +
+		`stroke-linejoin="round">` +
+		`<path d="M9 39h27v-3H9v3zM12 36v-4h21v4H12zM11 14V9h4v2h5V9h5v2h5V9h4v5" stroke-linecap="butt"/>` +
+		`<path d="M34 14l-3 3H14l-3-3"/>` +
+		`<path d="M31 17v12.5H14V17" stroke-linecap="butt" stroke-linejoin="miter"/>` +
+		`<path d="M31 29.5l1.5 2.5h-20l1.5-2.5"/>` +
+		`<path d="M11 14h23" fill="none" stroke="{detail}" stroke-width="1" stroke-linejoin="miter"/>` +
+		`</g>`,
+
+	chess.KNIGHT: `<g fill="none" fill-rule="evenodd" stroke="#000" stroke-width="1.5" stroke-linecap="round" ` +
+		`stroke-linejoin="round">` +
+		`<path d="M22 10c10.5 1 16.5 8 16 29H15c0-9 10-6.5 8-21" fill="{fill}"/>` +
+		`<path d="M24 18c.38 2.91-5.55 7.37-8 9-3 2-2.82 4.34-5 4-1.042-.94 1.41-3.04 0-3-1 0 .19 1.23-1 ` +
+		`2-1 0-4.003 1-4-4 0-2 6-12 6-12s1.89-1.9 2-3.5c-.73-.994-.5-2-.5-3 1-1 3 2.5 3 2.5h2s.78-1.992 ` +
+		`2.5-3c1 0 1 3 1 3" fill="{fill}"/>` +
+		`<path d="M9.5 25.5a.5.5 0 1 1-1 0 .5.5 0 1 1 1 0zm5.433-9.75a.5 1.5 30 1 1-.866-.5.5 1.5 30 1 1 ` +
+		`.866.5z" fill="{detail}" stroke="{detail}"/>` +
+		`</g>`,
+
+	chess.BISHOP: `<g fill="none" fill-rule="evenodd" stroke="#000" stroke-width="1.5" stroke-linecap="round" ` +
+		`stroke-linejoin="round">` +
+		`<g fill="{fill}" stroke-linecap="butt">` +
+		`<path d="M9 36c3.39-.97 10.11.43 13.5-2 3.39 2.43 10.11 1.03 13.5 2 0 0 1.65.54 3 2-.68.97-1.65.99-3 ` +
+		`.5-3.39-.97-10.11.46-13.5-1-3.39 1.46-10.11.03-13.5 1-1.354.49-2.323.47-3-.5 1.354-1.94 3-2 3-2z"/>` +
+		`<path d="M15 32c2.5 2.5 12.5 2.5 15 0 .5-1.5 0-2 0-2 0-2.5-2.5-4-2.5-4 5.5-1.5 6-11.5-5-15.5-11 ` +
+		`4-10.5 14-5 15.5 0 0-2.5 1.5-2.5 4 0 0-.5.5 0 2z"/>` +
+		`<path d="M25 8a2.5 2.5 0 1 1-5 0 2.5 2.5 0 1 1 5 0z"/>` +
+		`</g>` +
+		`<path d="M17.5 26h10M15 30h15m-7.5-14.5v5M20 18h5" stroke="{detail}" stroke-linejoin="miter"/>` +
+		`</g>`,
+
+	chess.QUEEN: `<g fill="{fill}" fill-rule="evenodd" stroke="#000" stroke-width="1.5" stroke-linecap="round" ` +
+		`stroke-linejoin="round">` +
+		`<path d="M8 12a2 2 0 1 1-4 0 2 2 0 1 1 4 0zM24.5 7.5a2 2 0 1 1-4 0 2 2 0 1 1 4 0zM41 12a2 2 0 1 ` +
+		`1-4 0 2 2 0 1 1 4 0zM16 8.5a2 2 0 1 1-4 0 2 2 0 1 1 4 0zM33 9a2 2 0 1 1-4 0 2 2 0 1 1 4 0z"/>` +
+		`<path d="M9 26c8.5-1.5 21-1.5 27 0l2-12-7 11V11l-5.5 13.5-3-15-3 15-5.5-14V25L7 14l2 12z" ` +
+		`stroke-linecap="butt"/>` +
+		`<path d="M9 26c0 2 1.5 2 2.5 4 1 1.5 1 1 .5 3.5-1.5 1-1.5 2.5-1.5 2.5-1.5 1.5.5 2.5.5 2.5 6.5 1 ` +
+		`16.5 1 23 0 0 0 1.5-1 0-2.5 0 0 .5-1.5-1-2.5-.5-2.5-.5-2 .5-3.5 1-2 2.5-2 2.5-4-8.5-1.5-18.5-1.5-27 ` +
+		`0z" stroke-linecap="butt"/>` +
+		`<path d="M11.5 30c3.5-1 18.5-1 22 0M12 33.5c6-1 15-1 21 0" fill="none" stroke="{detail}"/>` +
+		`</g>`,
+
+	chess.KING: `<g fill="none" fill-rule="evenodd" stroke="#000" stroke-width="1.5" stroke-linecap="round" ` +
+		`stroke-linejoin="round">` +
+		`<path d="M22.5 11.63V6M20 8h5" stroke-linejoin="miter"/>` +
+		`<path d="M22.5 25s4.5-7.5 3-10.5c0 0-1-2.5-3-2.5s-3 2.5-3 2.5c-1.5 3 3 10.5 3 10.5" fill="{fill}" ` +
+		`stroke-linecap="butt" stroke-linejoin="miter"/>` +
+		`<path d="M11.5 37c5.5 3.5 15.5 3.5 21 0v-7s9-4.5 6-10.5c-4-6.5-13.5-3.5-16 4V27v-3.5c-3.5-7.5-13-10.5-16 ` +
+		`-4-3 6 5 10 5 10V37z" fill="{fill}"/>` +
+		`<path d="M11.5 30c5.5-3 15.5-3 21 0m-21 3.5c5.5-3 15.5-3 21 0m-21 3.5c5.5-3 15.5-3 21 0" ` +
+		`stroke="{detail}"/>` +
+		`</g>`,
+}
+
+type unicodeGlyphs struct{}
+
+func (unicodeGlyphs) Name() string {
+	return "unicode"
+}
+
+// SVG draws the piece's glyph, using the solid black glyphs with a white fill for white
+// pieces so both colors have the same shape. Pieces without a glyph are drawn as their
+// letter.
+func (unicodeGlyphs) SVG(p chess.Piece) string {
+	fill := "#000"
+	if p.Color == chess.WHITE {
+		fill = "#fff"
+	}
+	solid := chess.Piece{Color: chess.BLACK, Symbol: p.Symbol}
+	glyph := solid.Unicode()
+	if glyph == solid.FENSymbol() {
+		glyph = p.FENSymbol()
+	}
+	return fmt.Sprintf(`<text x="22.5" y="36" font-size="38" text-anchor="middle" `+
+		`font-family="'DejaVu Sans', 'Segoe UI Symbol', sans-serif" fill="%s" stroke="#000" `+
+		`stroke-width="1">%s</text>`, fill, glyph)
+}
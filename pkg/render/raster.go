@@ -0,0 +1,254 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// Theme is the colors of a raster image. The highlights are drawn over the squares so
+// colors with some transparency work best.
+type Theme struct {
+	Light    color.Color // Light squares
+	Dark     color.Color // Dark squares
+	LastMove color.Color // Highlight of the squares of the last move
+	Check    color.Color // Highlight of the king in check
+	Target   color.Color // Highlight of the squares a piece can move to, shown in terminals
+}
+
+// Themes with the board colors of lichess
+var (
+	BrownTheme = Theme{
+		Light:    color.RGBA{0xf0, 0xd9, 0xb5, 0xff},
+		Dark:     color.RGBA{0xb5, 0x88, 0x63, 0xff},
+		LastMove: color.NRGBA{0x9b, 0xc7, 0x00, 0x69},
+		Check:    color.NRGBA{0xff, 0x00, 0x00, 0xb0},
+		Target:   color.NRGBA{0x14, 0x55, 0x1e, 0x80},
+	}
+	GreenTheme = Theme{
+		Light:    color.RGBA{0xff, 0xff, 0xdd, 0xff},
+		Dark:     color.RGBA{0x86, 0xa6, 0x66, 0xff},
+		LastMove: color.NRGBA{0x00, 0x9b, 0xc7, 0x69},
+		Check:    color.NRGBA{0xff, 0x00, 0x00, 0xb0},
+		Target:   color.NRGBA{0x14, 0x55, 0x1e, 0x80},
+	}
+	BlueTheme = Theme{
+		Light:    color.RGBA{0xde, 0xe3, 0xe6, 0xff},
+		Dark:     color.RGBA{0x8c, 0xa2, 0xad, 0xff},
+		LastMove: color.NRGBA{0x9b, 0xc7, 0x00, 0x69},
+		Check:    color.NRGBA{0xff, 0x00, 0x00, 0xb0},
+		Target:   color.NRGBA{0x14, 0x55, 0x1e, 0x80},
+	}
+)
+
+// ImageOptions configure the images drawn by RenderImage. The zero value draws a plain
+// board from white's side with 48 pixel squares.
+type ImageOptions struct {
+	SquareSize  int         // Size of each square in pixels, 48 if 0
+	Orientation chess.Color // Side at the bottom of the board
+	LastMove    *chess.Move // Move whose squares are highlighted
+	Check       bool        // Highlight the king of the side to move if it's in check
+	Theme       Theme       // BrownTheme is used for any colors left nil
+	Sprites     Sprites     // PixelSprites if nil
+}
+
+// defaultSpriteSize is the size of the squares when ImageOptions.SquareSize isn't given,
+// three pixels for each pixel of the pixel sprites
+const defaultSpriteSize = 48
+
+// withDefaults returns the options with defaults filled in for the settings left empty
+func (opts ImageOptions) withDefaults() ImageOptions {
+	if opts.SquareSize <= 0 {
+		opts.SquareSize = defaultSpriteSize
+	}
+	if opts.Sprites == nil {
+		opts.Sprites = PixelSprites
+	}
+	opts.Theme = opts.Theme.withDefaults()
+	return opts
+}
+
+// withDefaults returns the theme with the colors of BrownTheme for the colors left nil
+func (theme Theme) withDefaults() Theme {
+	defaults := BrownTheme
+	fill := func(c *color.Color, fallback color.Color) {
+		if *c == nil {
+			*c = fallback
+		}
+	}
+	fill(&theme.Light, defaults.Light)
+	fill(&theme.Dark, defaults.Dark)
+	fill(&theme.LastMove, defaults.LastMove)
+	fill(&theme.Check, defaults.Check)
+	fill(&theme.Target, defaults.Target)
+	return theme
+}
+
+// RenderImage draws the board as an image
+func RenderImage(board *chess.Board, opts ImageOptions) *image.RGBA {
+	opts = opts.withDefaults()
+	d := newDiagram(SVGOptions{SquareSize: opts.SquareSize, Orientation: opts.Orientation})
+	img := image.NewRGBA(image.Rect(0, 0, d.size, d.size))
+
+	square := func(sq int) image.Rectangle {
+		x, y := d.corner(sq)
+		return image.Rect(x, y, x+opts.SquareSize, y+opts.SquareSize)
+	}
+	for sq := 0; sq < chess.RANKS*chess.FILES; sq++ {
+		fill := opts.Theme.Dark
+		if file, rank := chess.BitToCartesian(sq); (file+rank)%2 == 1 {
+			fill = opts.Theme.Light
+		}
+		draw.Draw(img, square(sq), image.NewUniform(fill), image.Point{}, draw.Src)
+	}
+
+	if m := opts.LastMove; m != nil {
+		for _, sq := range []int{m.From, m.To} {
+			if sq != chess.NoSquare {
+				draw.Draw(img, square(sq), image.NewUniform(opts.Theme.LastMove), image.Point{}, draw.Over)
+			}
+		}
+	}
+
+	if opts.Check {
+		if king := checkedKing(board); king != chess.NoSquare {
+			r := square(king)
+			draw.DrawMask(img, r, image.NewUniform(opts.Theme.Check), image.Point{},
+				disc{r.Min, opts.SquareSize}, r.Min, draw.Over)
+		}
+	}
+
+	sprites := map[chess.Piece]image.Image{}
+	for sq := 0; sq < chess.RANKS*chess.FILES; sq++ {
+		if occupied, piece := board.GetSquare(sq); occupied {
+			sprite, ok := sprites[*piece]
+			if !ok {
+				sprite = opts.Sprites.Sprite(*piece, opts.SquareSize)
+				sprites[*piece] = sprite
+			}
+			x, y := d.corner(sq)
+			drawSprite(img, sprite, x, y)
+		}
+	}
+	return img
+}
+
+// disc is a mask of the circle filling a square whose top left corner is at a point
+type disc struct {
+	min  image.Point
+	size int
+}
+
+func (disc) ColorModel() color.Model {
+	return color.AlphaModel
+}
+
+func (c disc) Bounds() image.Rectangle {
+	return image.Rect(c.min.X, c.min.Y, c.min.X+c.size, c.min.Y+c.size)
+}
+
+func (c disc) At(x, y int) color.Color {
+	r := float64(c.size) / 2
+	dx, dy := float64(x-c.min.X)+0.5-r, float64(y-c.min.Y)+0.5-r
+	if dx*dx+dy*dy <= r*r {
+		return color.Opaque
+	}
+	return color.Transparent
+}
+
+// EncodePNG draws the board and writes it to w as a PNG image
+func EncodePNG(w io.Writer, board *chess.Board, opts ImageOptions) error {
+	return png.Encode(w, RenderImage(board, opts))
+}
+
+// GIFOptions configure the animations drawn by RenderGIF
+type GIFOptions struct {
+	ImageOptions
+	Delay      time.Duration   // Time each position is shown, 1 second if 0
+	FinalDelay time.Duration   // Time the final position is shown, 3 seconds if 0
+	Delays     []time.Duration // Per-ply times overriding the others where not 0, from the starting position
+	LoopCount  int             // Number of times the animation repeats as in image/gif: 0 loops forever, -1 plays once
+}
+
+// RenderGIF draws a game as an animation with a frame for the starting position and for
+// the position after each move, highlighting the last move played
+func RenderGIF(game *chess.Game, opts GIFOptions) (*gif.GIF, error) {
+	board, err := game.StartingPosition()
+	if err != nil {
+		return nil, err
+	}
+	if opts.Delay <= 0 {
+		opts.Delay = time.Second
+	}
+	if opts.FinalDelay <= 0 {
+		opts.FinalDelay = 3 * time.Second
+	}
+
+	animation := &gif.GIF{LoopCount: opts.LoopCount}
+	for ply := 0; ply <= len(game.Moves); ply++ {
+		frame := opts.ImageOptions
+		if ply > 0 {
+			m := game.Moves[ply-1]
+			board.MakeMove(m)
+			frame.LastMove = &m
+		}
+
+		delay := opts.Delay
+		if ply == len(game.Moves) {
+			delay = opts.FinalDelay
+		}
+		if ply < len(opts.Delays) && opts.Delays[ply] > 0 {
+			delay = opts.Delays[ply]
+		}
+		animation.Image = append(animation.Image, paletted(RenderImage(board, frame)))
+		animation.Delay = append(animation.Delay, int(delay/(10*time.Millisecond)))
+	}
+	return animation, nil
+}
+
+// EncodeGIF draws a game as an animation and writes it to w as a GIF image
+func EncodeGIF(w io.Writer, game *chess.Game, opts GIFOptions) error {
+	animation, err := RenderGIF(game, opts)
+	if err != nil {
+		return err
+	}
+	return gif.EncodeAll(w, animation)
+}
+
+// paletted converts an image to a paletted image of its own colors, which keeps the
+// colors exact unless there are more than a GIF can hold. Images with too many colors,
+// e.g. from sprites with smooth edges, are dithered to the Plan 9 palette.
+func paletted(img *image.RGBA) *image.Paletted {
+	bounds := img.Bounds()
+	indices := map[color.RGBA]int{}
+	var colors color.Palette
+	for y := bounds.Min.Y; y < bounds.Max.Y && len(colors) <= 256; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := img.RGBAAt(x, y)
+			if _, ok := indices[c]; !ok {
+				indices[c] = len(colors)
+				colors = append(colors, c)
+			}
+		}
+	}
+
+	if len(colors) > 256 {
+		frame := image.NewPaletted(bounds, palette.Plan9)
+		draw.FloydSteinberg.Draw(frame, bounds, img, bounds.Min)
+		return frame
+	}
+	frame := image.NewPaletted(bounds, colors)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			frame.SetColorIndex(x, y, uint8(indices[img.RGBAAt(x, y)]))
+		}
+	}
+	return frame
+}
@@ -0,0 +1,131 @@
+package render
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+func rgba(c color.Color) color.RGBA {
+	return color.RGBAModel.Convert(c).(color.RGBA)
+}
+
+func TestRenderImage(t *testing.T) {
+	board, _ := chess.NewBoard()
+	img := RenderImage(board, ImageOptions{})
+	if size := img.Bounds().Size(); size.X != 384 || size.Y != 384 {
+		t.Fatalf("expected a 384 pixel board, actual: %v", size)
+	}
+	// The corners of a1 and h1 are empty in the sprites, the center of e1 is the king
+	if c := img.RGBAAt(0, 383); c != rgba(BrownTheme.Dark) {
+		t.Errorf("expected a dark a1 in the bottom left corner, actual: %v", c)
+	}
+	if c := img.RGBAAt(383, 383); c != rgba(BrownTheme.Light) {
+		t.Errorf("expected a light h1 in the bottom right corner, actual: %v", c)
+	}
+	if c := img.RGBAAt(4*48+24, 7*48+24); c != rgba(color.White) {
+		t.Errorf("expected the white king on e1, actual: %v", c)
+	}
+	if c := img.RGBAAt(4*48+24, 24); c != rgba(color.Black) {
+		t.Errorf("expected the black king on e8, actual: %v", c)
+	}
+
+	var buf bytes.Buffer
+	if err := EncodePNG(&buf, board, ImageOptions{SquareSize: 16}); err != nil {
+		t.Fatalf("unexpected error encoding PNG: %s", err)
+	}
+	if decoded, err := png.Decode(&buf); err != nil || decoded.Bounds().Dx() != 128 {
+		t.Errorf("expected a 128 pixel PNG, actual: %v %v", decoded, err)
+	}
+}
+
+func TestRenderImageOptions(t *testing.T) {
+	board, _ := chess.ParseFEN("rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3")
+	last := chess.Move{From: chess.D8, To: chess.H4}
+	knight := image.NewUniform(color.RGBA{0, 0, 0xff, 0xff})
+	img := RenderImage(board, ImageOptions{
+		SquareSize:  20,
+		Orientation: chess.BLACK,
+		LastMove:    &last,
+		Check:       true,
+		Theme:       Theme{Dark: color.Black},
+		Sprites:     ImageSprites{"N": knight},
+	})
+
+	// Flipped, a1 is in the top right corner
+	if c := img.RGBAAt(159, 0); c != rgba(color.Black) {
+		t.Errorf("expected a1 in the top right corner, actual: %v", c)
+	}
+	// d8 is highlighted as part of the last move
+	if c := img.RGBAAt(4*20, 7*20+19); c == rgba(color.Black) {
+		t.Errorf("expected d8 to be highlighted, actual: %v", c)
+	}
+	// The corner of the king's square is outside the check highlight but its edge isn't
+	if c := img.RGBAAt(3*20, 0); c != rgba(color.Black) {
+		t.Errorf("expected the corner of e1 to be plain, actual: %v", c)
+	}
+	if c := img.RGBAAt(3*20+1, 5); c.R < 0x80 || c.G != 0 {
+		t.Errorf("expected the edge of e1 to be highlighted red, actual: %v", c)
+	}
+	// The white knights are drawn by the image sprite and the black ones by the pixel
+	// sprites
+	if c := img.RGBAAt(6*20, 0); c != rgba(knight.C) {
+		t.Errorf("expected the image sprite on b1, actual: %v", c)
+	}
+	if c := img.RGBAAt(6*20, 7*20); c == rgba(knight.C) {
+		t.Errorf("expected the pixel sprite on b8, actual: %v", c)
+	}
+}
+
+func TestRenderGIF(t *testing.T) {
+	game, _ := chess.NewGame()
+	for _, m := range []string{"f3", "e5", "g4", "Qh4#"} {
+		if _, err := game.Play(m); err != nil {
+			t.Fatalf("unexpected error playing %s: %s", m, err)
+		}
+	}
+	opts := GIFOptions{
+		ImageOptions: ImageOptions{SquareSize: 16, Check: true},
+		Delay:        500 * time.Millisecond,
+		Delays:       []time.Duration{2 * time.Second},
+		LoopCount:    -1,
+	}
+	var buf bytes.Buffer
+	if err := EncodeGIF(&buf, game, opts); err != nil {
+		t.Fatalf("unexpected error encoding GIF: %s", err)
+	}
+	animation, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error decoding GIF: %s", err)
+	}
+	if len(animation.Image) != 5 || animation.LoopCount != -1 {
+		t.Fatalf("expected 5 frames played once, actual: %d %d", len(animation.Image), animation.LoopCount)
+	}
+	expected := []int{200, 50, 50, 50, 300}
+	for i, delay := range animation.Delay {
+		if delay != expected[i] {
+			t.Errorf("expected delays %v, actual: %v", expected, animation.Delay)
+			break
+		}
+	}
+
+	// Frames keep the board's colors exactly
+	board, _ := game.Board()
+	final := RenderImage(board, ImageOptions{
+		SquareSize: 16,
+		Check:      true,
+		LastMove:   &game.Moves[3],
+	})
+	frame := animation.Image[4]
+	for _, p := range []image.Point{{0, 127}, {7*16 + 8, 4*16 + 8}, {4*16 + 8, 7*16 + 1}} {
+		if c := rgba(frame.At(p.X, p.Y)); c != final.RGBAAt(p.X, p.Y) {
+			t.Errorf("expected %v at %v, actual: %v", final.RGBAAt(p.X, p.Y), p, c)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// Sprites draw the pieces of raster images
+type Sprites interface {
+	// Sprite returns the piece drawn in a size x size image with a transparent background
+	Sprite(p chess.Piece, size int) image.Image
+}
+
+// PixelSprites draws the pieces as 16x16 pixel art scaled to the squares, so square
+// sizes that are a multiple of 16 look sharpest. Pieces without a sprite, such as fairy
+// pieces, are drawn as a disc.
+var PixelSprites Sprites = pixelSprites{}
+
+// ImageSprites are sprites from images, e.g. decoded from PNG files, keyed by the
+// piece's FEN symbol ("K" for the white king, "k" for the black king). The images are
+// scaled to the squares and pieces without an image are drawn by PixelSprites.
+type ImageSprites map[string]image.Image
+
+// Sprite scales the piece's image to the square
+func (s ImageSprites) Sprite(p chess.Piece, size int) image.Image {
+	img, ok := s[p.FENSymbol()]
+	if !ok {
+		return PixelSprites.Sprite(p, size)
+	}
+	return scale(img, size)
+}
+
+type pixelSprites struct{}
+
+// Sprite draws the piece's outline in black, its body in its color and the markings on
+// its body in the other color
+func (pixelSprites) Sprite(p chess.Piece, size int) image.Image {
+	sprite, ok := pixelPieces[p.Symbol]
+	if !ok {
+		sprite = pixelDisc
+	}
+	fill, detail := color.Color(color.White), color.Color(color.Black)
+	if p.Color == chess.BLACK {
+		fill, detail = color.Black, color.White
+	}
+	colors := map[byte]color.Color{'#': color.Black, 'o': fill, '+': detail}
+
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		row := sprite[y*len(sprite)/size]
+		for x := 0; x < size; x++ {
+			if c, ok := colors[row[x*len(row)/size]]; ok {
+				img.Set(x, y, c)
+			}
+		}
+	}
+	return img
+}
+
+// scale resizes an image to a size x size square by nearest neighbour sampling
+func scale(src image.Image, size int) image.Image {
+	bounds := src.Bounds()
+	if bounds.Dx() == size && bounds.Dy() == size {
+		return src
+	}
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			img.Set(x, y, src.At(bounds.Min.X+x*bounds.Dx()/size, bounds.Min.Y+y*bounds.Dy()/size))
+		}
+	}
+	return img
+}
+
+// drawSprite draws a sprite over a square whose top left corner is at x, y
+func drawSprite(dst draw.Image, sprite image.Image, x, y int) {
+	bounds := sprite.Bounds()
+	draw.Draw(dst, image.Rect(x, y, x+bounds.Dx(), y+bounds.Dy()), sprite, bounds.Min, draw.Over)
+}
+
+// pixelPieces are the pixel art pieces, with "#" for the outline, "o" for the body, "+"
+// for markings on the body and "." for transparent pixels
+var pixelPieces = map[chess.Symbol][]string{
+	chess.PAWN: {
+		"................",
+		"................",
+		"................",
+		"......####......",
+		".....#oooo#.....",
+		".....#oooo#.....",
+		"......#oo#......",
+		".....#oooo#.....",
+		"....#oooooo#....",
+		".....#oooo#.....",
+		".....#oooo#.....",
+		"....#oooooo#....",
+		"...#oooooooo#...",
+		"..#oooooooooo#..",
+		"..############..",
+		"................",
+	},
+	chess.ROOK: {
+		"................",
+		"..###.####.###..",
+		"..#o###oo###o#..",
+		"..#oooooooooo#..",
+		"..#++++++++++#..",
+		"...#oooooooo#...",
+		"...#oooooooo#...",
+		"...#oooooooo#...",
+		"...#oooooooo#...",
+		"...#oooooooo#...",
+		"..#++++++++++#..",
+		"..#oooooooooo#..",
+		".#oooooooooooo#.",
+		".#oooooooooooo#.",
+		".##############.",
+		"................",
+	},
+	chess.KNIGHT: {
+		"................",
+		"......#.#.......",
+		".....#o#o#......",
+		"....#oooooo#....",
+		"...#oo+ooooo#...",
+		"..#oooooooooo#..",
+		".#ooooooooooo#..",
+		".#ooo###ooooo#..",
+		"..###.#oooooo#..",
+		"......#oooooo#..",
+		".....#ooooooo#..",
+		"....#oooooooo#..",
+		"...#ooooooooo#..",
+		"..#oooooooooo#..",
+		"..############..",
+		"................",
+	},
+	chess.BISHOP: {
+		"................",
+		".......##.......",
+		"......#oo#......",
+		".......##.......",
+		"......#oo#......",
+		".....#oo+o#.....",
+		"....#oo+ooo#....",
+		"....#o+oooo#....",
+		"....#oooooo#....",
+		".....#oooo#.....",
+		"....#++++++#....",
+		".....#oooo#.....",
+		"...#oooooooo#...",
+		"..#oooooooooo#..",
+		"..############..",
+		"................",
+	},
+	chess.QUEEN: {
+		"................",
+		".#.....##.....#.",
+		".##...#oo#...##.",
+		".#o#.#oooo#.#o#.",
+		".#oo#oooooo#oo#.",
+		".#oooooooooooo#.",
+		"..#oooooooooo#..",
+		"..#oooooooooo#..",
+		"...#oooooooo#...",
+		"...#++++++++#...",
+		"...#oooooooo#...",
+		"..#oooooooooo#..",
+		"..#++++++++++#..",
+		"..#oooooooooo#..",
+		"..############..",
+		"................",
+	},
+	chess.KING: {
+		"................",
+		".......##.......",
+		"......####......",
+		".......##.......",
+		"..###..##..###..",
+		".#ooo#.##.#ooo#.",
+		"#ooooo+oo+ooooo#",
+		"#oooooo++oooooo#",
+		"#oooooooooooooo#",
+		".#oooooooooooo#.",
+		"..#oooooooooo#..",
+		"..#++++++++++#..",
+		"..#oooooooooo#..",
+		"..#++++++++++#..",
+		"..############..",
+		"................",
+	},
+}
+
+// pixelDisc is drawn for pieces without pixel art
+var pixelDisc = []string{
+	"................",
+	"................",
+	"................",
+	"......####......",
+	"....##oooo##....",
+	"...#oooooooo#...",
+	"...#oooooooo#...",
+	"..#oooooooooo#..",
+	"..#oooooooooo#..",
+	"..#oooooooooo#..",
+	"...#oooooooo#...",
+	"...#oooooooo#...",
+	"....##oooo##....",
+	"......####......",
+	"................",
+	"................",
+}
@@ -0,0 +1,310 @@
+package render
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// Colors are the colors of a diagram in any format SVG accepts, e.g. "#f0d9b5" or "red".
+// Annotations are drawn over the board so colors with some transparency work best.
+type Colors struct {
+	Light      string // Light squares
+	Dark       string // Dark squares
+	LastMove   string // Highlight of the squares of the last move
+	Check      string // Highlight of the king in check
+	Arrow      string // Arrows and circles without their own color
+	Margin     string // Margin around the board holding the coordinates
+	Coordinate string // File and rank labels
+}
+
+// DefaultColors are the brown board colors used by lichess
+var DefaultColors = Colors{
+	Light:      "#f0d9b5",
+	Dark:       "#b58863",
+	LastMove:   "rgba(155, 199, 0, 0.41)",
+	Check:      "#ff0000",
+	Arrow:      "rgba(21, 120, 27, 0.8)",
+	Margin:     "#212121",
+	Coordinate: "#e5e5e5",
+}
+
+// Arrow is an arrow drawn from the center of one square to another. An arrow to the
+// square it starts on is drawn as a circle.
+type Arrow struct {
+	From, To int
+	Color    string // Colors.Arrow if empty
+}
+
+// Circle is a ring drawn around a square
+type Circle struct {
+	Square int
+	Color  string // Colors.Arrow if empty
+}
+
+// SVGOptions configure the diagrams drawn by RenderSVG. The zero value draws a plain
+// board from white's side with 45 pixel squares.
+type SVGOptions struct {
+	SquareSize  int         // Size of each square in pixels, 45 if 0
+	Orientation chess.Color // Side at the bottom of the board
+	Coordinates bool        // Label the files and ranks in a margin around the board
+	LastMove    *chess.Move // Move whose squares are highlighted
+	Check       bool        // Highlight the king of the side to move if it's in check
+	Arrows      []Arrow
+	Circles     []Circle
+	Colors      Colors   // DefaultColors are used for any colors left empty
+	Pieces      PieceSet // Cburnett if nil
+}
+
+// defaultSquareSize is the size of the squares when SVGOptions.SquareSize isn't given,
+// matching the 45x45 box pieces are drawn in
+const defaultSquareSize = 45
+
+// withDefaults returns the options with defaults filled in for the settings left empty
+func (opts SVGOptions) withDefaults() SVGOptions {
+	if opts.SquareSize <= 0 {
+		opts.SquareSize = defaultSquareSize
+	}
+	if opts.Pieces == nil {
+		opts.Pieces = Cburnett
+	}
+	colors, defaults := &opts.Colors, DefaultColors
+	fill := func(color *string, fallback string) {
+		if *color == "" {
+			*color = fallback
+		}
+	}
+	fill(&colors.Light, defaults.Light)
+	fill(&colors.Dark, defaults.Dark)
+	fill(&colors.LastMove, defaults.LastMove)
+	fill(&colors.Check, defaults.Check)
+	fill(&colors.Arrow, defaults.Arrow)
+	fill(&colors.Margin, defaults.Margin)
+	fill(&colors.Coordinate, defaults.Coordinate)
+	return opts
+}
+
+// RenderSVG draws the board as a standalone SVG document
+func RenderSVG(board *chess.Board, opts SVGOptions) string {
+	opts = opts.withDefaults()
+	d := newDiagram(opts)
+
+	var s strings.Builder
+	fmt.Fprintf(&s, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" `+
+		`version="1.1" width="%d" height="%d" viewBox="0 0 %d %d">`, d.size, d.size, d.size, d.size)
+	s.WriteString(d.definitions(board))
+
+	if opts.Coordinates {
+		fmt.Fprintf(&s, `<rect x="0" y="0" width="%d" height="%d" fill="%s"/>`, d.size, d.size, opts.Colors.Margin)
+	}
+	for sq := 0; sq < chess.RANKS*chess.FILES; sq++ {
+		x, y := d.corner(sq)
+		color := opts.Colors.Dark
+		if file, rank := chess.BitToCartesian(sq); (file+rank)%2 == 1 {
+			color = opts.Colors.Light
+		}
+		fmt.Fprintf(&s, `<rect x="%d" y="%d" width="%d" height="%d" class="square %s" fill="%s"/>`,
+			x, y, opts.SquareSize, opts.SquareSize, chess.BitToAlgebraic(sq), color)
+	}
+
+	if m := opts.LastMove; m != nil {
+		for _, sq := range []int{m.From, m.To} {
+			if sq == chess.NoSquare {
+				continue
+			}
+			x, y := d.corner(sq)
+			fmt.Fprintf(&s, `<rect x="%d" y="%d" width="%d" height="%d" class="lastmove" fill="%s"/>`,
+				x, y, opts.SquareSize, opts.SquareSize, opts.Colors.LastMove)
+		}
+	}
+
+	if opts.Check {
+		if king := checkedKing(board); king != chess.NoSquare {
+			x, y := d.corner(king)
+			fmt.Fprintf(&s, `<rect x="%d" y="%d" width="%d" height="%d" class="check" fill="url(#check-gradient)"/>`,
+				x, y, opts.SquareSize, opts.SquareSize)
+		}
+	}
+
+	if opts.Coordinates {
+		s.WriteString(d.coordinates())
+	}
+
+	for sq := 0; sq < chess.RANKS*chess.FILES; sq++ {
+		if occupied, piece := board.GetSquare(sq); occupied {
+			x, y := d.corner(sq)
+			fmt.Fprintf(&s, `<use xlink:href="#%s" href="#%s" transform="translate(%d, %d) scale(%s)"/>`,
+				d.pieceID(*piece), d.pieceID(*piece), x, y, d.scale())
+		}
+	}
+
+	for _, c := range opts.Circles {
+		s.WriteString(d.circle(c.Square, c.Color))
+	}
+	for _, a := range opts.Arrows {
+		if a.From == a.To {
+			s.WriteString(d.circle(a.From, a.Color))
+		} else {
+			s.WriteString(d.arrow(a))
+		}
+	}
+
+	s.WriteString("</svg>")
+	return s.String()
+}
+
+// checkedKing returns the square of the king of the side to move if it's in check, or
+// NoSquare
+func checkedKing(board *chess.Board) int {
+	if !board.InCheck() {
+		return chess.NoSquare
+	}
+	for sq := 0; sq < chess.RANKS*chess.FILES; sq++ {
+		if occupied, piece := board.GetSquare(sq); occupied && piece.Symbol == chess.KING && piece.Color == board.Turn {
+			return sq
+		}
+	}
+	return chess.NoSquare
+}
+
+// diagram lays out the squares of a diagram
+type diagram struct {
+	opts   SVGOptions
+	margin int // Width of the margin around the board
+	size   int // Width and height of the whole diagram
+}
+
+func newDiagram(opts SVGOptions) diagram {
+	d := diagram{opts: opts}
+	if opts.Coordinates {
+		d.margin = opts.SquareSize / 2
+	}
+	d.size = 2*d.margin + chess.FILES*opts.SquareSize
+	return d
+}
+
+// corner returns the position of the top left corner of a square
+func (d diagram) corner(sq int) (int, int) {
+	file, rank := chess.BitToCartesian(sq)
+	if d.opts.Orientation == chess.BLACK {
+		file = chess.FILES - 1 - file
+	} else {
+		rank = chess.RANKS - 1 - rank
+	}
+	return d.margin + file*d.opts.SquareSize, d.margin + rank*d.opts.SquareSize
+}
+
+// center returns the position of the center of a square
+func (d diagram) center(sq int) (float64, float64) {
+	x, y := d.corner(sq)
+	half := float64(d.opts.SquareSize) / 2
+	return float64(x) + half, float64(y) + half
+}
+
+// scale returns the factor scaling the 45x45 piece box to a square
+func (d diagram) scale() string {
+	return formatFloat(float64(d.opts.SquareSize) / defaultSquareSize)
+}
+
+// pieceID returns the id of the definition of a piece
+func (d diagram) pieceID(p chess.Piece) string {
+	return fmt.Sprintf("%s-%s-%s", d.opts.Pieces.Name(), p.Color, strings.Replace(p.Symbol.String(), " ", "-", -1))
+}
+
+// definitions returns the pieces on the board and the check gradient for reuse
+func (d diagram) definitions(board *chess.Board) string {
+	var s strings.Builder
+	s.WriteString("<defs>")
+	defined := map[string]bool{}
+	for sq := 0; sq < chess.RANKS*chess.FILES; sq++ {
+		occupied, piece := board.GetSquare(sq)
+		if !occupied || defined[d.pieceID(*piece)] {
+			continue
+		}
+		defined[d.pieceID(*piece)] = true
+		fmt.Fprintf(&s, `<g id="%s" class="%s %s">%s</g>`, d.pieceID(*piece), piece.Color, piece.Symbol, d.opts.Pieces.SVG(*piece))
+	}
+	fmt.Fprintf(&s, `<radialGradient id="check-gradient" r="0.5">`+
+		`<stop offset="0%%" stop-color="%[1]s" stop-opacity="1"/>`+
+		`<stop offset="50%%" stop-color="%[1]s" stop-opacity="1"/>`+
+		`<stop offset="100%%" stop-color="%[1]s" stop-opacity="0"/>`+
+		`</radialGradient>`, d.opts.Colors.Check)
+	s.WriteString("</defs>")
+	return s.String()
+}
+
+// coordinates returns the file letters along the top and bottom margins and the rank
+// numbers along the sides
+func (d diagram) coordinates() string {
+	var s strings.Builder
+	fontSize := formatFloat(float64(d.margin) * 0.8)
+	label := func(x, y float64, text string) {
+		fmt.Fprintf(&s, `<text x="%s" y="%s" font-size="%s" font-family="sans-serif" text-anchor="middle" `+
+			`dominant-baseline="central" fill="%s" class="coord">%s</text>`,
+			formatFloat(x), formatFloat(y), fontSize, d.opts.Colors.Coordinate, text)
+	}
+	edge := float64(d.margin) / 2
+	for file := 0; file < chess.FILES; file++ {
+		x, _ := d.center(chess.CartesianToBit(file, 0))
+		letter := string(rune('a' + file))
+		label(x, edge, letter)
+		label(x, float64(d.size)-edge, letter)
+	}
+	for rank := 0; rank < chess.RANKS; rank++ {
+		_, y := d.center(chess.CartesianToBit(0, rank))
+		number := fmt.Sprint(rank + 1)
+		label(edge, y, number)
+		label(float64(d.size)-edge, y, number)
+	}
+	return s.String()
+}
+
+// circle returns a ring around a square
+func (d diagram) circle(sq int, color string) string {
+	if color == "" {
+		color = d.opts.Colors.Arrow
+	}
+	x, y := d.center(sq)
+	width := float64(d.opts.SquareSize) / 15
+	radius := float64(d.opts.SquareSize)/2 - width/2
+	return fmt.Sprintf(`<circle cx="%s" cy="%s" r="%s" stroke="%s" stroke-width="%s" fill="none" class="circle"/>`,
+		formatFloat(x), formatFloat(y), formatFloat(radius), color, formatFloat(width))
+}
+
+// arrow returns an arrow from the center of one square to another, with the head ending
+// short of the center of the target square
+func (d diagram) arrow(a Arrow) string {
+	color := a.Color
+	if color == "" {
+		color = d.opts.Colors.Arrow
+	}
+	size := float64(d.opts.SquareSize)
+	width, headWidth, headLength := size/5, size*0.55, size*0.45
+	x1, y1 := d.center(a.From)
+	x2, y2 := d.center(a.To)
+	dx, dy := x2-x1, y2-y1
+	length := math.Hypot(dx, dy)
+	ux, uy := dx/length, dy/length
+
+	tipX, tipY := x2-ux*size*0.15, y2-uy*size*0.15
+	baseX, baseY := tipX-ux*headLength, tipY-uy*headLength
+	point := func(x, y float64) string {
+		return formatFloat(x) + "," + formatFloat(y)
+	}
+	return fmt.Sprintf(`<g class="arrow"><line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s" stroke-width="%s" `+
+		`stroke-linecap="butt"/><polygon points="%s %s %s" fill="%s"/></g>`,
+		formatFloat(x1), formatFloat(y1), formatFloat(baseX), formatFloat(baseY), color, formatFloat(width),
+		point(tipX, tipY), point(baseX-uy*headWidth/2, baseY+ux*headWidth/2),
+		point(baseX+uy*headWidth/2, baseY-ux*headWidth/2), color)
+}
+
+// formatFloat formats a coordinate with at most two decimal places
+func formatFloat(f float64) string {
+	s := strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", f), "0"), ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
@@ -0,0 +1,102 @@
+package render
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// elements parses an SVG document, counting the elements of each name and recording
+// the attributes of each element by its class
+func elements(t *testing.T, svg string) (map[string]int, map[string][]map[string]string) {
+	counts, classes := map[string]int{}, map[string][]map[string]string{}
+	decoder := xml.NewDecoder(strings.NewReader(svg))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("invalid SVG: %s\n%s", err, svg)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			counts[start.Name.Local]++
+			attrs := map[string]string{}
+			for _, attr := range start.Attr {
+				attrs[attr.Name.Local] = attr.Value
+			}
+			classes[attrs["class"]] = append(classes[attrs["class"]], attrs)
+		}
+	}
+	return counts, classes
+}
+
+func TestRenderSVG(t *testing.T) {
+	board, _ := chess.NewBoard()
+	svg := RenderSVG(board, SVGOptions{})
+	counts, classes := elements(t, svg)
+	if counts["svg"] != 1 || counts["use"] != 32 || counts["rect"] != 64 || counts["text"] != 0 {
+		t.Errorf("unexpected elements for the starting position: %v", counts)
+	}
+	// Twelve kinds of pieces are defined once each
+	if counts["g"] < 12 || len(classes["white king"]) != 1 || len(classes["black pawn"]) != 1 {
+		t.Errorf("expected each piece to be defined once, actual: %v", counts)
+	}
+	if !strings.Contains(svg, `width="360" height="360"`) {
+		t.Errorf("expected a 360 pixel board")
+	}
+	if a1 := classes["square a1"][0]; a1["x"] != "0" || a1["y"] != "315" || a1["fill"] != DefaultColors.Dark {
+		t.Errorf("expected a dark a1 in the bottom left corner, actual: %v", a1)
+	}
+	if h1 := classes["square h1"][0]; h1["fill"] != DefaultColors.Light {
+		t.Errorf("expected a light h1, actual: %v", h1)
+	}
+}
+
+func TestRenderSVGOptions(t *testing.T) {
+	board, _ := chess.ParseFEN("rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3")
+	last := chess.Move{From: chess.D8, To: chess.H4}
+	svg := RenderSVG(board, SVGOptions{
+		SquareSize:  60,
+		Orientation: chess.BLACK,
+		Coordinates: true,
+		LastMove:    &last,
+		Check:       true,
+		Arrows:      []Arrow{{From: chess.E2, To: chess.E4}, {From: chess.D4, To: chess.D4, Color: "blue"}},
+		Circles:     []Circle{{Square: chess.F3, Color: "red"}},
+		Colors:      Colors{Light: "white"},
+		Pieces:      UnicodeGlyphs,
+	})
+	counts, classes := elements(t, svg)
+
+	// Flipped, a1 is in the top right corner inside a 30 pixel margin
+	if a1 := classes["square a1"][0]; a1["x"] != "450" || a1["y"] != "30" || a1["width"] != "60" {
+		t.Errorf("expected a1 in the top right corner, actual: %v", a1)
+	}
+	if h1 := classes["square h1"][0]; h1["fill"] != "white" {
+		t.Errorf("expected the light squares to be white, actual: %v", h1)
+	}
+	if len(classes["lastmove"]) != 2 || len(classes["check"]) != 1 || len(classes["coord"]) != 32 {
+		t.Errorf("expected highlights and coordinates, actual: %v", counts)
+	}
+	if check := classes["check"][0]; check["x"] != "210" || check["y"] != "30" {
+		t.Errorf("expected the white king on e1 to be highlighted, actual: %v", check)
+	}
+	if len(classes["arrow"]) != 1 || len(classes["circle"]) != 2 || counts["polygon"] != 1 {
+		t.Errorf("expected an arrow and two circles, actual: %v", counts)
+	}
+	if circle := classes["circle"][0]; circle["stroke"] != "red" || circle["cx"] != "180" || circle["cy"] != "180" {
+		t.Errorf("expected a red circle around f3, actual: %v", circle)
+	}
+	// One glyph is defined for each of the twelve pieces, plus the coordinates
+	if counts["text"] != 12+32 || !strings.Contains(svg, "♚") {
+		t.Errorf("expected the pieces to be drawn as glyphs, actual: %v", counts)
+	}
+
+	board, _ = chess.ParseFEN("rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3")
+	if _, classes := elements(t, RenderSVG(board, SVGOptions{})); len(classes["check"]) != 0 {
+		t.Errorf("check should only be highlighted when asked for")
+	}
+}
@@ -0,0 +1,319 @@
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"sort"
+	"strings"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/clock"
+)
+
+// ColorMode is the colors a terminal can display
+type ColorMode int
+
+// Color modes, from plain text to 24-bit color
+const (
+	NoColor   ColorMode = iota // Plain text
+	Color256                   // The xterm 256 color palette
+	TrueColor                  // 24-bit color
+)
+
+// TerminalOptions configure the boards drawn by RenderTerminal. The zero value draws a
+// plain text board from white's side.
+//
+// Without colors, the last move's squares are drawn as [x], the king in check as {x},
+// targets that are empty as * and targets that are occupied as (x).
+type TerminalOptions struct {
+	Orientation chess.Color  // Side at the bottom of the board
+	Colors      ColorMode    // Colors of the terminal, with checkered squares unless NoColor
+	ASCII       bool         // Draw pieces as letters, KQRBNP for white and kqrbnp for black
+	LastMove    *chess.Move  // Move whose squares are highlighted
+	Check       bool         // Highlight the king of the side to move if it's in check
+	Targets     []int        // Squares to highlight, e.g. where a selected piece can move
+	Captured    bool         // Show the pieces each side has captured beside the board
+	Clock       *clock.Clock // Show each side's time beside the board
+	Theme       Theme        // BrownTheme is used for any colors left nil
+}
+
+// RenderTerminal draws the board as text for a terminal, with ANSI escape sequences for
+// the colors unless the mode is NoColor
+func RenderTerminal(board *chess.Board, opts TerminalOptions) string {
+	opts.Theme = opts.Theme.withDefaults()
+	highlights := map[int]string{}
+	for _, sq := range opts.Targets {
+		highlights[sq] = "target"
+	}
+	if m := opts.LastMove; m != nil {
+		for _, sq := range []int{m.From, m.To} {
+			highlights[sq] = "lastmove"
+		}
+	}
+	if opts.Check {
+		if king := checkedKing(board); king != chess.NoSquare {
+			highlights[king] = "check"
+		}
+	}
+
+	files := make([]int, chess.FILES)
+	ranks := make([]int, chess.RANKS)
+	for i := range files {
+		files[i] = i
+		if opts.Orientation == chess.BLACK {
+			files[i] = chess.FILES - 1 - i
+		}
+	}
+	for i := range ranks {
+		ranks[i] = chess.RANKS - 1 - i
+		if opts.Orientation == chess.BLACK {
+			ranks[i] = i
+		}
+	}
+
+	var labels strings.Builder
+	labels.WriteString("  ")
+	for _, file := range files {
+		fmt.Fprintf(&labels, " %c ", 'a'+file)
+	}
+	header := strings.TrimRight(labels.String(), " ")
+
+	panel := panel(board, opts)
+	lines := []string{header}
+	for i, rank := range ranks {
+		var s strings.Builder
+		fmt.Fprintf(&s, "%d ", rank+1)
+		for _, file := range files {
+			sq := chess.CartesianToBit(file, rank)
+			s.WriteString(opts.square(board, sq, (file+rank)%2 == 1, highlights[sq]))
+		}
+		if opts.Colors != NoColor {
+			s.WriteString("\x1b[0m")
+		}
+		fmt.Fprintf(&s, " %d", rank+1)
+		if panel[i] != "" {
+			s.WriteString("   " + panel[i])
+		}
+		lines = append(lines, s.String())
+	}
+	lines = append(lines, header)
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// square draws a square three characters wide
+func (opts TerminalOptions) square(board *chess.Board, sq int, light bool, highlight string) string {
+	occupied, piece := board.GetSquare(sq)
+	glyph := "-"
+	if occupied {
+		glyph = opts.glyph(*piece)
+	} else if highlight == "target" {
+		glyph = "*"
+		if !opts.ASCII && opts.Colors != NoColor {
+			glyph = "•"
+		}
+	} else if opts.Colors != NoColor {
+		glyph = " "
+	}
+
+	if opts.Colors == NoColor {
+		switch {
+		case highlight == "lastmove":
+			return "[" + glyph + "]"
+		case highlight == "check":
+			return "{" + glyph + "}"
+		case highlight == "target" && occupied:
+			return "(" + glyph + ")"
+		}
+		return " " + glyph + " "
+	}
+
+	background := opts.Theme.Dark
+	if light {
+		background = opts.Theme.Light
+	}
+	switch highlight {
+	case "lastmove":
+		background = blend(background, opts.Theme.LastMove)
+	case "check":
+		background = blend(background, opts.Theme.Check)
+	case "target":
+		background = blend(background, opts.Theme.Target)
+	}
+	foreground := color.Color(color.Black)
+	if occupied && piece.Color == chess.WHITE {
+		foreground = color.White
+	}
+	return opts.Colors.background(background) + opts.Colors.foreground(foreground) + " " + glyph + " "
+}
+
+// glyph returns the character drawing a piece. With colors, both sides use the solid
+// glyphs so their pieces have the same shape.
+func (opts TerminalOptions) glyph(p chess.Piece) string {
+	if opts.ASCII {
+		return p.FENSymbol()
+	}
+	if opts.Colors == NoColor {
+		return p.Unicode()
+	}
+	solid := chess.Piece{Color: chess.BLACK, Symbol: p.Symbol}
+	if glyph := solid.Unicode(); glyph != solid.FENSymbol() {
+		return glyph
+	}
+	return p.FENSymbol()
+}
+
+// panel returns the lines shown beside each rank, with the clock and captures of the
+// side at the top of the board beside the top ranks and of the other side beside the
+// bottom ranks
+func panel(board *chess.Board, opts TerminalOptions) []string {
+	lines := make([]string, chess.RANKS)
+	top, bottom := opts.Orientation.Opponent(), opts.Orientation
+	if opts.Clock != nil {
+		lines[0] = clockLine(opts.Clock, top)
+		lines[chess.RANKS-1] = clockLine(opts.Clock, bottom)
+	}
+	if opts.Captured {
+		topCaptures, bottomCaptures := captured(board, bottom), captured(board, top)
+		lines[1] = opts.capturedLine(topCaptures, value(topCaptures)-value(bottomCaptures))
+		lines[chess.RANKS-2] = opts.capturedLine(bottomCaptures, value(bottomCaptures)-value(topCaptures))
+	}
+	return lines
+}
+
+// clockLine shows a side's time, marked with a * while it's running
+func clockLine(c *clock.Clock, side chess.Color) string {
+	marker := " "
+	if c.Running() && c.Turn() == side {
+		marker = "*"
+	}
+	return fmt.Sprintf("%s%s %s", marker, side, clock.Format(c.Remaining(side)))
+}
+
+// capturedLine shows the pieces a side has captured followed by its material advantage
+func (opts TerminalOptions) capturedLine(pieces []chess.Piece, advantage int) string {
+	var s strings.Builder
+	for _, p := range pieces {
+		if opts.ASCII {
+			s.WriteString(p.FENSymbol())
+		} else {
+			s.WriteString(p.Unicode())
+		}
+	}
+	if advantage > 0 {
+		fmt.Fprintf(&s, " +%d", advantage)
+	}
+	return s.String()
+}
+
+// captured returns the pieces of a color missing from the board compared to the standard
+// starting position, most valuable first. Pieces beyond the starting set are taken to be
+// promoted pawns.
+func captured(board *chess.Board, c chess.Color) []chess.Piece {
+	start := map[chess.Symbol]int{chess.QUEEN: 1, chess.ROOK: 2, chess.BISHOP: 2, chess.KNIGHT: 2}
+	pawns := 8 - board.Positions[chess.PieceIndex(c, chess.PAWN)].Population()
+	var pieces []chess.Piece
+	for symbol, count := range start {
+		piece := chess.Pieces[chess.PieceIndex(c, symbol)]
+		missing := count - board.Positions[piece.Index].Population()
+		if missing < 0 {
+			pawns += missing
+		}
+		for i := 0; i < missing; i++ {
+			pieces = append(pieces, piece)
+		}
+	}
+	for i := 0; i < pawns; i++ {
+		pieces = append(pieces, chess.Pieces[chess.PieceIndex(c, chess.PAWN)])
+	}
+	sort.SliceStable(pieces, func(i, j int) bool {
+		if pieces[i].Value != pieces[j].Value {
+			return pieces[i].Value > pieces[j].Value
+		}
+		return pieces[i].Symbol < pieces[j].Symbol
+	})
+	return pieces
+}
+
+// value returns the total value of pieces
+func value(pieces []chess.Piece) int {
+	total := 0
+	for _, p := range pieces {
+		total += int(p.Value)
+	}
+	return total
+}
+
+// blend returns a highlight drawn over a color
+func blend(base, highlight color.Color) color.Color {
+	img := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, base)
+	draw.Draw(img, img.Bounds(), image.NewUniform(highlight), image.Point{}, draw.Over)
+	return img.At(0, 0)
+}
+
+// background returns the escape sequence setting the background color
+func (mode ColorMode) background(c color.Color) string {
+	if mode == Color256 {
+		return fmt.Sprintf("\x1b[48;5;%dm", xterm256(c))
+	}
+	r, g, b := rgb(c)
+	return fmt.Sprintf("\x1b[48;2;%d;%d;%dm", r, g, b)
+}
+
+// foreground returns the escape sequence setting the text color
+func (mode ColorMode) foreground(c color.Color) string {
+	if mode == Color256 {
+		return fmt.Sprintf("\x1b[38;5;%dm", xterm256(c))
+	}
+	r, g, b := rgb(c)
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm", r, g, b)
+}
+
+// rgb returns the 8-bit red, green and blue components of a color
+func rgb(c color.Color) (int, int, int) {
+	r, g, b, _ := c.RGBA()
+	return int(r >> 8), int(g >> 8), int(b >> 8)
+}
+
+// xterm256 returns the closest color in the xterm palette's 6x6x6 color cube or its
+// grayscale ramp
+func xterm256(c color.Color) int {
+	r, g, b := rgb(c)
+	levels := []int{0, 95, 135, 175, 215, 255}
+	level := func(v int) int {
+		closest := 0
+		for i, l := range levels {
+			if abs(v-l) < abs(v-levels[closest]) {
+				closest = i
+			}
+		}
+		return closest
+	}
+	distance := func(r2, g2, b2 int) int {
+		return (r-r2)*(r-r2) + (g-g2)*(g-g2) + (b-b2)*(b-b2)
+	}
+
+	ri, gi, bi := level(r), level(g), level(b)
+	cube := 16 + 36*ri + 6*gi + bi
+	gray := ((r+g+b)/3 - 8 + 5) / 10
+	if gray < 0 {
+		gray = 0
+	} else if gray > 23 {
+		gray = 23
+	}
+	grayLevel := 8 + 10*gray
+	if distance(grayLevel, grayLevel, grayLevel) < distance(levels[ri], levels[gi], levels[bi]) {
+		return 232 + gray
+	}
+	return cube
+}
+
+// abs returns the absolute value of an integer
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,98 @@
+package render
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/clock"
+)
+
+func TestRenderTerminal(t *testing.T) {
+	board, _ := chess.ParseFEN("rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3")
+	last := chess.Move{From: chess.D8, To: chess.H4}
+	expected := []string{
+		"   a  b  c  d  e  f  g  h",
+		"8  ♜  ♞  ♝ [-] ♚  ♝  ♞  ♜  8",
+		"7  ♟  ♟  ♟  ♟  -  ♟  ♟  ♟  7",
+		"6  -  -  -  -  -  -  -  -  6",
+		"5  -  -  -  -  ♟  -  -  -  5",
+		"4  -  -  -  -  -  - (♙)[♛] 4",
+		"3  -  -  -  -  -  ♙  *  -  3",
+		"2  ♙  ♙  ♙  ♙  ♙  -  -  ♙  2",
+		"1  ♖  ♘  ♗  ♕ {♔} ♗  ♘  ♖  1",
+		"   a  b  c  d  e  f  g  h",
+		"",
+	}
+	actual := RenderTerminal(board, TerminalOptions{
+		LastMove: &last,
+		Check:    true,
+		Targets:  []int{chess.G3, chess.G4},
+	})
+	if actual != strings.Join(expected, "\n") {
+		t.Errorf("expected:\n%s\nactual:\n%s", strings.Join(expected, "\n"), actual)
+	}
+
+	lines := strings.Split(RenderTerminal(board, TerminalOptions{Orientation: chess.BLACK, ASCII: true}), "\n")
+	if lines[0] != "   h  g  f  e  d  c  b  a" || lines[1] != "1  R  N  B  K  Q  B  N  R  1" {
+		t.Errorf("expected the board from black's side, actual:\n%s", strings.Join(lines, "\n"))
+	}
+}
+
+func TestRenderTerminalPanels(t *testing.T) {
+	board, _ := chess.ParseFEN("rnb1kbnr/pppp1ppp/8/8/8/8/PPPPPP1P/RNBQKBNR w KQkq - 0 3")
+	tc, _ := clock.ParseTimeControl("300")
+	src := clock.NewFakeSource(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	c, _ := clock.NewClock(tc, src)
+	c.Start()
+	src.Advance(1500 * time.Millisecond)
+
+	lines := strings.Split(RenderTerminal(board, TerminalOptions{ASCII: true, Captured: true, Clock: c}), "\n")
+	for i, panel := range map[int]string{1: " black 5:00", 2: "P", 7: "qp +9", 8: "*white 4:58"} {
+		if !strings.HasSuffix(lines[i], "   "+panel) {
+			t.Errorf("expected %q beside rank %d, actual: %q", panel, 9-i, lines[i])
+		}
+	}
+	if lines[3] != "6  -  -  -  -  -  -  -  -  6" {
+		t.Errorf("expected nothing beside rank 6, actual: %q", lines[3])
+	}
+
+	// Promoted pawns aren't counted as captured pieces
+	board, _ = chess.ParseFEN("4k3/8/8/8/8/8/1PPPPPPP/QQ2K3 w - - 0 1")
+	if pieces := captured(board, chess.WHITE); len(pieces) != 6 || pieces[0].Symbol != chess.ROOK || pieces[5].Symbol == chess.PAWN {
+		t.Errorf("expected two rooks, two bishops and two knights to be captured, actual: %v", pieces)
+	}
+}
+
+func TestRenderTerminalColors(t *testing.T) {
+	board, _ := chess.NewBoard()
+	last, _ := board.ParseMove("e4")
+	board.MakeMove(last)
+
+	truecolor := RenderTerminal(board, TerminalOptions{Colors: TrueColor, LastMove: &last})
+	lines := strings.Split(truecolor, "\n")
+	// a1 is dark with a white rook, a8 is light with a black rook
+	if !strings.HasPrefix(lines[8], "1 \x1b[48;2;181;136;99m\x1b[38;2;255;255;255m ♜ ") {
+		t.Errorf("expected a white rook on a dark a1, actual: %q", lines[8])
+	}
+	if !strings.HasPrefix(lines[1], "8 \x1b[48;2;240;217;181m\x1b[38;2;0;0;0m ♜ ") {
+		t.Errorf("expected a black rook on a light a8, actual: %q", lines[1])
+	}
+	if !strings.HasSuffix(lines[8], "\x1b[0m 1") || strings.Count(truecolor, "\x1b[48;2;205;210;106m") != 2 {
+		t.Errorf("expected the colors to be reset and e2 and e4 to be highlighted, actual: %q", truecolor)
+	}
+
+	xterm := RenderTerminal(board, TerminalOptions{Colors: Color256})
+	if !strings.Contains(xterm, "\x1b[48;5;") || !strings.Contains(xterm, "\x1b[38;5;231m") {
+		t.Errorf("expected 256 color escape sequences, actual: %q", xterm)
+	}
+	for c, expected := range map[color.RGBA]int{
+		{255, 0, 0, 255}: 196, {255, 255, 255, 255}: 231, {0, 0, 0, 255}: 16, {128, 128, 128, 255}: 244,
+	} {
+		if actual := xterm256(c); actual != expected {
+			t.Errorf("expected %v to be color %d, actual: %d", c, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package syzygy
+
+//-----------------------------------------------------------------------------
+// Position indexing
+//-----------------------------------------------------------------------------
+
+// Positions are mapped to an index into the table in the same way as the Syzygy
+// generator: the leading group of pieces (or pawns) is mapped to a canonical half
+// (or quarter) of the board using the board's symmetries and the remaining groups
+// of identical pieces are encoded as combinations of the squares still free.
+
+const maxPieces = 7
+
+var (
+	mapPawns      [64]int
+	mapB1H1H7     [64]int
+	mapA1D1D4     [64]int
+	mapKK         [10][64]int
+	binomial      [maxPieces][64]uint64
+	leadPawnIdx   [6][64]uint64
+	leadPawnsSize [6][4]uint64
+)
+
+func fileOf(sq int) int { return sq & 7 }
+func rankOf(sq int) int { return sq >> 3 }
+
+// offA1H8 is positive above the a1-h8 diagonal, negative below and zero on it
+func offA1H8(sq int) int { return rankOf(sq) - fileOf(sq) }
+
+func flipFile(sq int) int { return sq ^ 7 }
+func flipRank(sq int) int { return sq ^ 56 }
+
+func edgeDistance(file int) int {
+	if file > 7-file {
+		return 7 - file
+	}
+	return file
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func init() {
+	// mapB1H1H7 encodes a square below the a1-h8 diagonal to 0..27
+	code := 0
+	for sq := 0; sq < 64; sq++ {
+		if offA1H8(sq) < 0 {
+			mapB1H1H7[sq] = code
+			code++
+		}
+	}
+
+	// mapA1D1D4 encodes a square in the a1-d1-d4 triangle to 0..9, diagonal squares last
+	var diagonal []int
+	code = 0
+	for sq := 0; sq <= 27; sq++ {
+		if offA1H8(sq) < 0 && fileOf(sq) <= 3 {
+			mapA1D1D4[sq] = code
+			code++
+		} else if offA1H8(sq) == 0 && fileOf(sq) <= 3 {
+			diagonal = append(diagonal, sq)
+		}
+	}
+	for _, sq := range diagonal {
+		mapA1D1D4[sq] = code
+		code++
+	}
+
+	// mapKK encodes the 462 legal placements of two kings where the first is in the
+	// a1-d1-d4 triangle. If the first king is on the a1-d4 diagonal the other must not
+	// be above the a1-h8 diagonal. Placements with both kings on the diagonal come last.
+	type pair struct{ idx, sq int }
+	var bothOnDiagonal []pair
+	code = 0
+	for idx := 0; idx < 10; idx++ {
+		for s1 := 0; s1 <= 27; s1++ {
+			if mapA1D1D4[s1] != idx || (idx == 0 && s1 != 1) { // b1 is mapped to 0
+				continue
+			}
+			if fileOf(s1) > 3 || offA1H8(s1) > 0 {
+				continue
+			}
+			for s2 := 0; s2 < 64; s2++ {
+				if abs(fileOf(s1)-fileOf(s2)) <= 1 && abs(rankOf(s1)-rankOf(s2)) <= 1 {
+					continue // kings on the same or adjacent squares
+				} else if offA1H8(s1) == 0 && offA1H8(s2) > 0 {
+					continue
+				} else if offA1H8(s1) == 0 && offA1H8(s2) == 0 {
+					bothOnDiagonal = append(bothOnDiagonal, pair{idx, s2})
+				} else {
+					mapKK[idx][s2] = code
+					code++
+				}
+			}
+		}
+	}
+	for _, p := range bothOnDiagonal {
+		mapKK[p.idx][p.sq] = code
+		code++
+	}
+
+	// binomial[k][n] is the number of ways to choose k elements from n
+	binomial[0][0] = 1
+	for n := 1; n < 64; n++ {
+		for k := 0; k < maxPieces && k <= n; k++ {
+			if k > 0 {
+				binomial[k][n] += binomial[k-1][n-1]
+			}
+			if k < n {
+				binomial[k][n] += binomial[k][n-1]
+			}
+		}
+	}
+
+	// mapPawns encodes the squares a2-h7 to 0..47, the number of squares available to
+	// the other pawns when the leading pawn is on the square. The leading pawn is the
+	// one nearest the edge and, among pawns on the same file, the one with lowest rank.
+	available := 47
+	for leadPawns := 1; leadPawns <= 5; leadPawns++ {
+		for file := 0; file <= 3; file++ {
+			var idx uint64
+			for rank := 1; rank <= 6; rank++ {
+				sq := rank*8 + file
+				if leadPawns == 1 {
+					mapPawns[sq] = available
+					available--
+					mapPawns[flipFile(sq)] = available
+					available--
+				}
+				leadPawnIdx[leadPawns][sq] = idx
+				idx += binomial[leadPawns-1][mapPawns[sq]]
+			}
+			leadPawnsSize[leadPawns][file] = idx
+		}
+	}
+}
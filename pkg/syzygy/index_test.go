@@ -0,0 +1,135 @@
+package syzygy
+
+import (
+	"testing"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+func TestIndexTables(t *testing.T) {
+	codes := map[int]bool{}
+	for idx := 0; idx < 10; idx++ {
+		for sq := 0; sq < 64; sq++ {
+			if mapKK[idx][sq] != 0 || (idx == 0 && sq == chess.D1) {
+				codes[mapKK[idx][sq]] = true
+			}
+		}
+	}
+	if len(codes) != 462 {
+		t.Errorf("expected 462 king placements, actual: %d", len(codes))
+	}
+
+	if binomial[2][64-1] != 63*62/2 || binomial[5][48] != 1712304 {
+		t.Errorf("unexpected binomial coefficients %d %d", binomial[2][63], binomial[5][48])
+	}
+
+	// One pawn can be on any of the 6 squares of its file, pawns nearer the edge lead
+	if leadPawnsSize[1][0] != 6 || mapPawns[chess.A2] != 47 || mapPawns[chess.E7] != 0 {
+		t.Errorf("unexpected pawn mapping %d %d %d", leadPawnsSize[1][0], mapPawns[chess.A2], mapPawns[chess.E7])
+	}
+}
+
+// testBoard places the pieces, given as piece index and square pairs, on an empty board
+func testBoard(t *testing.T, turn chess.Color, placement ...int) *chess.Board {
+	positions := make([]chess.Bitboard, len(chess.Pieces))
+	for i := 0; i < len(placement); i += 2 {
+		positions[placement[i]].SetBit(placement[i+1])
+	}
+	b, err := chess.NewBoard(positions...)
+	if err != nil {
+		t.Fatalf("unexpected error creating board: %s", err)
+	}
+	b.Turn = turn
+	return b
+}
+
+// checkIndex verifies positions that are the same under symmetry share an index and
+// different positions don't
+func checkIndex(t *testing.T, tbl *table, boards map[int]*chess.Board, class map[int]int) {
+	classIndex, indexClass := map[int][2]uint64{}, map[[2]uint64]int{}
+	for key, b := range boards {
+		d, file, idx, ok := tbl.index(b, materialKey(b))
+		if !ok {
+			t.Fatalf("unexpected change of side to move")
+		}
+		n := 0
+		for d.groupLen[n] != 0 {
+			n++
+		}
+		if idx >= d.groupIdx[n] {
+			t.Fatalf("index %d out of range %d for %s", idx, d.groupIdx[n], b.FEN())
+		}
+		id := [2]uint64{uint64(file), idx}
+		c := class[key]
+		if prev, ok := classIndex[c]; ok && prev != id {
+			t.Fatalf("symmetric positions have different indices: %s", b.FEN())
+		}
+		if prev, ok := indexClass[id]; ok && prev != c {
+			t.Fatalf("different positions share index %v: %s", id, b.FEN())
+		}
+		classIndex[c], indexClass[id] = id, c
+	}
+}
+
+func TestIndexPieces(t *testing.T) {
+	single := [][]testSide{{{pieces: []int{6, 5, 14}, value: 4}, {pieces: []int{6, 5, 14}, value: 0}}}
+	tbl := loadTable(t, wdlTable, "KQvK", buildTable(wdlTable, false, true, single))
+
+	transpose := func(sq int) int { return (sq>>3 | sq<<3) & 63 }
+	symmetries := []func(int) int{
+		func(sq int) int { return sq },
+		flipFile,
+		flipRank,
+		func(sq int) int { return flipFile(flipRank(sq)) },
+		transpose,
+		func(sq int) int { return transpose(flipFile(sq)) },
+		func(sq int) int { return transpose(flipRank(sq)) },
+		func(sq int) int { return transpose(flipFile(flipRank(sq))) },
+	}
+
+	boards, class := map[int]*chess.Board{}, map[int]int{}
+	for wk := 0; wk < 64; wk++ {
+		for wq := 0; wq < 64; wq++ {
+			for bk := 0; bk < 64; bk++ {
+				if wk == wq || wk == bk || wq == bk || (wk+wq+bk)%3 != 0 { // a third is plenty
+					continue
+				}
+				key := wk<<12 | wq<<6 | bk
+				class[key] = key
+				for _, f := range symmetries {
+					if k := f(wk)<<12 | f(wq)<<6 | f(bk); k < class[key] {
+						class[key] = k
+					}
+				}
+				boards[key] = testBoard(t, chess.WHITE, chess.WhiteKing.Index, wk, chess.WhiteQueen.Index, wq, chess.BlackKing.Index, bk)
+			}
+		}
+	}
+	checkIndex(t, tbl, boards, class)
+}
+
+func TestIndexPawns(t *testing.T) {
+	var files [][]testSide
+	for f := 0; f < 4; f++ {
+		files = append(files, []testSide{{pieces: []int{1, 6, 14}, value: 4}, {pieces: []int{1, 6, 14}, value: 2}})
+	}
+	tbl := loadTable(t, wdlTable, "KPvK", buildTable(wdlTable, true, true, files))
+
+	boards, class := map[int]*chess.Board{}, map[int]int{}
+	for wp := chess.A2; wp <= chess.H7; wp++ {
+		for wk := 0; wk < 64; wk++ {
+			for bk := 0; bk < 64; bk++ {
+				if wk == wp || wk == bk || wp == bk || (wk+bk)%2 != 0 {
+					continue
+				}
+				key := wp<<12 | wk<<6 | bk
+				class[key] = key
+				if k := flipFile(wp)<<12 | flipFile(wk)<<6 | flipFile(bk); k < key {
+					class[key] = k
+				}
+				boards[key] = testBoard(t, chess.WHITE, chess.WhitePawn.Index, wp, chess.WhiteKing.Index, wk, chess.BlackKing.Index, bk)
+			}
+		}
+	}
+	checkIndex(t, tbl, boards, class)
+}
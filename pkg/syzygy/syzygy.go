@@ -0,0 +1,508 @@
+// Package syzygy probes Syzygy endgame tablebases for the game theoretical value of
+// positions with few pieces left on the board.
+//
+// WDL tables (.rtbw) store whether a position is won, drawn or lost and DTZ tables
+// (.rtbz) store the distance to the next capture or pawn move (zeroing move) when
+// following an optimal line. Together they are enough to play an endgame perfectly
+// under the fifty-move rule. See <https://www.chessprogramming.org/Syzygy_Bases>.
+package syzygy
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// WDL is the win/draw/loss value of a position for the side to move
+type WDL int
+
+// Loss is a loss for the side to move
+// BlessedLoss is a loss that can be saved by the fifty-move rule
+// Draw is a draw
+// CursedWin is a win that can be drawn by the fifty-move rule
+// Win is a win for the side to move
+const (
+	Loss        WDL = -2
+	BlessedLoss WDL = -1
+	Draw        WDL = 0
+	CursedWin   WDL = 1
+	Win         WDL = 2
+)
+
+// WDLNames maps the WDL value to the descriptive name
+var WDLNames = map[WDL]string{
+	Loss:        "loss",
+	BlessedLoss: "blessed loss",
+	Draw:        "draw",
+	CursedWin:   "cursed win",
+	Win:         "win",
+}
+
+func (w WDL) String() string {
+	if name, ok := WDLNames[w]; ok {
+		return name
+	}
+	return ""
+}
+
+// DTZ is the number of plies until the next zeroing move with optimal play. It is
+// positive when the side to move wins, negative when it loses and 0 for draws. Cursed
+// wins and blessed losses are offset by 100 plies.
+type DTZ int
+
+// maxDTZ ranks root moves so certain wins rank above everything else
+const maxDTZ = 1 << 18
+
+// Tablebase is a set of Syzygy table files. Files are read lazily the first time a
+// position with their material is probed.
+type Tablebase struct {
+	wdl       map[string]*table
+	dtz       map[string]*table
+	maxPieces int
+}
+
+// Open loads the names of the table files found in the given directories
+func Open(dirs ...string) (*Tablebase, error) {
+	tb := &Tablebase{wdl: map[string]*table{}, dtz: map[string]*table{}}
+	for _, dir := range dirs {
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			ext := filepath.Ext(f.Name())
+			tables, typ := tb.wdl, wdlTable
+			switch ext {
+			case ".rtbw":
+			case ".rtbz":
+				tables, typ = tb.dtz, dtzTable
+			default:
+				continue
+			}
+			t, err := newTable(filepath.Join(dir, f.Name()), typ, strings.TrimSuffix(f.Name(), ext))
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := tables[t.key]; ok {
+				continue // the first directory takes precedence
+			}
+			tables[t.key], tables[t.key2] = t, t
+			if t.pieceCount > tb.maxPieces {
+				tb.maxPieces = t.pieceCount
+			}
+		}
+	}
+	if len(tb.wdl) == 0 {
+		return nil, fmt.Errorf("no syzygy tables found in %s", strings.Join(dirs, ", "))
+	}
+	return tb, nil
+}
+
+// MaxPieces is the largest number of pieces (including kings) in any table
+func (tb *Tablebase) MaxPieces() int {
+	return tb.maxPieces
+}
+
+// Probe returns the WDL and DTZ values of the position for the side to move
+func (tb *Tablebase) Probe(board *chess.Board) (WDL, DTZ, error) {
+	wdl, err := tb.ProbeWDL(board)
+	if err != nil {
+		return wdl, 0, err
+	}
+	dtz, err := tb.ProbeDTZ(board)
+	return wdl, dtz, err
+}
+
+// ProbeWDL returns the win/draw/loss value of the position for the side to move. The
+// position must have no castling rights and at most MaxPieces pieces.
+func (tb *Tablebase) ProbeWDL(board *chess.Board) (WDL, error) {
+	if err := tb.check(board); err != nil {
+		return Draw, err
+	}
+	p := &prober{tb: tb, board: board.Copy()}
+	wdl, _ := p.search(false)
+	return wdl, p.err
+}
+
+// ProbeDTZ returns the distance to the next zeroing move for the side to move. The
+// position must have no castling rights and at most MaxPieces pieces.
+func (tb *Tablebase) ProbeDTZ(board *chess.Board) (DTZ, error) {
+	if err := tb.check(board); err != nil {
+		return 0, err
+	}
+	p := &prober{tb: tb, board: board.Copy()}
+	dtz := p.probeDTZ()
+	return DTZ(dtz), p.err
+}
+
+func (tb *Tablebase) check(board *chess.Board) error {
+	if board.Castling != chess.NoCastling {
+		return fmt.Errorf("tablebases do not contain positions with castling rights")
+	}
+	if n := board.Occupied.Population(); n > tb.maxPieces {
+		return fmt.Errorf("position has %d pieces, tablebases have at most %d", n, tb.maxPieces)
+	}
+	return nil
+}
+
+// RootMove is a legal move in the probed position ranked by its tablebase value.
+// Moves with a higher Rank are better, moves with the same Rank are equally good.
+type RootMove struct {
+	Move chess.Move
+	WDL  WDL
+	DTZ  DTZ
+	Rank int
+}
+
+// RootMoves ranks all legal moves of the position by their tablebase value, best first.
+// With DTZ tables available, wins are ranked by how quickly they can be converted
+// taking the fifty-move counter and repetitions into account; otherwise moves are
+// ranked only by their WDL value.
+func (tb *Tablebase) RootMoves(board *chess.Board) ([]RootMove, error) {
+	if err := tb.check(board); err != nil {
+		return nil, err
+	}
+	p := &prober{tb: tb, board: board.Copy()}
+	moves := p.rootProbe()
+	if p.err != nil {
+		// Fall back to WDL only when a DTZ table is missing
+		p.err = nil
+		moves = p.rootProbeWDL()
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	sort.SliceStable(moves, func(i, j int) bool {
+		return moves[i].Rank > moves[j].Rank
+	})
+	return moves, nil
+}
+
+// FilterRootMoves returns the legal moves that preserve the best achievable tablebase
+// result, e.g. only the winning moves of a won position, in order of preference
+func (tb *Tablebase) FilterRootMoves(board *chess.Board) ([]chess.Move, error) {
+	ranked, err := tb.RootMoves(board)
+	if err != nil {
+		return nil, err
+	}
+	var moves []chess.Move
+	for _, m := range ranked {
+		if m.Rank == ranked[0].Rank {
+			moves = append(moves, m.Move)
+		}
+	}
+	return moves, nil
+}
+
+//-----------------------------------------------------------------------------
+// Probing
+//-----------------------------------------------------------------------------
+
+// prober probes a copy of the position, making moves as required
+type prober struct {
+	tb    *Tablebase
+	board *chess.Board
+	err   error
+}
+
+// result of a table lookup
+type probeState int
+
+const (
+	probeOK          probeState = iota
+	probeChangeSTM              // DTZ table stores the other side to move
+	probeZeroingBest            // the best move is a capture or pawn move
+)
+
+// zeroing checks if a move resets the fifty-move counter
+func (p *prober) zeroing(m chess.Move) bool {
+	return m.IsCapture() || p.board.Pieces[m.Piece].Symbol == chess.PAWN
+}
+
+// search returns the WDL value of the position. Captures (and pawn moves when
+// checkZeroing is set) are searched since the tables don't store whether they are
+// the best move and positions with an en passant capture aren't in the tables at all.
+func (p *prober) search(checkZeroing bool) (WDL, probeState) {
+	best := Loss
+	moves := p.board.LegalMoves()
+	count := 0
+	for _, m := range moves {
+		if !m.IsCapture() && (!checkZeroing || p.board.Pieces[m.Piece].Symbol != chess.PAWN) {
+			continue
+		}
+		count++
+		p.board.MakeMove(m)
+		value, _ := p.search(false)
+		value = -value
+		p.board.UnmakeMove()
+		if p.err != nil {
+			return Draw, probeOK
+		}
+		if value > best {
+			best = value
+			if value >= Win {
+				return value, probeZeroingBest
+			}
+		}
+	}
+
+	// The table can't be trusted when every legal move has been searched, e.g. an en
+	// passant capture is the only move
+	var value WDL
+	noMoreMoves := count > 0 && count == len(moves)
+	if noMoreMoves {
+		value = best
+	} else {
+		v, _ := p.probeTable(wdlTable, Draw)
+		if p.err != nil {
+			return Draw, probeOK
+		}
+		value = WDL(v)
+	}
+
+	if best >= value {
+		if best > Draw || noMoreMoves {
+			return best, probeZeroingBest
+		}
+		return best, probeOK
+	}
+	return value, probeOK
+}
+
+// dtzBeforeZeroing is the DTZ of a position where the best move is a zeroing move
+func dtzBeforeZeroing(wdl WDL) int {
+	switch wdl {
+	case Win:
+		return 1
+	case CursedWin:
+		return 101
+	case BlessedLoss:
+		return -101
+	case Loss:
+		return -1
+	}
+	return 0
+}
+
+func sign(x int) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
+
+// probeDTZ returns the DTZ of the position in plies
+func (p *prober) probeDTZ() int {
+	wdl, state := p.search(true)
+	if p.err != nil || wdl == Draw {
+		return 0
+	}
+	if state == probeZeroingBest {
+		return dtzBeforeZeroing(wdl)
+	}
+
+	dtz, state := p.probeTable(dtzTable, wdl)
+	if p.err != nil {
+		return 0
+	}
+	if state != probeChangeSTM {
+		if wdl == BlessedLoss || wdl == CursedWin {
+			dtz += 100
+		}
+		return dtz * sign(int(wdl))
+	}
+
+	// The table only stores the other side to move, so search one ply for the move
+	// that reaches the next zeroing move fastest
+	minDTZ := 0xFFFF
+	for _, m := range p.board.LegalMoves() {
+		zeroing := p.zeroing(m)
+		p.board.MakeMove(m)
+		if zeroing {
+			// The DTZ of a zeroing move is the DTZ before it's made, the search only
+			// gives the sign of the result
+			v, _ := p.search(false)
+			dtz = -dtzBeforeZeroing(v)
+		} else {
+			dtz = -p.probeDTZ()
+		}
+		if dtz == 1 && p.board.IsCheckmate() {
+			minDTZ = 1
+		}
+		if !zeroing {
+			dtz += sign(dtz)
+		}
+		if dtz < minDTZ && sign(dtz) == sign(int(wdl)) {
+			minDTZ = dtz
+		}
+		p.board.UnmakeMove()
+		if p.err != nil {
+			return 0
+		}
+	}
+	if minDTZ == 0xFFFF {
+		return -1 // mated
+	}
+	return minDTZ
+}
+
+// rootProbe ranks the root moves using the DTZ tables
+func (p *prober) rootProbe() []RootMove {
+	b := p.board
+	cnt50, rep := b.HalfMoveClock, hasRepeated(b)
+
+	var moves []RootMove
+	for _, m := range b.LegalMoves() {
+		b.MakeMove(m)
+		var dtz int
+		var wdl WDL
+		switch {
+		case b.HalfMoveClock == 0:
+			wdl, _ = p.search(false)
+			wdl = -wdl
+			dtz = dtzBeforeZeroing(wdl)
+		case b.IsFiftyMoveDraw() || b.IsThreefoldRepetition():
+			wdl = Draw
+		default:
+			dtz = -p.probeDTZ()
+			dtz += sign(dtz)
+			wdl = wdlFromDTZ(dtz, cnt50)
+		}
+		if dtz == 2 && b.IsCheckmate() {
+			dtz = 1
+		}
+		b.UnmakeMove()
+		if p.err != nil {
+			return nil
+		}
+
+		// Better moves rank higher, wins rank equally as long as they can be converted
+		// before the fifty-move rule, losses rank equally unless a draw is in sight
+		rank := 0
+		switch {
+		case dtz > 0 && dtz+cnt50 <= 99 && !rep:
+			rank = maxDTZ - dtz
+		case dtz > 0:
+			rank = maxDTZ/2 - (dtz + cnt50)
+		case dtz < 0 && -dtz*2+cnt50 < 100:
+			rank = -maxDTZ - dtz
+		case dtz < 0:
+			rank = -maxDTZ/2 + (-dtz + cnt50)
+		}
+		moves = append(moves, RootMove{m, wdl, DTZ(dtz), rank})
+	}
+	return moves
+}
+
+// rootProbeWDL ranks the root moves using only the WDL tables
+func (p *prober) rootProbeWDL() []RootMove {
+	b := p.board
+	wdlRank := map[WDL]int{Loss: -maxDTZ, BlessedLoss: -maxDTZ + 101, Draw: 0, CursedWin: maxDTZ - 101, Win: maxDTZ}
+
+	var moves []RootMove
+	for _, m := range b.LegalMoves() {
+		b.MakeMove(m)
+		wdl := Draw
+		if !b.IsFiftyMoveDraw() && !b.IsThreefoldRepetition() {
+			wdl, _ = p.search(false)
+			wdl = -wdl
+		}
+		b.UnmakeMove()
+		if p.err != nil {
+			return nil
+		}
+		moves = append(moves, RootMove{m, wdl, 0, wdlRank[wdl]})
+	}
+	return moves
+}
+
+// wdlFromDTZ converts a DTZ to a WDL value given the current fifty-move counter
+func wdlFromDTZ(dtz, cnt50 int) WDL {
+	switch {
+	case dtz > 0 && dtz+cnt50 <= 100:
+		return Win
+	case dtz > 0:
+		return CursedWin
+	case dtz < 0 && -dtz+cnt50 <= 100:
+		return Loss
+	case dtz < 0:
+		return BlessedLoss
+	}
+	return Draw
+}
+
+// hasRepeated checks whether any position since the last zeroing move has occurred before
+func hasRepeated(board *chess.Board) bool {
+	b := board.Copy()
+	for {
+		if b.Repetitions() > 1 {
+			return true
+		}
+		if b.HalfMoveClock == 0 {
+			return false
+		}
+		if _, err := b.UnmakeMove(); err != nil {
+			return false
+		}
+	}
+}
+
+// probeTable looks up the position in the WDL or DTZ table for its material
+func (p *prober) probeTable(typ tableType, wdl WDL) (int, probeState) {
+	b := p.board
+	if b.Occupied.Population() == 2 {
+		return int(Draw), probeOK // KvK
+	}
+
+	key := materialKey(b)
+	tables := p.tb.wdl
+	if typ == dtzTable {
+		tables = p.tb.dtz
+	}
+	t, ok := tables[key]
+	if !ok {
+		p.err = fmt.Errorf("missing %s table for %s", [...]string{"WDL", "DTZ"}[typ], key)
+		return 0, probeOK
+	}
+	if err := t.load(); err != nil {
+		p.err = err
+		return 0, probeOK
+	}
+	return t.probe(b, key, wdl)
+}
+
+// syzygyPiece converts a piece to the code used in the table files
+func syzygyPiece(p chess.Piece) int {
+	code := strings.IndexRune("PNBRQK", rune(p.Symbol)) + 1
+	if p.Symbol == chess.PAWN {
+		code = 1
+	}
+	if p.Color == chess.BLACK {
+		code += 8
+	}
+	return code
+}
+
+// materialKey names the material on the board the way table files are named
+func materialKey(b *chess.Board) string {
+	var sides [2]string
+	for c, color := range []chess.Color{chess.WHITE, chess.BLACK} {
+		for _, r := range pieceOrder {
+			s := chess.Symbol(r)
+			if r == 'P' {
+				s = chess.PAWN
+			}
+			if i := chess.PieceIndex(color, s); i != chess.NoPiece {
+				sides[c] += strings.Repeat(string(r), b.Positions[i].Population())
+			}
+		}
+	}
+	return materialName(sides[0], sides[1])
+}
@@ -0,0 +1,307 @@
+package syzygy
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+	"github.com/aaronireland/go-chess/pkg/tablebase"
+)
+
+// openKQvK writes single value KQvK tables: white to move always wins converting in
+// 5 moves and black to move always loses, unless a capture says otherwise
+func openKQvK(t *testing.T, withDTZ bool) *Tablebase {
+	dir := t.TempDir()
+	pieces := []int{6, 5, 14}
+	writeTable(t, dir, "KQvK.rtbw", buildTable(wdlTable, false, true, [][]testSide{{
+		{pieces: pieces, value: int(Win) + 2},
+		{pieces: pieces, value: int(Loss) + 2},
+	}}))
+	if withDTZ {
+		writeTable(t, dir, "KQvK.rtbz", buildTable(dtzTable, false, true, [][]testSide{{
+			{pieces: pieces, value: 5},
+		}}))
+	}
+	writeTable(t, dir, "README", []byte("not a table"))
+
+	tb, err := Open(dir)
+	if err != nil {
+		t.Fatalf("unexpected error opening tablebase: %s", err)
+	}
+	return tb
+}
+
+func parseFEN(t *testing.T, fen string) *chess.Board {
+	b, err := chess.ParseFEN(fen)
+	if err != nil {
+		t.Fatalf("unexpected error parsing %s: %s", fen, err)
+	}
+	return b
+}
+
+func TestOpen(t *testing.T) {
+	tb := openKQvK(t, true)
+	if tb.MaxPieces() != 3 {
+		t.Errorf("expected 3 pieces, actual: %d", tb.MaxPieces())
+	}
+	if tb.wdl["KvKQ"] != tb.wdl["KQvK"] || tb.dtz["KQvK"] == nil {
+		t.Errorf("tables should be registered for both colors")
+	}
+
+	if _, err := Open(t.TempDir()); err == nil {
+		t.Errorf("opening a directory without tables should fail")
+	}
+	if _, err := Open("testdata/missing"); err == nil {
+		t.Errorf("opening a missing directory should fail")
+	}
+}
+
+func TestProbe(t *testing.T) {
+	tb := openKQvK(t, true)
+	for _, test := range []struct {
+		fen string
+		wdl WDL
+		dtz DTZ
+	}{
+		{"4k3/8/8/8/8/8/8/4K2Q w - - 0 1", Win, 11},
+		{"4k3/8/8/8/8/8/8/4K2Q b - - 0 1", Loss, -12},
+		{"4k2q/8/8/8/8/8/8/4K3 b - - 0 1", Win, 11},  // colors swapped
+		{"8/8/8/8/8/8/6kQ/4K3 b - - 0 1", Draw, 0},   // black captures the queen
+		{"8/8/8/8/8/8/3q4/4K2k w - - 0 1", Draw, 0},  // colors swapped
+		{"8/8/8/8/8/8/6kq/4K3 w - - 0 1", Loss, -12}, // the queen is defended
+		{"8/8/8/8/8/8/8/K6k w - - 0 1", Draw, 0},     // bare kings
+	} {
+		b := parseFEN(t, test.fen)
+		wdl, dtz, err := tb.Probe(b)
+		if err != nil {
+			t.Errorf("unexpected error probing %s: %s", test.fen, err)
+		} else if wdl != test.wdl || dtz != test.dtz {
+			t.Errorf("expected %s %d for %s, actual: %s %d", test.wdl, test.dtz, test.fen, wdl, dtz)
+		}
+		if b.FEN() != test.fen {
+			t.Errorf("probing should not change the board, actual: %s", b.FEN())
+		}
+	}
+}
+
+func TestProbeErrors(t *testing.T) {
+	tb := openKQvK(t, false)
+	for _, fen := range []string{
+		chess.StartFEN,                   // too many pieces
+		"4k2r/8/8/8/8/8/8/4K3 w k - 0 1", // castling rights
+		"4k3/8/8/8/8/8/8/4K2R w - - 0 1", // no KRvK table
+	} {
+		if _, err := tb.ProbeWDL(parseFEN(t, fen)); err == nil {
+			t.Errorf("probing %s should fail", fen)
+		}
+	}
+	if _, err := tb.ProbeDTZ(parseFEN(t, "4k3/8/8/8/8/8/8/4K2Q w - - 0 1")); err == nil {
+		t.Errorf("probing DTZ without DTZ tables should fail")
+	}
+}
+
+func TestRootMoves(t *testing.T) {
+	// Qe7+ and Qd8+ give the queen away, every other move wins
+	fen := "4k3/8/8/8/7Q/8/8/4K3 w - - 0 1"
+	hanging := map[string]bool{"h4e7": true, "h4d8": true}
+
+	for _, withDTZ := range []bool{true, false} {
+		tb := openKQvK(t, withDTZ)
+		b := parseFEN(t, fen)
+		ranked, err := tb.RootMoves(b)
+		if err != nil {
+			t.Fatalf("unexpected error ranking root moves: %s", err)
+		}
+		if len(ranked) != len(b.LegalMoves()) {
+			t.Errorf("expected all %d legal moves to be ranked, actual: %d", len(b.LegalMoves()), len(ranked))
+		}
+		for _, m := range ranked {
+			expected := Win
+			if hanging[m.Move.UCI()] {
+				expected = Draw
+			}
+			if m.WDL != expected {
+				t.Errorf("expected %s to %s, actual: %s", m.Move, expected, m.WDL)
+			}
+			if withDTZ && m.WDL == Win && m.DTZ != 13 {
+				t.Errorf("expected %s to zero in 13 plies, actual: %d", m.Move, m.DTZ)
+			}
+		}
+
+		moves, err := tb.FilterRootMoves(b)
+		if err != nil {
+			t.Fatalf("unexpected error filtering root moves: %s", err)
+		}
+		if len(moves) != len(ranked)-len(hanging) {
+			t.Errorf("expected %d winning moves, actual: %v", len(ranked)-len(hanging), moves)
+		}
+		for _, m := range moves {
+			if hanging[m.UCI()] {
+				t.Errorf("%s should have been filtered", m)
+			}
+		}
+	}
+
+	// A win that can't be converted before the fifty-move rule ranks below one that can
+	tb := openKQvK(t, true)
+	late, _ := tb.RootMoves(parseFEN(t, "4k3/8/8/8/7Q/8/8/4K3 w - - 90 80"))
+	early, _ := tb.RootMoves(parseFEN(t, fen))
+	if late[0].Rank >= early[0].Rank || late[0].WDL != CursedWin {
+		t.Errorf("expected a cursed win ranked lower, actual: %+v vs %+v", late[0], early[0])
+	}
+}
+
+// TestRealTables probes the real KQvK, KRvK and KQvKR tables in testdata, see
+// testdata/README
+func TestRealTables(t *testing.T) {
+	for _, name := range []string{"KQvK", "KRvK", "KQvKR"} {
+		for _, ext := range []string{".rtbw", ".rtbz"} {
+			if _, err := os.Stat("testdata/" + name + ext); err != nil {
+				t.Fatalf("testdata/%s%s is missing, see testdata/README", name, ext)
+			}
+		}
+	}
+	tb, err := Open("testdata")
+	if err != nil {
+		t.Fatalf("unexpected error opening tablebase: %s", err)
+	}
+
+	for _, test := range []struct {
+		fen string
+		wdl WDL
+		dtz DTZ
+	}{
+		{"k7/8/1K6/8/8/8/8/7R w - - 0 1", Win, 1},    // Rh8#
+		{"R5k1/8/6K1/8/8/8/8/8 b - - 0 1", Loss, -1}, // checkmated
+		{"k7/2Q5/1K6/8/8/8/8/8 b - - 0 1", Draw, 0},  // stalemate
+		{"8/8/8/8/8/8/6kQ/4K3 b - - 0 1", Draw, 0},   // black captures the queen
+		{"r3k3/8/8/8/8/8/7K/Q7 w - - 0 1", Win, 1},   // Qxa8+ zeroes
+		{"Q3k3/8/8/8/8/8/7K/r7 b - - 0 1", Win, 1},   // Rxa8 leaves black the rook
+	} {
+		wdl, dtz, err := tb.Probe(parseFEN(t, test.fen))
+		if err != nil {
+			t.Errorf("unexpected error probing %s: %s", test.fen, err)
+		} else if wdl != test.wdl || dtz != test.dtz {
+			t.Errorf("expected %s %d for %s, actual: %s %d", test.wdl, test.dtz, test.fen, wdl, dtz)
+		}
+	}
+
+	// The stronger side can't capture or push a pawn before mating, so the DTZ of a win
+	// is the distance to mate, which the tablebase package generates independently
+	generated := tablebase.New()
+	for _, material := range []string{"KQK", "KRK"} {
+		checkGenerated(t, tb, generated, material, 37, func(b *chess.Board, result tablebase.Result, wdl WDL, dtz DTZ) {
+			expected, expectedDTZ := WDL(0), DTZ(0)
+			switch {
+			case result.Outcome == tablebase.Win:
+				expected, expectedDTZ = Win, DTZ(result.Plies)
+			case result.Outcome == tablebase.Loss && result.Plies == 0:
+				expected, expectedDTZ = Loss, -1
+			case result.Outcome == tablebase.Loss:
+				expected, expectedDTZ = Loss, DTZ(-result.Plies)
+			}
+			if wdl != expected || dtz != expectedDTZ {
+				t.Errorf("expected %s %d for %s, actual: %s %d", expected, expectedDTZ, b.FEN(), wdl, dtz)
+			}
+		})
+	}
+	if testing.Short() {
+		return
+	}
+
+	// Capturing the rook zeroes before mate, so a win only bounds its DTZ by the distance
+	// to mate. No KQvKR win takes long enough for the fifty-move rule to matter.
+	checkGenerated(t, tb, generated, "KQKR", 10007, func(b *chess.Board, result tablebase.Result, wdl WDL, dtz DTZ) {
+		ok := false
+		switch result.Outcome {
+		case tablebase.Win:
+			ok = wdl == Win && dtz > 0 && int(dtz) <= result.Plies
+		case tablebase.Loss:
+			ok = wdl == Loss && dtz < 0 && (int(-dtz) <= result.Plies || dtz == -1)
+		case tablebase.Draw:
+			ok = wdl == Draw && dtz == 0
+		}
+		if !ok {
+			t.Errorf("expected %s for %s, actual: %s %d", result, b.FEN(), wdl, dtz)
+		}
+	})
+}
+
+// checkGenerated probes a sample of the legal positions of a material balance, every
+// stride-th placement of its pieces, in both the Syzygy tables and a generated table
+func checkGenerated(t *testing.T, tb *Tablebase, generated *tablebase.Tablebase, material string, stride int, check func(*chess.Board, tablebase.Result, WDL, DTZ)) {
+	if _, err := generated.Generate(material); err != nil {
+		t.Fatalf("unexpected error generating %s: %s", material, err)
+	}
+	// The white pieces come first, up to the second king
+	black := strings.LastIndexByte(material, 'K')
+	placements := 2
+	for range material {
+		placements *= 64
+	}
+
+	checked := 0
+	for i := 0; i < placements; i += stride {
+		turn := "w"
+		if i%2 == 1 {
+			turn = "b"
+		}
+		pieces := map[int]byte{}
+		for j, sq := 0, i/2; j < len(material); j, sq = j+1, sq/64 {
+			p := material[j]
+			if j >= black {
+				p += 'a' - 'A'
+			}
+			pieces[sq%64] = p
+		}
+		if len(pieces) < len(material) {
+			continue
+		}
+		b, err := chess.ParseFEN(pieceFEN(pieces, turn))
+		if err != nil || b.Validate() != nil {
+			continue
+		}
+		result, err := generated.Probe(b)
+		if err != nil {
+			t.Fatalf("unexpected error probing the generated %s: %s", b.FEN(), err)
+		}
+		wdl, dtz, err := tb.Probe(b)
+		if err != nil {
+			t.Fatalf("unexpected error probing %s: %s", b.FEN(), err)
+		}
+		check(b, result, wdl, dtz)
+		checked++
+	}
+	if checked < 1000 {
+		t.Errorf("expected to check at least 1000 %s positions, actual: %d", material, checked)
+	}
+}
+
+// pieceFEN returns the FEN of a position with the pieces on the given squares, a1 being
+// 0 and h8 63
+func pieceFEN(pieces map[int]byte, turn string) string {
+	var fen strings.Builder
+	for rank := 7; rank >= 0; rank-- {
+		empty := 0
+		for file := 0; file < 8; file++ {
+			p, ok := pieces[rank*8+file]
+			if !ok {
+				empty++
+				continue
+			}
+			if empty > 0 {
+				fen.WriteByte(byte('0' + empty))
+				empty = 0
+			}
+			fen.WriteByte(p)
+		}
+		if empty > 0 {
+			fen.WriteByte(byte('0' + empty))
+		}
+		if rank > 0 {
+			fen.WriteByte('/')
+		}
+	}
+	return fen.String() + " " + turn + " - - 0 1"
+}
@@ -0,0 +1,722 @@
+package syzygy
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"math/bits"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+//-----------------------------------------------------------------------------
+// Table files
+//-----------------------------------------------------------------------------
+
+type tableType int
+
+const (
+	wdlTable tableType = iota
+	dtzTable
+)
+
+var magics = map[tableType][]byte{
+	wdlTable: {0x71, 0xE8, 0x23, 0x5D},
+	dtzTable: {0xD7, 0x66, 0x0C, 0xA5},
+}
+
+// Flags of the pairs data of each table
+const (
+	flagSTM         = 1
+	flagMapped      = 2
+	flagWinPlies    = 4
+	flagLossPlies   = 8
+	flagWide        = 16
+	flagSingleValue = 128
+)
+
+// pairsData holds the indexing and decompression information for one side to move
+// (and one leading pawn file for tables with pawns) of a table. Offsets are into the
+// table's file data.
+type pairsData struct {
+	flags           byte
+	maxSymLen       int
+	minSymLen       int
+	numBlocks       int
+	blockSize       int
+	span            int
+	lowestSym       int
+	btree           int
+	blockLength     int
+	blockLengthSize int
+	sparseIndex     int
+	sparseIndexSize int
+	data            int
+	base64          []uint64
+	symlen          []int
+	pieces          [maxPieces]int
+	groupIdx        [maxPieces + 1]uint64
+	groupLen        [maxPieces + 1]int
+	mapIdx          [4]int
+}
+
+// table is a single .rtbw or .rtbz file. The file is only read the first time it is
+// probed.
+type table struct {
+	path            string
+	typ             tableType
+	key             string
+	key2            string
+	pieceCount      int
+	hasPawns        bool
+	hasUniquePieces bool
+	pawnCount       [2]int // [lead color, other color]
+
+	once   sync.Once
+	err    error
+	data   []byte
+	dtzMap int
+	items  [2][4]pairsData // [side to move][leading pawn file]
+}
+
+// newTable sets up a table from the material in its name, e.g. KRPvKR
+func newTable(path string, typ tableType, name string) (*table, error) {
+	white, black, err := parseMaterial(name)
+	if err != nil {
+		return nil, err
+	}
+	t := &table{path: path, typ: typ, key: name, key2: materialName(black, white)}
+	t.pieceCount = len(white) + len(black)
+	pawns := [2]int{countOf(white, 'P'), countOf(black, 'P')}
+	t.hasPawns = pawns[0]+pawns[1] > 0
+	for _, side := range []string{white, black} {
+		for _, piece := range "QRBNP" {
+			if countOf(side, piece) == 1 {
+				t.hasUniquePieces = true
+			}
+		}
+	}
+
+	// The leading color is the side with fewer pawns (but at least one) since this
+	// compresses better
+	if pawns[1] == 0 || (pawns[0] > 0 && pawns[1] >= pawns[0]) {
+		t.pawnCount = pawns
+	} else {
+		t.pawnCount = [2]int{pawns[1], pawns[0]}
+	}
+	return t, nil
+}
+
+func (t *table) sides() int {
+	if t.typ == wdlTable && t.key != t.key2 {
+		return 2
+	}
+	return 1
+}
+
+func (t *table) get(stm, file int) *pairsData {
+	if !t.hasPawns {
+		file = 0
+	}
+	return &t.items[stm%t.sides()][file]
+}
+
+// load reads and parses the table file the first time it's needed
+func (t *table) load() error {
+	t.once.Do(func() {
+		data, err := ioutil.ReadFile(t.path)
+		if err != nil {
+			t.err = err
+			return
+		}
+		if len(data) < 5 || string(data[:4]) != string(magics[t.typ]) {
+			t.err = fmt.Errorf("%s is not a syzygy table: bad magic", t.path)
+			return
+		}
+		t.data = data
+		defer func() {
+			// A truncated or corrupt file shows up as an out of range read
+			if r := recover(); r != nil {
+				t.data = nil
+				t.err = fmt.Errorf("%s is corrupt: %v", t.path, r)
+			}
+		}()
+		t.err = t.parse(4)
+	})
+	return t.err
+}
+
+func (t *table) u16(offset int) int {
+	return int(binary.LittleEndian.Uint16(t.data[offset:]))
+}
+
+func (t *table) u32(offset int) int {
+	return int(binary.LittleEndian.Uint32(t.data[offset:]))
+}
+
+// parse populates the pairs data of the table from the file header
+func (t *table) parse(offset int) error {
+	const (
+		split    = 1
+		hasPawns = 2
+	)
+	flags := t.data[offset]
+	if (flags&hasPawns != 0) != t.hasPawns || (t.typ == wdlTable && (flags&split != 0) != (t.key != t.key2)) {
+		return fmt.Errorf("%s does not match the material in its name", t.path)
+	}
+	offset++
+
+	sides, maxFile := t.sides(), 0
+	if t.hasPawns {
+		maxFile = 3
+	}
+	pp := t.hasPawns && t.pawnCount[1] > 0 // pawns on both sides
+
+	for f := 0; f <= maxFile; f++ {
+		order := [2][2]int{{int(t.data[offset] & 0xF), 0xF}, {int(t.data[offset] >> 4), 0xF}}
+		if pp {
+			order[0][1], order[1][1] = int(t.data[offset+1]&0xF), int(t.data[offset+1]>>4)
+			offset++
+		}
+		offset++
+
+		for k := 0; k < t.pieceCount; k, offset = k+1, offset+1 {
+			for i := 0; i < sides; i++ {
+				piece := int(t.data[offset] & 0xF)
+				if i > 0 {
+					piece = int(t.data[offset] >> 4)
+				}
+				t.get(i, f).pieces[k] = piece
+			}
+		}
+		for i := 0; i < sides; i++ {
+			t.setGroups(t.get(i, f), order[i], f)
+		}
+	}
+	offset += offset & 1
+
+	for f := 0; f <= maxFile; f++ {
+		for i := 0; i < sides; i++ {
+			offset = t.setSizes(t.get(i, f), offset)
+		}
+	}
+
+	if t.typ == dtzTable {
+		offset = t.setDTZMap(offset, maxFile)
+	}
+
+	for f := 0; f <= maxFile; f++ {
+		for i := 0; i < sides; i++ {
+			d := t.get(i, f)
+			d.sparseIndex = offset
+			offset += d.sparseIndexSize * 6
+		}
+	}
+	for f := 0; f <= maxFile; f++ {
+		for i := 0; i < sides; i++ {
+			d := t.get(i, f)
+			d.blockLength = offset
+			offset += d.blockLengthSize * 2
+		}
+	}
+	for f := 0; f <= maxFile; f++ {
+		for i := 0; i < sides; i++ {
+			d := t.get(i, f)
+			offset = (offset + 0x3F) &^ 0x3F // 64 byte alignment
+			d.data = offset
+			offset += d.numBlocks * d.blockSize
+		}
+	}
+	if offset > len(t.data) {
+		return fmt.Errorf("%s is truncated", t.path)
+	}
+	return nil
+}
+
+// setGroups groups together the pieces that are encoded together: pieces of the same
+// type and color, except for the leading group which (for tables without pawns) is
+// either 3 unique pieces or the two kings. Then the multiplier of each group in the
+// index is calculated using the encoding order stored in the file.
+func (t *table) setGroups(d *pairsData, order [2]int, file int) {
+	n, firstLen := 0, 2
+	if t.hasPawns {
+		firstLen = 0
+	} else if t.hasUniquePieces {
+		firstLen = 3
+	}
+	d.groupLen[n] = 1
+	for i := 1; i < t.pieceCount; i++ {
+		firstLen--
+		if firstLen > 0 || d.pieces[i] == d.pieces[i-1] {
+			d.groupLen[n]++
+		} else {
+			n++
+			d.groupLen[n] = 1
+		}
+	}
+	n++
+	d.groupLen[n] = 0
+
+	pp := t.hasPawns && t.pawnCount[1] > 0
+	next, freeSquares := 1, 64-d.groupLen[0]
+	if pp {
+		next = 2
+		freeSquares -= d.groupLen[1]
+	}
+
+	idx := uint64(1)
+	for k := 0; next < n || k == order[0] || k == order[1]; k++ {
+		switch {
+		case k == order[0]: // leading pawns or pieces
+			d.groupIdx[0] = idx
+			switch {
+			case t.hasPawns:
+				idx *= leadPawnsSize[d.groupLen[0]][file]
+			case t.hasUniquePieces:
+				idx *= 31332
+			default:
+				idx *= 462
+			}
+		case k == order[1]: // remaining pawns
+			d.groupIdx[1] = idx
+			idx *= binomial[d.groupLen[1]][48-d.groupLen[0]]
+		default: // remaining pieces
+			d.groupIdx[next] = idx
+			idx *= binomial[d.groupLen[next]][freeSquares]
+			freeSquares -= d.groupLen[next]
+			next++
+		}
+	}
+	d.groupIdx[n] = idx
+}
+
+// setSizes reads the block and Huffman code information for the pairs data
+func (t *table) setSizes(d *pairsData, offset int) int {
+	d.flags = t.data[offset]
+	offset++
+	if d.flags&flagSingleValue != 0 {
+		d.minSymLen = int(t.data[offset]) // the single value
+		return offset + 1
+	}
+
+	n := 0
+	for d.groupLen[n] != 0 {
+		n++
+	}
+	tbSize := d.groupIdx[n]
+
+	d.blockSize = 1 << t.data[offset]
+	d.span = 1 << t.data[offset+1]
+	d.sparseIndexSize = int((tbSize + uint64(d.span) - 1) / uint64(d.span))
+	padding := int(t.data[offset+2])
+	d.numBlocks = t.u32(offset + 3)
+	d.blockLengthSize = d.numBlocks + padding
+	d.maxSymLen = int(t.data[offset+7])
+	d.minSymLen = int(t.data[offset+8])
+	offset += 9
+	d.lowestSym = offset
+
+	// The canonical Huffman code gives longer symbols lower values, so base64[l] is
+	// the lowest symbol of length minSymLen + l right-padded to 64 bits
+	d.base64 = make([]uint64, d.maxSymLen-d.minSymLen+1)
+	for i := len(d.base64) - 2; i >= 0; i-- {
+		d.base64[i] = (d.base64[i+1] + uint64(t.u16(d.lowestSym+2*i)) - uint64(t.u16(d.lowestSym+2*(i+1)))) / 2
+	}
+	for i := range d.base64 {
+		d.base64[i] <<= uint(64 - i - d.minSymLen)
+	}
+	offset += len(d.base64) * 2
+
+	// Each symbol is either a value or a pair of symbols (Recursive Pairing)
+	d.symlen = make([]int, t.u16(offset))
+	offset += 2
+	d.btree = offset
+	visited := make([]bool, len(d.symlen))
+	for sym := range d.symlen {
+		if !visited[sym] {
+			d.symlen[sym] = t.setSymlen(d, sym, visited)
+		}
+	}
+	return offset + len(d.symlen)*3 + len(d.symlen)&1
+}
+
+// left and right read the child symbols of a symbol in the pairing tree
+func (t *table) left(d *pairsData, sym int) int {
+	o := d.btree + 3*sym
+	return int(t.data[o+1]&0xF)<<8 | int(t.data[o])
+}
+
+func (t *table) right(d *pairsData, sym int) int {
+	o := d.btree + 3*sym
+	return int(t.data[o+2])<<4 | int(t.data[o+1]>>4)
+}
+
+func (t *table) setSymlen(d *pairsData, sym int, visited []bool) int {
+	visited[sym] = true
+	right := t.right(d, sym)
+	if right == 0xFFF {
+		return 0
+	}
+	left := t.left(d, sym)
+	if !visited[left] {
+		d.symlen[left] = t.setSymlen(d, left, visited)
+	}
+	if !visited[right] {
+		d.symlen[right] = t.setSymlen(d, right, visited)
+	}
+	return d.symlen[left] + d.symlen[right] + 1
+}
+
+// setDTZMap reads the tables mapping stored DTZ values back to distances
+func (t *table) setDTZMap(offset, maxFile int) int {
+	t.dtzMap = offset
+	for f := 0; f <= maxFile; f++ {
+		d := t.get(0, f)
+		if d.flags&flagMapped == 0 {
+			continue
+		}
+		if d.flags&flagWide != 0 {
+			offset += offset & 1
+			for i := 0; i < 4; i++ {
+				d.mapIdx[i] = (offset-t.dtzMap)/2 + 1
+				offset += 2*t.u16(offset) + 2
+			}
+		} else {
+			for i := 0; i < 4; i++ {
+				d.mapIdx[i] = offset - t.dtzMap + 1
+				offset += int(t.data[offset]) + 1
+			}
+		}
+	}
+	return offset + offset&1
+}
+
+// decompress returns the value stored at an index. The values are stored in blocks of
+// Huffman coded symbols, each of which expands to one or more values. A sparse index
+// points at every span-th value so only a few blocks need to be skipped.
+func (t *table) decompress(d *pairsData, idx uint64) int {
+	if d.flags&flagSingleValue != 0 {
+		return d.minSymLen
+	}
+
+	k := int(idx / uint64(d.span))
+	block := t.u32(d.sparseIndex + 6*k)
+	offset := t.u16(d.sparseIndex + 6*k + 4)
+	offset += int(idx%uint64(d.span)) - d.span/2
+
+	blockLength := func(b int) int { return t.u16(d.blockLength + 2*b) }
+	for offset < 0 {
+		block--
+		offset += blockLength(block) + 1
+	}
+	for offset > blockLength(block) {
+		offset -= blockLength(block) + 1
+		block++
+	}
+
+	ptr := d.data + block*d.blockSize
+	buf64 := binary.BigEndian.Uint64(t.data[ptr:])
+	ptr += 8
+	buf64Size := 64
+
+	var sym int
+	for {
+		l := 0
+		for buf64 < d.base64[l] {
+			l++
+		}
+		sym = int((buf64 - d.base64[l]) >> uint(64-l-d.minSymLen))
+		sym += t.u16(d.lowestSym + 2*l)
+		if offset < d.symlen[sym]+1 {
+			break
+		}
+		offset -= d.symlen[sym] + 1
+		l += d.minSymLen
+		buf64 <<= uint(l)
+		buf64Size -= l
+		if buf64Size <= 32 {
+			buf64Size += 32
+			buf64 |= uint64(binary.BigEndian.Uint32(t.data[ptr:])) << uint(64-buf64Size)
+			ptr += 4
+		}
+	}
+
+	// Expand the symbol until reaching the leaf holding our value
+	for d.symlen[sym] != 0 {
+		left := t.left(d, sym)
+		if offset < d.symlen[left]+1 {
+			sym = left
+		} else {
+			offset -= d.symlen[left] + 1
+			sym = t.right(d, sym)
+		}
+	}
+	return t.left(d, sym)
+}
+
+// mapScore converts a decompressed value to a WDL score or a DTZ in plies
+func (t *table) mapScore(file, value int, wdl WDL) int {
+	if t.typ == wdlTable {
+		return value - 2
+	}
+
+	wdlMap := [5]int{1, 3, 0, 2, 0}
+	d := t.get(0, file)
+	if d.flags&flagMapped != 0 {
+		i := d.mapIdx[wdlMap[wdl+2]] + value
+		if d.flags&flagWide != 0 {
+			value = t.u16(t.dtzMap + 2*i)
+		} else {
+			value = int(t.data[t.dtzMap+i])
+		}
+	}
+
+	// DTZ is stored in moves or plies, convert it to plies
+	if (wdl == Win && d.flags&flagWinPlies == 0) || (wdl == Loss && d.flags&flagLossPlies == 0) ||
+		wdl == CursedWin || wdl == BlessedLoss {
+		value *= 2
+	}
+	return value + 1
+}
+
+// checkDTZSTM checks if a DTZ table stores the side to move, since DTZ tables only
+// store one side
+func (t *table) checkDTZSTM(stm, file int) bool {
+	if t.typ == wdlTable {
+		return true
+	}
+	return int(t.get(stm, file).flags&flagSTM) == stm || (t.key == t.key2 && !t.hasPawns)
+}
+
+// pawnLess orders pawns so the leading pawn (nearest the edge, lowest rank) is last
+func pawnLess(a, b int) bool {
+	return mapPawns[a] < mapPawns[b]
+}
+
+// probe looks up the position given the material key of the board
+func (t *table) probe(b *chess.Board, key string, wdl WDL) (int, probeState) {
+	d, file, idx, ok := t.index(b, key)
+	if !ok {
+		return 0, probeChangeSTM
+	}
+	return t.mapScore(file, t.decompress(d, idx), wdl), probeOK
+}
+
+// index returns the pairs data and index of the position in the table, ok is false
+// if the position's side to move isn't stored in a DTZ table
+func (t *table) index(b *chess.Board, key string) (d *pairsData, file int, idx uint64, ok bool) {
+	var squares, pieces [maxPieces]int
+	size, leadPawnsCount := 0, 0
+	leadIndex := chess.NoPiece
+
+	// Tables are stored with the stronger side as white, if the position has black as
+	// the stronger side (or the material is symmetric and black is to move) the colors
+	// are swapped and the board flipped
+	flip := (b.Turn == chess.BLACK && t.key == t.key2) || key != t.key
+	flipColor, flipSquares, stm := 0, 0, int(b.Turn)
+	if flip {
+		flipColor, flipSquares, stm = 8, 56, stm^1
+	}
+
+	// Tables with pawns are split by the file of the leading pawn
+	if t.hasPawns {
+		pc := t.get(0, 0).pieces[0] ^ flipColor
+		color := chess.WHITE
+		if pc&8 != 0 {
+			color = chess.BLACK
+		}
+		leadIndex = chess.PieceIndex(color, chess.PAWN)
+		for bb := b.Positions[leadIndex]; bb != 0; bb &= bb - 1 {
+			squares[size] = bits.TrailingZeros64(uint64(bb)) ^ flipSquares
+			size++
+		}
+		leadPawnsCount = size
+		lead := 0
+		for i := 1; i < size; i++ {
+			if pawnLess(squares[lead], squares[i]) {
+				lead = i
+			}
+		}
+		squares[0], squares[lead] = squares[lead], squares[0]
+		file = edgeDistance(fileOf(squares[0]))
+	}
+
+	if !t.checkDTZSTM(stm, file) {
+		return nil, file, 0, false
+	}
+
+	for i, bb := range b.Positions {
+		if i == leadIndex {
+			continue
+		}
+		for ; bb != 0; bb &= bb - 1 {
+			squares[size] = bits.TrailingZeros64(uint64(bb)) ^ flipSquares
+			pieces[size] = syzygyPiece(b.Pieces[i]) ^ flipColor
+			size++
+		}
+	}
+	d = t.get(stm, file)
+
+	// Reorder the pieces to the sequence stored in the table
+	for i := leadPawnsCount; i < size-1; i++ {
+		for j := i + 1; j < size; j++ {
+			if d.pieces[i] == pieces[j] {
+				pieces[i], pieces[j] = pieces[j], pieces[i]
+				squares[i], squares[j] = squares[j], squares[i]
+				break
+			}
+		}
+	}
+
+	// Mirror the board so the leading piece is on the a-d files
+	if fileOf(squares[0]) > 3 {
+		for i := 0; i < size; i++ {
+			squares[i] = flipFile(squares[i])
+		}
+	}
+
+	if t.hasPawns {
+		idx = leadPawnIdx[leadPawnsCount][squares[0]]
+		others := squares[1:leadPawnsCount]
+		sort.SliceStable(others, func(i, j int) bool { return pawnLess(others[i], others[j]) })
+		for i := 1; i < leadPawnsCount; i++ {
+			idx += binomial[i][mapPawns[squares[i]]]
+		}
+	} else {
+		idx = t.leadingIndex(d, squares[:size])
+	}
+
+	// Encode the remaining groups as combinations of the squares not taken by the
+	// previous groups
+	idx *= d.groupIdx[0]
+	remainingPawns := t.hasPawns && t.pawnCount[1] > 0
+	start := d.groupLen[0]
+	for next := 1; d.groupLen[next] != 0; next++ {
+		group := squares[start : start+d.groupLen[next]]
+		sort.Ints(group)
+		var n uint64
+		for i, sq := range group {
+			adjust := 0
+			for _, s := range squares[:start] {
+				if sq > s {
+					adjust++
+				}
+			}
+			if remainingPawns {
+				adjust += 8
+			}
+			n += binomial[i+1][sq-adjust]
+		}
+		remainingPawns = false
+		idx += n * d.groupIdx[next]
+		start += d.groupLen[next]
+	}
+	return d, file, idx, true
+}
+
+// leadingIndex encodes the leading group of a table without pawns after mapping the
+// first piece into the a1-d1-d4 triangle
+func (t *table) leadingIndex(d *pairsData, squares []int) uint64 {
+	if rankOf(squares[0]) > 3 {
+		for i := range squares {
+			squares[i] = flipRank(squares[i])
+		}
+	}
+
+	// Flip along the a1-h8 diagonal so the first piece of the leading group that isn't
+	// on the diagonal is below it
+	for i := 0; i < d.groupLen[0]; i++ {
+		if offA1H8(squares[i]) == 0 {
+			continue
+		}
+		if offA1H8(squares[i]) > 0 {
+			for j := i; j < len(squares); j++ {
+				squares[j] = ((squares[j] >> 3) | (squares[j] << 3)) & 63
+			}
+		}
+		break
+	}
+
+	if !t.hasUniquePieces {
+		return uint64(mapKK[mapA1D1D4[squares[0]]][squares[1]])
+	}
+
+	// Three unique pieces are encoded together, with special cases when the leading
+	// pieces are on the diagonal
+	adjust1, adjust2 := 0, 0
+	if squares[1] > squares[0] {
+		adjust1 = 1
+	}
+	if squares[2] > squares[0] {
+		adjust2++
+	}
+	if squares[2] > squares[1] {
+		adjust2++
+	}
+	var idx int
+	switch {
+	case offA1H8(squares[0]) != 0:
+		idx = (mapA1D1D4[squares[0]]*63+(squares[1]-adjust1))*62 + squares[2] - adjust2
+	case offA1H8(squares[1]) != 0:
+		idx = (6*63+rankOf(squares[0])*28+mapB1H1H7[squares[1]])*62 + squares[2] - adjust2
+	case offA1H8(squares[2]) != 0:
+		idx = 6*63*62 + 4*28*62 + rankOf(squares[0])*7*28 + (rankOf(squares[1])-adjust1)*28 +
+			mapB1H1H7[squares[2]]
+	default:
+		idx = 6*63*62 + 4*28*62 + 4*7*28 + rankOf(squares[0])*7*6 + (rankOf(squares[1])-adjust1)*6 +
+			(rankOf(squares[2]) - adjust2)
+	}
+	return uint64(idx)
+}
+
+//-----------------------------------------------------------------------------
+// Material
+//-----------------------------------------------------------------------------
+
+// pieceOrder is the order pieces appear in table names
+const pieceOrder = "KQRBNP"
+
+func countOf(side string, piece rune) int {
+	n := 0
+	for _, r := range side {
+		if r == piece {
+			n++
+		}
+	}
+	return n
+}
+
+func materialName(white, black string) string {
+	return white + "v" + black
+}
+
+// parseMaterial splits a table name like KRPvKR into the white and black pieces
+func parseMaterial(name string) (string, string, error) {
+	var sides [2]string
+	n, count := 0, 0
+	for i, r := range name {
+		switch {
+		case r == 'v' && n == 0:
+			n++
+		case strings.ContainsRune(pieceOrder, r):
+			sides[n] += string(r)
+			count++
+		default:
+			return "", "", fmt.Errorf("invalid table name %q at %d", name, i)
+		}
+	}
+	if n != 1 || countOf(sides[0], 'K') != 1 || countOf(sides[1], 'K') != 1 || count > maxPieces {
+		return "", "", fmt.Errorf("invalid table name %q", name)
+	}
+	for _, side := range sides {
+		for i := 1; i < len(side); i++ {
+			if strings.IndexByte(pieceOrder, side[i-1]) > strings.IndexByte(pieceOrder, side[i]) {
+				return "", "", fmt.Errorf("invalid table name %q: pieces out of order", name)
+			}
+		}
+	}
+	return sides[0], sides[1], nil
+}
@@ -0,0 +1,225 @@
+package syzygy
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+// testSide describes the pairs data of one side to move in a synthetic table. Tables
+// with a single value are used to test probing and tables with values are Huffman
+// coded with a fixed symbol length to test decompression.
+type testSide struct {
+	pieces []int // piece codes in encoding order
+	value  int   // the value of every position when values is nil
+	values []int
+	flags  byte
+}
+
+const (
+	testSymLen    = 3
+	testBlockSize = 16
+	testSpan      = 32
+)
+
+// buildTable writes a table file for tables with at most one side having pawns. Files
+// holds one entry per leading pawn file (just one for tables without pawns) with the
+// sides to move stored in it.
+func buildTable(typ tableType, hasPawns, split bool, files [][]testSide) []byte {
+	data := append([]byte{}, magics[typ]...)
+	var flags byte
+	if split {
+		flags |= 1
+	}
+	if hasPawns {
+		flags |= 2
+	}
+	data = append(data, flags)
+
+	for _, sides := range files {
+		data = append(data, 0) // the leading group is encoded first
+		for k := range sides[0].pieces {
+			b := byte(sides[0].pieces[k])
+			if len(sides) > 1 {
+				b |= byte(sides[1].pieces[k]) << 4
+			}
+			data = append(data, b)
+		}
+	}
+	if len(data)&1 != 0 {
+		data = append(data, 0)
+	}
+
+	type section struct {
+		sparse, blockLengths, blocks []byte
+	}
+	var sections []section
+	for _, sides := range files {
+		for _, s := range sides {
+			if s.values == nil {
+				data = append(data, s.flags|flagSingleValue, byte(s.value))
+				continue
+			}
+			perBlock := testBlockSize * 8 / testSymLen
+			numBlocks := (len(s.values) + perBlock - 1) / perBlock
+			numSyms := 0
+			for _, v := range s.values {
+				if v+1 > numSyms {
+					numSyms = v + 1
+				}
+			}
+			data = append(data, s.flags, 4, 5, 1, 0, 0, 0, 0, testSymLen, testSymLen, 0, 0, byte(numSyms), 0)
+			binary.LittleEndian.PutUint32(data[len(data)-10:], uint32(numBlocks))
+			for sym := 0; sym < numSyms; sym++ {
+				data = append(data, byte(sym), 0xF0|byte(sym>>8), 0xFF) // leaf, right = 0xFFF
+			}
+			if numSyms&1 != 0 {
+				data = append(data, 0)
+			}
+
+			var sec section
+			for k := 0; k*testSpan < len(s.values); k++ {
+				target := k*testSpan + testSpan/2
+				entry := make([]byte, 6)
+				binary.LittleEndian.PutUint32(entry, uint32(target/perBlock))
+				binary.LittleEndian.PutUint16(entry[4:], uint16(target%perBlock))
+				sec.sparse = append(sec.sparse, entry...)
+			}
+			for b := 0; b <= numBlocks; b++ { // one block of padding
+				sec.blockLengths = append(sec.blockLengths, byte(perBlock-1), 0)
+			}
+			sec.blocks = make([]byte, numBlocks*testBlockSize+8)
+			for i, v := range s.values {
+				bit := (i/perBlock)*testBlockSize*8 + (i%perBlock)*testSymLen
+				for j := 0; j < testSymLen; j++ {
+					if v&(1<<uint(testSymLen-1-j)) != 0 {
+						sec.blocks[(bit+j)/8] |= 0x80 >> uint((bit+j)%8)
+					}
+				}
+			}
+			sections = append(sections, sec)
+		}
+	}
+	for _, sec := range sections {
+		data = append(data, sec.sparse...)
+	}
+	for _, sec := range sections {
+		data = append(data, sec.blockLengths...)
+	}
+	for _, sec := range sections {
+		for len(data)%64 != 0 {
+			data = append(data, 0)
+		}
+		data = append(data, sec.blocks...)
+	}
+	for len(data)%64 != 0 {
+		data = append(data, 0)
+	}
+	return data
+}
+
+func writeTable(t *testing.T, dir, name string, data []byte) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		t.Fatalf("unexpected error writing %s: %s", path, err)
+	}
+	return path
+}
+
+func loadTable(t *testing.T, typ tableType, name string, data []byte) *table {
+	ext := map[tableType]string{wdlTable: ".rtbw", dtzTable: ".rtbz"}[typ]
+	path := writeTable(t, t.TempDir(), name+ext, data)
+	tbl, err := newTable(path, typ, name)
+	if err != nil {
+		t.Fatalf("unexpected error creating table %s: %s", name, err)
+	}
+	if err := tbl.load(); err != nil {
+		t.Fatalf("unexpected error loading table %s: %s", name, err)
+	}
+	return tbl
+}
+
+func TestParseMaterial(t *testing.T) {
+	for name, expected := range map[string][2]string{
+		"KQvK":   {"KQ", "K"},
+		"KRPvKR": {"KRP", "KR"},
+		"KvKBN":  {"K", "KBN"},
+	} {
+		white, black, err := parseMaterial(name)
+		if err != nil || white != expected[0] || black != expected[1] {
+			t.Errorf("expected %s to parse as %v, actual: %s %s %v", name, expected, white, black, err)
+		}
+	}
+	for _, name := range []string{"", "KQK", "QKvK", "KQvKvK", "KQvQ", "KPRvK", "KXvK", "KQQQQQQvK"} {
+		if _, _, err := parseMaterial(name); err == nil {
+			t.Errorf("parsing %q should have failed", name)
+		}
+	}
+}
+
+func TestNewTable(t *testing.T) {
+	tbl, err := newTable("KRPvKP.rtbw", wdlTable, "KRPvKP")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if tbl.key2 != "KPvKRP" || tbl.pieceCount != 5 || !tbl.hasPawns || !tbl.hasUniquePieces {
+		t.Errorf("unexpected table: %+v", tbl)
+	}
+	if tbl.pawnCount != [2]int{1, 1} || tbl.sides() != 2 {
+		t.Errorf("unexpected pawn count %v or sides %d", tbl.pawnCount, tbl.sides())
+	}
+
+	tbl, _ = newTable("KPPvKP.rtbz", dtzTable, "KPPvKP")
+	if tbl.pawnCount != [2]int{1, 2} || tbl.sides() != 1 {
+		t.Errorf("black should lead with fewer pawns, actual: %v", tbl.pawnCount)
+	}
+
+	tbl, _ = newTable("KNNvK.rtbw", wdlTable, "KNNvK")
+	if tbl.hasUniquePieces {
+		t.Errorf("KNNvK has no unique pieces")
+	}
+}
+
+func TestDecompress(t *testing.T) {
+	var values [2][]int
+	for i := 0; i < 31332; i++ {
+		values[0] = append(values[0], (i*7+i/100)%5)
+		values[1] = append(values[1], (i/3)%5)
+	}
+	data := buildTable(wdlTable, false, true, [][]testSide{{
+		{pieces: []int{6, 5, 14}, values: values[0]},
+		{pieces: []int{6, 5, 14}, values: values[1]},
+	}})
+	tbl := loadTable(t, wdlTable, "KQvK", data)
+
+	for stm := 0; stm < 2; stm++ {
+		d := tbl.get(stm, 0)
+		if d.groupIdx[1] != 31332 || d.numBlocks != (31332+41)/42 {
+			t.Fatalf("unexpected table size %d or number of blocks %d", d.groupIdx[1], d.numBlocks)
+		}
+		for idx, expected := range values[stm] {
+			if actual := tbl.decompress(d, uint64(idx)); actual != expected {
+				t.Fatalf("expected value %d at %d for side %d, actual: %d", expected, idx, stm, actual)
+			}
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	single := [][]testSide{{{pieces: []int{6, 5, 14}, value: 4}, {pieces: []int{6, 5, 14}, value: 0}}}
+	valid := buildTable(wdlTable, false, true, single)
+
+	for name, data := range map[string][]byte{
+		"bad magic":      append([]byte{1, 2, 3, 4}, valid[4:]...),
+		"truncated":      valid[:10],
+		"wrong material": buildTable(wdlTable, true, true, single),
+		"empty":          {},
+	} {
+		path := writeTable(t, t.TempDir(), "KQvK.rtbw", data)
+		tbl, _ := newTable(path, wdlTable, "KQvK")
+		if err := tbl.load(); err == nil {
+			t.Errorf("loading a table with %s should fail", name)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package tablebase
+
+import (
+	"bufio"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// Saved tables start with a short header followed by the zlib compressed results, one
+// byte per position. Most positions are draws or can't occur so they compress well.
+//
+//	magic    [4]byte "GCTB"
+//	version  uint8
+//	length   uint8 of the material name
+//	material [length]byte e.g. "KQvKR"
+//	size     uint32 number of positions, little endian
+const (
+	formatMagic   = "GCTB"
+	formatVersion = 1
+)
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// WriteTo writes the table in the compact on-disk format
+func (t *Table) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	header := append([]byte(formatMagic), formatVersion, byte(len(t.material)))
+	header = append(header, t.material...)
+	header = append(header, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(header[len(header)-4:], uint32(len(t.values)))
+	if _, err := cw.Write(header); err != nil {
+		return cw.n, err
+	}
+
+	z, err := zlib.NewWriterLevel(cw, zlib.BestCompression)
+	if err != nil {
+		return cw.n, err
+	}
+	if _, err := z.Write(t.values); err != nil {
+		return cw.n, err
+	}
+	err = z.Close()
+	return cw.n, err
+}
+
+// ReadTable reads a table written by WriteTo
+func ReadTable(r io.Reader) (*Table, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, len(formatMagic)+2)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, fmt.Errorf("unable to read table header: %s", err)
+	}
+	if string(header[:len(formatMagic)]) != formatMagic {
+		return nil, fmt.Errorf("not a tablebase file")
+	}
+	if header[4] != formatVersion {
+		return nil, fmt.Errorf("unsupported table format version %d", header[4])
+	}
+
+	material := make([]byte, int(header[5])+4)
+	if _, err := io.ReadFull(br, material); err != nil {
+		return nil, fmt.Errorf("unable to read table header: %s", err)
+	}
+	size := binary.LittleEndian.Uint32(material[len(material)-4:])
+	t, err := newTable(string(material[:len(material)-4]))
+	if err != nil {
+		return nil, err
+	}
+	if int(size) != 2*t.size {
+		return nil, fmt.Errorf("%s table should have %d positions, found %d", t.material, 2*t.size, size)
+	}
+
+	z, err := zlib.NewReader(br)
+	if err != nil {
+		return nil, err
+	}
+	defer z.Close()
+	if t.values, err = ioutil.ReadAll(io.LimitReader(z, int64(size)+1)); err != nil {
+		return nil, err
+	}
+	if len(t.values) != int(size) {
+		return nil, fmt.Errorf("%s table has %d positions, expected %d", t.material, len(t.values), size)
+	}
+	return t, nil
+}
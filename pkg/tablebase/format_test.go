@@ -0,0 +1,66 @@
+package tablebase
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteRead(t *testing.T) {
+	tbl := generate(t, "KRK")
+	var buf bytes.Buffer
+	n, err := tbl.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error writing table: %s", err)
+	}
+	if n != int64(buf.Len()) || n > int64(tbl.Len())/4 {
+		t.Errorf("expected a compact table of %d bytes, actual: %d", buf.Len(), n)
+	}
+
+	read, err := ReadTable(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("unexpected error reading table: %s", err)
+	}
+	if read.Material() != "KRvK" || !bytes.Equal(read.values, tbl.values) {
+		t.Errorf("table changed after reading it back")
+	}
+
+	data := buf.Bytes()
+	for name, corrupt := range map[string][]byte{
+		"empty":     {},
+		"bad magic": append([]byte("XXXX"), data[4:]...),
+		"version":   append(append([]byte{}, data[:4]...), append([]byte{9}, data[5:]...)...),
+		"truncated": data[:len(data)/2],
+		"material":  bytes.Replace(data, []byte("KRvK"), []byte("KQvK"), 1)[:len(data)-20],
+	} {
+		if _, err := ReadTable(bytes.NewReader(corrupt)); err == nil {
+			t.Errorf("reading a table with %s should fail", name)
+		}
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	generate(t, "KPK")
+	dir := t.TempDir()
+	if err := generated.Save(dir); err != nil {
+		t.Fatalf("unexpected error saving tables: %s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "README"), []byte("not a table"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tb, err := Load(dir)
+	if err != nil {
+		t.Fatalf("unexpected error loading tables: %s", err)
+	}
+	if len(tb.Materials()) != len(generated.Materials()) {
+		t.Errorf("expected %v, actual: %v", generated.Materials(), tb.Materials())
+	}
+	if r, err := tb.Probe(parseFEN(t, "8/8/8/8/8/8/4P3/4K2k w - - 0 1")); err != nil || r != (Result{Win, 23}) {
+		t.Errorf("expected mate in 12 from the loaded tables, actual: %s %v", r, err)
+	}
+	if _, ok := tb.Table("KvKP"); !ok {
+		t.Errorf("expected a table for KvKP")
+	}
+}
@@ -0,0 +1,213 @@
+package tablebase
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// State of a position while a table is generated
+const (
+	unknown uint8 = iota
+	won
+	lost
+	drawn
+	invalid    // illegal or not the stored copy of a symmetric position
+	propagated = 0x80
+)
+
+// maxPlies is the longest distance to mate the table format can store
+const maxPlies = 2 * 127
+
+// Best escape from a position by capturing or promoting, if it doesn't draw or win
+// it's the number of plies of the longest loss
+const (
+	escapeWin  uint16 = 0x100
+	escapeDraw uint16 = 0x200
+)
+
+// generate fills the table by retrograde analysis. Every position first counts its
+// moves that stay in the table and looks up the result of captures and promotions in
+// the tables of smaller endgames. Then, one ply at a time, positions lost in n plies
+// make every position leading to them won in n+1 and positions won in n plies make a
+// position leading to them lost once all its moves have been found to lose.
+func (t *Table) generate(tb *Tablebase) error {
+	n := 2 * t.size
+	state := make([]uint8, n)
+	plies := make([]uint8, n)
+	remaining := make([]uint8, n) // moves staying in the table not yet known to lose
+	escape := make([]uint16, n)   // best result of a capture or promotion
+	buckets := make([][]int32, maxPlies+2)
+
+	schedule := func(idx int, ply int) error {
+		if ply > maxPlies {
+			return fmt.Errorf("%s: distance to mate is too long to store", t.material)
+		}
+		buckets[ply] = append(buckets[ply], int32(idx))
+		return nil
+	}
+
+	var children []int
+	for idx := 0; idx < n; idx++ {
+		p := t.position(idx)
+		if t.index(&p) != idx || !t.legal(&p) {
+			state[idx] = invalid
+			continue
+		}
+
+		var err error
+		legal, draws := false, false
+		win, loss := -1, -1
+		children = children[:0]
+		t.forEachMove(&p, func(m move) {
+			legal = true
+			if !m.isConversion() {
+				c := p
+				c.squares[m.piece] = m.to
+				c.turn = p.turn.Opponent()
+				children = append(children, t.index(&c))
+				return
+			}
+			r, e := tb.lookup(t.converted(&p, m))
+			if e != nil {
+				err = e
+			}
+			switch r = r.parent(); r.Outcome {
+			case Win:
+				if win < 0 || r.Plies < win {
+					win = r.Plies
+				}
+			case Draw:
+				draws = true
+			case Loss:
+				if r.Plies > loss {
+					loss = r.Plies
+				}
+			}
+		})
+		if err != nil {
+			return err
+		}
+
+		remaining[idx] = uint8(countDistinct(children))
+		switch {
+		case !legal && t.inCheck(&p):
+			err = schedule(idx, 0) // checkmate
+		case !legal:
+			state[idx] = drawn // stalemate
+		case win >= 0:
+			escape[idx] = escapeWin
+			err = schedule(idx, win)
+		case draws:
+			escape[idx] = escapeDraw
+			if remaining[idx] == 0 {
+				state[idx] = drawn
+			}
+		case loss >= 0:
+			escape[idx] = uint16(loss)
+			if remaining[idx] == 0 {
+				err = schedule(idx, loss)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var parents []int
+	for ply := 0; ply < len(buckets); ply++ {
+		for _, i := range buckets[ply] {
+			idx := int(i)
+			if state[idx]&propagated != 0 || (state[idx] != unknown && int(plies[idx]) != ply) {
+				continue
+			}
+			result := won
+			if ply%2 == 0 {
+				result = lost
+			}
+			state[idx], plies[idx] = result|propagated, uint8(ply)
+
+			p := t.position(idx)
+			parents = parents[:0]
+			t.forEachUnmove(&p, func(q *position) {
+				parents = append(parents, t.index(q))
+			})
+			sort.Ints(parents)
+			for j, parent := range parents {
+				if (j > 0 && parent == parents[j-1]) || state[parent] != unknown {
+					continue
+				}
+				if result == lost {
+					// Found the quickest win for the parent, nothing can beat it
+					state[parent], plies[parent] = won, uint8(ply+1)
+					if err := schedule(parent, ply+1); err != nil {
+						return err
+					}
+					continue
+				}
+				if remaining[parent]--; remaining[parent] > 0 {
+					continue
+				}
+				switch {
+				case escape[parent] == escapeWin:
+					// Already scheduled to win by capturing or promoting
+				case escape[parent] == escapeDraw:
+					state[parent] = drawn
+				case int(escape[parent]) > ply+1:
+					if err := schedule(parent, int(escape[parent])); err != nil {
+						return err
+					}
+				default:
+					if err := schedule(parent, ply+1); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		buckets[ply] = nil
+	}
+
+	t.values = make([]byte, n)
+	for idx, s := range state {
+		switch s &^ propagated {
+		case won:
+			t.values[idx] = encodeResult(Result{Win, int(plies[idx])})
+		case lost:
+			t.values[idx] = encodeResult(Result{Loss, int(plies[idx])})
+		}
+	}
+	return nil
+}
+
+// converted returns the pieces after a capture or promotion
+func (t *Table) converted(p *position, m move) ([]kind, []int, chess.Color) {
+	var kinds []kind
+	var squares []int
+	for i, k := range t.kinds {
+		switch {
+		case i == m.captured:
+			continue
+		case i == m.piece:
+			if m.promotion != chess.PAWN {
+				k.symbol = m.promotion
+			}
+			kinds, squares = append(kinds, k), append(squares, m.to)
+		default:
+			kinds, squares = append(kinds, k), append(squares, p.squares[i])
+		}
+	}
+	return kinds, squares, p.turn.Opponent()
+}
+
+// countDistinct counts the distinct values, sorting them in place
+func countDistinct(values []int) int {
+	sort.Ints(values)
+	count := 0
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			count++
+		}
+	}
+	return count
+}
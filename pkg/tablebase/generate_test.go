@@ -0,0 +1,105 @@
+package tablebase
+
+import (
+	"testing"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// generated is shared between tests since generating tables takes a moment
+var generated = New()
+
+func generate(t *testing.T, material string) *Table {
+	tbl, err := generated.Generate(material)
+	if err != nil {
+		t.Fatalf("unexpected error generating %s: %s", material, err)
+	}
+	return tbl
+}
+
+func TestSymmetries(t *testing.T) {
+	if symmetries[1][chess.B1] != chess.G1 || symmetries[2][chess.B1] != chess.B8 || symmetries[4][chess.B1] != chess.A2 {
+		t.Errorf("unexpected symmetries of b1: %d %d %d", symmetries[1][chess.B1], symmetries[2][chess.B1], symmetries[4][chess.B1])
+	}
+	for sq := 0; sq < 64; sq++ {
+		if len(triangle.transforms[sq]) == 0 || len(queenSide.transforms[sq]) != 1 {
+			t.Errorf("square %d should be mapped into both regions", sq)
+		}
+	}
+	if len(triangle.transforms[chess.C3]) != 2 || len(triangle.transforms[chess.B1]) != 1 {
+		t.Errorf("squares on the diagonal map to the triangle in two ways")
+	}
+}
+
+func TestLongestMates(t *testing.T) {
+	endgames := map[string]int{"KQK": 10, "KRK": 16, "KPK": 28}
+	if !testing.Short() {
+		endgames["KBNK"] = 33
+		endgames["KQKR"] = 35
+	}
+	for material, moves := range endgames {
+		if longest := generate(t, material).Longest(); longest.MovesToMate() != moves {
+			t.Errorf("expected the longest %s mate in %d, actual: %s", material, moves, longest)
+		}
+	}
+}
+
+// TestConsistency checks a sample of positions against the results of their moves using
+// the board's own move generation
+func TestConsistency(t *testing.T) {
+	for _, material := range []string{"KQK", "KRK", "KPK"} {
+		tbl := generate(t, material)
+		checked := 0
+		for idx := 0; idx < len(tbl.values); idx += 101 {
+			p := tbl.position(idx)
+			if !tbl.legal(&p) || tbl.index(&p) != idx {
+				continue
+			}
+			b := boardOf(t, tbl, &p)
+			actual, err := generated.Probe(b)
+			if err != nil {
+				t.Fatalf("unexpected error probing %s: %s", b.FEN(), err)
+			}
+
+			expected := Result{}
+			moves := b.LegalMoves()
+			if len(moves) == 0 && b.InCheck() {
+				expected = Result{Loss, 0}
+			}
+			for i, m := range moves {
+				b.MakeMove(m)
+				r, err := generated.Probe(b)
+				b.UnmakeMove()
+				if err != nil {
+					t.Fatalf("unexpected error probing after %s in %s: %s", m, b.FEN(), err)
+				}
+				if r = r.parent(); i == 0 || r.better(expected) {
+					expected = r
+				}
+			}
+			if actual != expected {
+				t.Fatalf("expected %s for %s, actual: %s", expected, b.FEN(), actual)
+			}
+			checked++
+		}
+		if checked < 100 {
+			t.Errorf("expected to check more %s positions, actual: %d", material, checked)
+		}
+	}
+}
+
+// boardOf sets up a board with the position of a table
+func boardOf(t *testing.T, tbl *Table, p *position) *chess.Board {
+	positions := make([]chess.Bitboard, len(chess.Pieces))
+	for i, k := range tbl.kinds {
+		positions[chess.PieceIndex(k.color, k.symbol)].SetBit(p.squares[i])
+	}
+	b, err := chess.NewBoard(positions...)
+	if err != nil {
+		t.Fatalf("unexpected error creating board: %s", err)
+	}
+	b.Turn = p.turn
+	b.Castling = chess.NoCastling
+	b.Rehash()
+	return b
+}
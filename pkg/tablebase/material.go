@@ -0,0 +1,156 @@
+package tablebase
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// maxPieces is the largest endgame (including kings) that can be generated
+const maxPieces = 4
+
+// pieceOrder is the order pieces appear in material names
+const pieceOrder = "KQRBNP"
+
+// kind is a type of piece of one color
+type kind struct {
+	color  chess.Color
+	symbol chess.Symbol
+}
+
+func kindFromRune(color chess.Color, r rune) kind {
+	if r == 'P' {
+		return kind{color, chess.PAWN}
+	}
+	return kind{color, chess.Symbol(r)}
+}
+
+func (k kind) rune() rune {
+	if k.symbol == chess.PAWN {
+		return 'P'
+	}
+	return rune(k.symbol)
+}
+
+func (k kind) value() int {
+	return int(chess.Pieces[chess.PieceIndex(k.color, k.symbol)].Value)
+}
+
+// parseMaterial splits a material name like KQvKR (or KQKR) into the pieces of each side
+func parseMaterial(name string) (string, string, error) {
+	white, black := name, ""
+	if i := strings.IndexByte(name, 'v'); i >= 0 {
+		white, black = name[:i], name[i+1:]
+	} else if i := strings.LastIndexByte(name, 'K'); i > 0 {
+		white, black = name[:i], name[i:]
+	}
+	for _, side := range []string{white, black} {
+		if strings.Count(side, "K") != 1 || !strings.HasPrefix(side, "K") {
+			return "", "", fmt.Errorf("invalid material %q: each side needs exactly one king", name)
+		}
+		for _, r := range side {
+			if !strings.ContainsRune(pieceOrder, r) {
+				return "", "", fmt.Errorf("invalid material %q: unknown piece %q", name, r)
+			}
+		}
+	}
+	if len(white)+len(black) > maxPieces {
+		return "", "", fmt.Errorf("invalid material %q: at most %d pieces are supported", name, maxPieces)
+	}
+	return sortPieces(white), sortPieces(black), nil
+}
+
+func sortPieces(side string) string {
+	pieces := []byte(side)
+	sort.Slice(pieces, func(i, j int) bool {
+		return strings.IndexByte(pieceOrder, pieces[i]) < strings.IndexByte(pieceOrder, pieces[j])
+	})
+	return string(pieces)
+}
+
+func sideValue(side string) int {
+	value := 0
+	for _, r := range side {
+		value += kindFromRune(chess.WHITE, r).value()
+	}
+	return value
+}
+
+// canonicalMaterial names the material with the stronger side as white, swapped reports
+// whether the colors had to be swapped to do so
+func canonicalMaterial(white, black string) (string, bool) {
+	if v, u := sideValue(white), sideValue(black); u > v || (u == v && len(black) > len(white)) ||
+		(u == v && len(black) == len(white) && black > white) {
+		return black + "v" + white, true
+	}
+	return white + "v" + black, false
+}
+
+// insufficient checks if neither side can ever checkmate, in which case no table is needed
+func insufficient(white, black string) bool {
+	bare := func(side string) bool { return side == "K" }
+	minor := func(side string) bool { return side == "K" || side == "KB" || side == "KN" }
+	return (bare(white) && minor(black)) || (bare(black) && minor(white))
+}
+
+// materialOf names the material of a set of pieces
+func materialOf(kinds []kind) (string, string) {
+	var sides [2]string
+	for _, k := range kinds {
+		sides[k.color] += string(k.rune())
+	}
+	return sortPieces(sides[chess.WHITE]), sortPieces(sides[chess.BLACK])
+}
+
+// tableKinds lists the pieces of a material in table order: kings first, then the
+// remaining white and black pieces
+func tableKinds(white, black string) []kind {
+	kinds := []kind{{chess.WHITE, chess.KING}, {chess.BLACK, chess.KING}}
+	for c, side := range []string{white, black} {
+		for _, r := range side[1:] {
+			kinds = append(kinds, kindFromRune(chess.Color(c), r))
+		}
+	}
+	return kinds
+}
+
+// subMaterials lists the materials a capture, promotion or both can lead to
+func subMaterials(kinds []kind) [][2]string {
+	var subs [][2]string
+	seen := map[[2]string]bool{}
+	add := func(k []kind) {
+		white, black := materialOf(k)
+		if m := [2]string{white, black}; !seen[m] {
+			seen[m] = true
+			subs = append(subs, m)
+		}
+	}
+
+	for removed := -1; removed < len(kinds); removed++ {
+		if removed >= 0 && kinds[removed].symbol == chess.KING {
+			continue
+		}
+		var rest []kind
+		for i, k := range kinds {
+			if i != removed {
+				rest = append(rest, k)
+			}
+		}
+		if removed >= 0 {
+			add(rest)
+		}
+		for i, k := range rest {
+			if k.symbol != chess.PAWN {
+				continue
+			}
+			for _, s := range []chess.Symbol{chess.QUEEN, chess.ROOK, chess.BISHOP, chess.KNIGHT} {
+				promoted := append([]kind{}, rest...)
+				promoted[i].symbol = s
+				add(promoted)
+			}
+		}
+	}
+	return subs
+}
@@ -0,0 +1,73 @@
+package tablebase
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMaterial(t *testing.T) {
+	for name, expected := range map[string][2]string{
+		"KQK":   {"KQ", "K"},
+		"KQvK":  {"KQ", "K"},
+		"KQKR":  {"KQ", "KR"},
+		"KNBvK": {"KBN", "K"},
+		"KvKP":  {"K", "KP"},
+	} {
+		white, black, err := parseMaterial(name)
+		if err != nil || white != expected[0] || black != expected[1] {
+			t.Errorf("expected %s to parse as %v, actual: %s %s %v", name, expected, white, black, err)
+		}
+	}
+	for _, name := range []string{"", "K", "QK", "KQvQ", "KXK", "KQRKRN", "KKK"} {
+		if _, _, err := parseMaterial(name); err == nil {
+			t.Errorf("parsing %q should have failed", name)
+		}
+	}
+}
+
+func TestCanonicalMaterial(t *testing.T) {
+	for _, test := range []struct {
+		white, black, name string
+		swapped            bool
+	}{
+		{"KQ", "K", "KQvK", false},
+		{"K", "KQ", "KQvK", true},
+		{"KR", "KQ", "KQvKR", true},
+		{"KBN", "K", "KBNvK", false},
+		{"KN", "KB", "KNvKB", false},
+		{"KB", "KN", "KNvKB", true},
+	} {
+		name, swapped := canonicalMaterial(test.white, test.black)
+		if name != test.name || swapped != test.swapped {
+			t.Errorf("expected %s %t for %sv%s, actual: %s %t", test.name, test.swapped, test.white, test.black, name, swapped)
+		}
+	}
+}
+
+func TestSubMaterials(t *testing.T) {
+	expected := [][2]string{
+		{"KQ", "K"}, {"KR", "K"}, {"KB", "K"}, {"KN", "K"}, // promotions
+		{"K", "K"}, // the pawn is captured
+	}
+	if actual := subMaterials(tableKinds("KP", "K")); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, actual: %v", expected, actual)
+	}
+
+	expected = [][2]string{{"K", "KR"}, {"KQ", "K"}}
+	if actual := subMaterials(tableKinds("KQ", "KR")); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, actual: %v", expected, actual)
+	}
+}
+
+func TestInsufficient(t *testing.T) {
+	for _, m := range [][2]string{{"K", "K"}, {"KB", "K"}, {"K", "KN"}} {
+		if !insufficient(m[0], m[1]) {
+			t.Errorf("%sv%s should be insufficient", m[0], m[1])
+		}
+	}
+	for _, m := range [][2]string{{"KP", "K"}, {"KB", "KN"}, {"KNN", "K"}, {"KR", "K"}} {
+		if insufficient(m[0], m[1]) {
+			t.Errorf("%sv%s should not be insufficient", m[0], m[1])
+		}
+	}
+}
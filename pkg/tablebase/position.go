@@ -0,0 +1,289 @@
+package tablebase
+
+import (
+	"math/bits"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+//-----------------------------------------------------------------------------
+// Symmetry
+//-----------------------------------------------------------------------------
+
+// Positions are only stored once for every group of positions that are the same up to
+// a symmetry of the board. Without pawns any of the 8 reflections and rotations can be
+// used to move the white king into the a1-d1-d4 triangle; with pawns only mirroring
+// the files keeps the pawns moving the right way, so the king is moved to the a-d files.
+
+var (
+	symmetries [8][64]int
+	triangle   region
+	queenSide  region
+)
+
+// region is the set of squares the white king is normalised to, code maps a square in
+// the region to 0..len(squares) and transforms lists the symmetries that move a square
+// into the region
+type region struct {
+	squares    []int
+	code       [64]int
+	transforms [64][]int
+}
+
+func newRegion(squares []int, allowed []int) region {
+	r := region{squares: squares}
+	for sq := range r.code {
+		r.code[sq] = -1
+	}
+	for i, sq := range squares {
+		r.code[sq] = i
+	}
+	for sq := 0; sq < 64; sq++ {
+		for _, t := range allowed {
+			if r.code[symmetries[t][sq]] >= 0 {
+				r.transforms[sq] = append(r.transforms[sq], t)
+			}
+		}
+	}
+	return r
+}
+
+func init() {
+	// Symmetry t reflects about the a1-h8 diagonal (bit 2), then the center ranks (bit 1),
+	// then the center files (bit 0)
+	for t := range symmetries {
+		for sq := 0; sq < 64; sq++ {
+			b := chess.Bitboard(1) << uint(sq)
+			if t&4 != 0 {
+				b = b.FlipDiagonalA1H8()
+			}
+			if t&2 != 0 {
+				b = b.FlipVertical()
+			}
+			if t&1 != 0 {
+				b = b.FlipHorizontal()
+			}
+			symmetries[t][sq] = bits.TrailingZeros64(uint64(b))
+		}
+	}
+	var files []int
+	for sq := 0; sq < 64; sq++ {
+		if sq%8 < 4 {
+			files = append(files, sq)
+		}
+	}
+	triangle = newRegion([]int{
+		chess.A1, chess.B1, chess.C1, chess.D1, chess.B2, chess.C2, chess.D2, chess.C3, chess.D3, chess.D4,
+	}, []int{0, 1, 2, 3, 4, 5, 6, 7})
+	queenSide = newRegion(files, []int{0, 1})
+}
+
+//-----------------------------------------------------------------------------
+// Positions
+//-----------------------------------------------------------------------------
+
+// position places the pieces of a table, in the table's order, on the board
+type position struct {
+	squares [maxPieces]int
+	turn    chess.Color
+}
+
+func attacks(k kind, sq int, occupied chess.Bitboard) chess.Bitboard {
+	switch k.symbol {
+	case chess.KING:
+		return chess.KingAttacks(sq)
+	case chess.QUEEN:
+		return chess.QueenAttacks(sq, occupied)
+	case chess.ROOK:
+		return chess.RookAttacks(sq, occupied)
+	case chess.BISHOP:
+		return chess.BishopAttacks(sq, occupied)
+	case chess.KNIGHT:
+		return chess.KnightAttacks(sq)
+	}
+	return chess.PawnAttacks(k.color, sq)
+}
+
+func flipRank(sq int) int {
+	return symmetries[2][sq]
+}
+
+func kingIndex(c chess.Color) int {
+	return int(c) // kings are always first, white then black
+}
+
+// index returns the index of the stored position that is symmetric to the position
+func (t *Table) index(p *position) int {
+	best := -1
+	for _, s := range t.region.transforms[p.squares[0]] {
+		idx := t.region.code[symmetries[s][p.squares[0]]]
+		for i := 1; i < len(t.kinds); i++ {
+			idx = idx*64 + symmetries[s][p.squares[i]]
+		}
+		if best < 0 || idx < best {
+			best = idx
+		}
+	}
+	return best + int(p.turn)*t.size
+}
+
+// position decodes an index
+func (t *Table) position(idx int) position {
+	var p position
+	p.turn = chess.Color(idx / t.size)
+	idx %= t.size
+	for i := len(t.kinds) - 1; i > 0; i-- {
+		p.squares[i] = idx % 64
+		idx /= 64
+	}
+	p.squares[0] = t.region.squares[idx]
+	return p
+}
+
+func (t *Table) occupied(p *position) chess.Bitboard {
+	var occupied chess.Bitboard
+	for i := range t.kinds {
+		occupied |= chess.Bitboard(1) << uint(p.squares[i])
+	}
+	return occupied
+}
+
+// attacked checks if a square is attacked by a color, ignoring the piece at skip
+func (t *Table) attacked(p *position, sq int, by chess.Color, occupied chess.Bitboard, skip int) bool {
+	for i, k := range t.kinds {
+		if i != skip && k.color == by && attacks(k, p.squares[i], occupied).IsBitSet(sq) {
+			return true
+		}
+	}
+	return false
+}
+
+// legal checks that the pieces are on distinct squares, pawns aren't on the first or
+// last rank and the side that isn't to move isn't in check
+func (t *Table) legal(p *position) bool {
+	occupied := t.occupied(p)
+	if occupied.Population() != len(t.kinds) {
+		return false
+	}
+	for i, k := range t.kinds {
+		if k.symbol == chess.PAWN && (p.squares[i] < 8 || p.squares[i] >= 56) {
+			return false
+		}
+	}
+	return !t.attacked(p, p.squares[kingIndex(p.turn.Opponent())], p.turn, occupied, -1)
+}
+
+func (t *Table) inCheck(p *position) bool {
+	return t.attacked(p, p.squares[kingIndex(p.turn)], p.turn.Opponent(), t.occupied(p), -1)
+}
+
+// move is a move of the piece at index piece, captured is the index of the captured
+// piece or -1
+type move struct {
+	piece, to, captured int
+	promotion           chess.Symbol
+}
+
+// isConversion checks if the move leaves the table's material
+func (m move) isConversion() bool {
+	return m.captured >= 0 || m.promotion != chess.PAWN
+}
+
+// forEachMove calls f with every legal move of the side to move
+func (t *Table) forEachMove(p *position, f func(m move)) {
+	occupied := t.occupied(p)
+	var own chess.Bitboard
+	for i, k := range t.kinds {
+		if k.color == p.turn {
+			own |= chess.Bitboard(1) << uint(p.squares[i])
+		}
+	}
+	king := kingIndex(p.turn)
+
+	try := func(m move) {
+		from := p.squares[m.piece]
+		child := *p
+		child.squares[m.piece] = m.to
+		occ := occupied&^(chess.Bitboard(1)<<uint(from)) | chess.Bitboard(1)<<uint(m.to)
+		if !t.attacked(&child, child.squares[king], p.turn.Opponent(), occ, m.captured) {
+			f(m)
+		}
+	}
+	add := func(i, to int) {
+		captured := -1
+		for j := range t.kinds {
+			if p.squares[j] == to {
+				captured = j
+			}
+		}
+		if t.kinds[i].symbol == chess.PAWN && (to < 8 || to >= 56) {
+			for _, s := range []chess.Symbol{chess.QUEEN, chess.ROOK, chess.BISHOP, chess.KNIGHT} {
+				try(move{i, to, captured, s})
+			}
+			return
+		}
+		try(move{i, to, captured, chess.PAWN})
+	}
+
+	for i, k := range t.kinds {
+		if k.color != p.turn {
+			continue
+		}
+		from := p.squares[i]
+		var targets chess.Bitboard
+		if k.symbol == chess.PAWN {
+			targets = chess.PawnAttacks(k.color, from) & occupied &^ own
+			push, start := 8, 1
+			if k.color == chess.BLACK {
+				push, start = -8, 6
+			}
+			if !occupied.IsBitSet(from + push) {
+				targets.SetBit(from + push)
+				if from/8 == start && !occupied.IsBitSet(from+2*push) {
+					targets.SetBit(from + 2*push)
+				}
+			}
+		} else {
+			targets = attacks(k, from, occupied) &^ own
+		}
+		for ; targets != 0; targets &= targets - 1 {
+			add(i, bits.TrailingZeros64(uint64(targets)))
+		}
+	}
+}
+
+// forEachUnmove calls f with every legal position that leads to the position with a move
+// that stays in the table, i.e. isn't a capture or promotion
+func (t *Table) forEachUnmove(p *position, f func(q *position)) {
+	occupied := t.occupied(p)
+	mover := p.turn.Opponent()
+	for i, k := range t.kinds {
+		if k.color != mover {
+			continue
+		}
+		to := p.squares[i]
+		var froms chess.Bitboard
+		if k.symbol == chess.PAWN {
+			push, double := -8, 3
+			if k.color == chess.BLACK {
+				push, double = 8, 4
+			}
+			if !occupied.IsBitSet(to + push) {
+				froms.SetBit(to + push)
+				if to/8 == double && !occupied.IsBitSet(to+2*push) {
+					froms.SetBit(to + 2*push)
+				}
+			}
+		} else {
+			froms = attacks(k, to, occupied) &^ occupied
+		}
+		for ; froms != 0; froms &= froms - 1 {
+			q := *p
+			q.squares[i] = bits.TrailingZeros64(uint64(froms))
+			q.turn = mover
+			if t.legal(&q) {
+				f(&q)
+			}
+		}
+	}
+}
@@ -0,0 +1,377 @@
+// Package tablebase generates perfect play tables for small endgames by retrograde
+// analysis and probes them for the distance to mate.
+//
+// Each table covers one material balance, e.g. KQvKR, and stores for every position
+// with either side to move whether it is won, drawn or lost and how many moves it takes
+// to mate with perfect play. Tables are built from the positions that are already
+// decided, checkmates and captures or promotions into smaller endgames, working
+// backwards one ply at a time. See <https://www.chessprogramming.org/Retrograde_Analysis>.
+package tablebase
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+// Outcome is the result of a position for the side to move with perfect play
+type Outcome int8
+
+// Loss means the side to move will be checkmated
+// Draw means neither side can force checkmate
+// Win means the side to move can force checkmate
+const (
+	Loss Outcome = -1
+	Draw Outcome = 0
+	Win  Outcome = 1
+)
+
+// OutcomeNames maps the outcome to the descriptive name
+var OutcomeNames = map[Outcome]string{
+	Loss: "loss",
+	Draw: "draw",
+	Win:  "win",
+}
+
+func (o Outcome) String() string {
+	if name, ok := OutcomeNames[o]; ok {
+		return name
+	}
+	return ""
+}
+
+// Result is the outcome of a position and the number of plies to checkmate when the
+// winning side mates as quickly as possible and the losing side delays it as long as
+// possible. Plies is 0 for draws and for positions that are already checkmate.
+type Result struct {
+	Outcome Outcome
+	Plies   int
+}
+
+// MovesToMate returns the distance to mate in moves of the winning side
+func (r Result) MovesToMate() int {
+	return (r.Plies + 1) / 2
+}
+
+func (r Result) String() string {
+	switch {
+	case r.Outcome == Win:
+		return fmt.Sprintf("mate in %d", r.MovesToMate())
+	case r.Outcome == Loss && r.Plies == 0:
+		return "checkmated"
+	case r.Outcome == Loss:
+		return fmt.Sprintf("mated in %d", r.MovesToMate())
+	}
+	return "draw"
+}
+
+// parent converts the result of a position to the result of the move leading to it
+// for the side making the move
+func (r Result) parent() Result {
+	switch r.Outcome {
+	case Win:
+		return Result{Loss, r.Plies + 1}
+	case Loss:
+		return Result{Win, r.Plies + 1}
+	}
+	return r
+}
+
+// better checks if a result is preferable to another for the side to move
+func (r Result) better(other Result) bool {
+	if r.Outcome != other.Outcome {
+		return r.Outcome > other.Outcome
+	}
+	if r.Outcome == Win {
+		return r.Plies < other.Plies
+	}
+	return r.Plies > other.Plies
+}
+
+// Table holds the results of every position of one material balance. Results are
+// stored in one byte: 0 for draws (and positions that can't occur), 1-127 for a win
+// with mate in that many moves and 128 plus the number of moves for a loss.
+type Table struct {
+	material string
+	kinds    []kind
+	region   *region
+	size     int // number of positions with each side to move
+	values   []byte
+}
+
+// newTable sets up an empty table for a material, which must be in canonical form
+func newTable(material string) (*Table, error) {
+	white, black, err := parseMaterial(material)
+	if err != nil {
+		return nil, err
+	}
+	if name, _ := canonicalMaterial(white, black); name != material {
+		return nil, fmt.Errorf("table material %q should be named %q", material, name)
+	}
+	if insufficient(white, black) {
+		return nil, fmt.Errorf("%s is a draw and needs no table", material)
+	}
+
+	t := &Table{material: material, kinds: tableKinds(white, black), region: &triangle}
+	for _, k := range t.kinds {
+		if k.symbol == chess.PAWN {
+			if k.color == chess.BLACK {
+				return nil, fmt.Errorf("%s: tables with black pawns are not supported", material)
+			}
+			t.region = &queenSide
+		}
+	}
+	t.size = len(t.region.squares)
+	for i := 1; i < len(t.kinds); i++ {
+		t.size *= 64
+	}
+	return t, nil
+}
+
+// Material returns the name of the material balance covered by the table, e.g. KQvKR
+func (t *Table) Material() string {
+	return t.material
+}
+
+// Len returns the number of positions stored in the table
+func (t *Table) Len() int {
+	return len(t.values)
+}
+
+// Longest returns the longest win in the table
+func (t *Table) Longest() Result {
+	longest := Result{}
+	for _, v := range t.values {
+		if r := decodeResult(v); r.Outcome == Win && r.Plies > longest.Plies {
+			longest = r
+		}
+	}
+	return longest
+}
+
+func encodeResult(r Result) byte {
+	switch r.Outcome {
+	case Win:
+		return byte(r.MovesToMate())
+	case Loss:
+		return 128 + byte(r.MovesToMate())
+	}
+	return 0
+}
+
+func decodeResult(v byte) Result {
+	switch {
+	case v == 0:
+		return Result{}
+	case v < 128:
+		return Result{Win, int(v)*2 - 1}
+	}
+	return Result{Loss, int(v-128) * 2}
+}
+
+// probe returns the result of a position given as pieces of the table's material
+func (t *Table) probe(kinds []kind, squares []int, turn chess.Color, swapped bool) (Result, error) {
+	var p position
+	p.turn = turn
+	if swapped {
+		p.turn = turn.Opponent()
+	}
+	used := make([]bool, len(kinds))
+	for i, k := range t.kinds {
+		for j, other := range kinds {
+			if swapped {
+				other.color = other.color.Opponent()
+			}
+			if !used[j] && other == k {
+				p.squares[i] = squares[j]
+				if swapped {
+					p.squares[i] = flipRank(squares[j])
+				}
+				used[j] = true
+				break
+			}
+		}
+	}
+	if !t.legal(&p) {
+		return Result{}, fmt.Errorf("illegal %s position", t.material)
+	}
+	return decodeResult(t.values[t.index(&p)]), nil
+}
+
+// Tablebase is a collection of tables, including the tables for every endgame they can
+// be converted to
+type Tablebase struct {
+	tables map[string]*Table
+}
+
+// New returns an empty tablebase
+func New() *Tablebase {
+	return &Tablebase{tables: map[string]*Table{}}
+}
+
+// Generate builds the table for a material balance given as e.g. KQvKR or KQKR, along
+// with the tables of the endgames reached by captures and promotions. Tables for up to
+// 4 pieces are supported where only one side has pawns.
+func (tb *Tablebase) Generate(material string) (*Table, error) {
+	white, black, err := parseMaterial(material)
+	if err != nil {
+		return nil, err
+	}
+	name, _ := canonicalMaterial(white, black)
+	if t, ok := tb.tables[name]; ok {
+		return t, nil
+	}
+	t, err := newTable(name)
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range subMaterials(t.kinds) {
+		if !insufficient(sub[0], sub[1]) {
+			if _, err := tb.Generate(sub[0] + "v" + sub[1]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := t.generate(tb); err != nil {
+		return nil, err
+	}
+	tb.tables[name] = t
+	return t, nil
+}
+
+// Add adds a table that was generated or read separately
+func (tb *Tablebase) Add(t *Table) {
+	tb.tables[t.material] = t
+}
+
+// Table returns the table for a material balance
+func (tb *Tablebase) Table(material string) (*Table, bool) {
+	white, black, err := parseMaterial(material)
+	if err != nil {
+		return nil, false
+	}
+	name, _ := canonicalMaterial(white, black)
+	t, ok := tb.tables[name]
+	return t, ok
+}
+
+// Materials lists the material balances in the tablebase
+func (tb *Tablebase) Materials() []string {
+	var materials []string
+	for name := range tb.tables {
+		materials = append(materials, name)
+	}
+	sort.Strings(materials)
+	return materials
+}
+
+// lookup returns the result of a position given as a list of pieces
+func (tb *Tablebase) lookup(kinds []kind, squares []int, turn chess.Color) (Result, error) {
+	white, black := materialOf(kinds)
+	if insufficient(white, black) {
+		return Result{}, nil
+	}
+	name, swapped := canonicalMaterial(white, black)
+	t, ok := tb.tables[name]
+	if !ok {
+		return Result{}, fmt.Errorf("no table for %s", name)
+	}
+	return t.probe(kinds, squares, turn, swapped)
+}
+
+// Probe returns the result of the position for the side to move. The position must
+// have no castling rights and its material must be in the tablebase.
+func (tb *Tablebase) Probe(board *chess.Board) (Result, error) {
+	if board.Castling != chess.NoCastling {
+		return Result{}, fmt.Errorf("tablebases do not contain positions with castling rights")
+	}
+	var kinds []kind
+	var squares []int
+	for i, bb := range board.Positions {
+		for ; bb != 0; bb &= bb - 1 {
+			if len(kinds) == maxPieces {
+				return Result{}, fmt.Errorf("tablebases have at most %d pieces", maxPieces)
+			}
+			p := board.Pieces[i]
+			kinds = append(kinds, kind{p.Color, p.Symbol})
+			squares = append(squares, bits.TrailingZeros64(uint64(bb)))
+		}
+	}
+	return tb.lookup(kinds, squares, board.Turn)
+}
+
+// BestMove returns the move with the best result for the side to move, the quickest
+// mate when winning and the longest defence when losing, along with the result of the
+// position after the move from the mover's point of view
+func (tb *Tablebase) BestMove(board *chess.Board) (chess.Move, Result, error) {
+	b := board.Copy()
+	var best chess.Move
+	var bestResult Result
+	moves := b.LegalMoves()
+	if len(moves) == 0 {
+		return best, bestResult, fmt.Errorf("no legal moves")
+	}
+	for i, m := range moves {
+		b.MakeMove(m)
+		r, err := tb.Probe(b)
+		b.UnmakeMove()
+		if err != nil {
+			return best, bestResult, err
+		}
+		if r = r.parent(); i == 0 || r.better(bestResult) {
+			best, bestResult = m, r
+		}
+	}
+	return best, bestResult, nil
+}
+
+// tableExtension is the file extension of saved tables
+const tableExtension = ".gctb"
+
+// Save writes every table to a file in the directory named after its material
+func (tb *Tablebase) Save(dir string) error {
+	for name, t := range tb.tables {
+		f, err := os.Create(filepath.Join(dir, name+tableExtension))
+		if err != nil {
+			return err
+		}
+		_, err = t.WriteTo(f)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load reads all the tables saved in a directory
+func Load(dir string) (*Tablebase, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	tb := New()
+	for _, file := range files {
+		if filepath.Ext(file.Name()) != tableExtension {
+			continue
+		}
+		f, err := os.Open(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		t, err := ReadTable(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", file.Name(), err)
+		}
+		tb.Add(t)
+	}
+	return tb, nil
+}
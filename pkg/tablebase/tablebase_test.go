@@ -0,0 +1,109 @@
+package tablebase
+
+import (
+	"testing"
+
+	"github.com/aaronireland/go-chess/pkg/chess"
+)
+
+func parseFEN(t *testing.T, fen string) *chess.Board {
+	b, err := chess.ParseFEN(fen)
+	if err != nil {
+		t.Fatalf("unexpected error parsing %s: %s", fen, err)
+	}
+	return b
+}
+
+func TestProbe(t *testing.T) {
+	generate(t, "KQK")
+	generate(t, "KPK")
+	for _, test := range []struct {
+		fen      string
+		expected Result
+	}{
+		{"7k/8/6K1/8/8/8/8/1Q6 w - - 0 1", Result{Win, 1}},
+		{"Q6k/8/6K1/8/8/8/8/8 b - - 0 1", Result{Loss, 0}},   // checkmate
+		{"7k/5Q2/6K1/8/8/8/8/8 b - - 0 1", Result{Draw, 0}},  // stalemate
+		{"1q6/8/8/8/8/6k1/8/7K b - - 0 1", Result{Win, 1}},   // colors swapped
+		{"8/8/8/8/8/8/6Kq/4k3 w - - 0 1", Result{Draw, 0}},   // the queen is captured
+		{"k7/8/8/8/8/8/P7/K7 w - - 0 1", Result{Draw, 0}},    // rook pawn
+		{"8/8/8/8/8/8/4P3/4K2k w - - 0 1", Result{Win, 23}},  // the pawn can't be caught
+		{"8/8/8/8/8/8/8/K6k w - - 0 1", Result{Draw, 0}},     // bare kings
+		{"8/8/8/8/8/8/3B4/K6k b - - 0 1", Result{Draw, 0}},   // insufficient material
+		{"4k3/8/8/8/8/8/4p3/4K3 w - - 0 1", Result{Draw, 0}}, // the pawn is captured
+	} {
+		b := parseFEN(t, test.fen)
+		if actual, err := generated.Probe(b); err != nil {
+			t.Errorf("unexpected error probing %s: %s", test.fen, err)
+		} else if actual != test.expected {
+			t.Errorf("expected %s for %s, actual: %s", test.expected, test.fen, actual)
+		}
+	}
+
+	for _, fen := range []string{
+		chess.StartFEN,
+		"4k3/8/8/8/8/8/8/R3K3 w Q - 0 1",  // castling rights
+		"4k3/8/8/8/8/8/8/RR2K3 w - - 0 1", // no table
+		"7k/6Q1/8/8/8/8/8/K7 w - - 0 1",   // black is in check with white to move
+	} {
+		if _, err := generated.Probe(parseFEN(t, fen)); err == nil {
+			t.Errorf("probing %s should fail", fen)
+		}
+	}
+}
+
+func TestBestMove(t *testing.T) {
+	generate(t, "KQK")
+	b := parseFEN(t, "7k/8/6K1/8/8/8/8/1Q6 w - - 0 1")
+	m, r, err := generated.BestMove(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if m.UCI() != "b1b8" || r != (Result{Win, 1}) {
+		t.Errorf("expected b1b8 mate in 1, actual: %s %s", m, r)
+	}
+
+	// Follow the best moves for both sides until mate
+	b = parseFEN(t, "8/8/8/3k4/8/8/8/K5Q1 w - - 0 1")
+	start, _ := generated.Probe(b)
+	plies := 0
+	for !b.IsCheckmate() {
+		m, r, err := generated.BestMove(b)
+		if err != nil {
+			t.Fatalf("unexpected error after %d plies: %s", plies, err)
+		}
+		if r.Plies != start.Plies-plies {
+			t.Fatalf("expected mate in %d plies, actual: %s", start.Plies-plies, r)
+		}
+		b.MakeMove(m)
+		plies++
+	}
+	if plies != start.Plies {
+		t.Errorf("expected mate after %d plies, actual: %d", start.Plies, plies)
+	}
+
+	if _, _, err := generated.BestMove(parseFEN(t, "Q6k/8/6K1/8/8/8/8/8 b - - 0 1")); err == nil {
+		t.Errorf("a checkmated side has no best move")
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	for _, material := range []string{"KvK", "KBK", "KPKP", "KQRKR", "KQ"} {
+		if _, err := New().Generate(material); err == nil {
+			t.Errorf("generating %s should fail", material)
+		}
+	}
+}
+
+func TestResultString(t *testing.T) {
+	for r, expected := range map[Result]string{
+		{Win, 5}:  "mate in 3",
+		{Loss, 4}: "mated in 2",
+		{Loss, 0}: "checkmated",
+		{Draw, 0}: "draw",
+	} {
+		if r.String() != expected {
+			t.Errorf("expected %q, actual: %q", expected, r.String())
+		}
+	}
+}